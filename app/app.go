@@ -9,7 +9,7 @@ import (
 
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
-	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/infrastructure/os/execenv"
 	"github.com/kaspanet/kaspad/infrastructure/os/limits"
@@ -18,11 +18,12 @@ import (
 	"github.com/kaspanet/kaspad/util/panics"
 	"github.com/kaspanet/kaspad/util/profiling"
 	"github.com/kaspanet/kaspad/version"
+	"github.com/pkg/errors"
 )
 
 const (
-	leveldbCacheSizeMiB = 256
-	defaultDataDirname  = "datadir2"
+	databaseCacheSizeMiB = 256
+	defaultDataDirname   = "datadir2"
 )
 
 var desiredLimits = &limits.DesiredLimits{
@@ -102,6 +103,28 @@ func (app *kaspadApp) main(startedChan chan<- struct{}) error {
 		}
 	}
 
+	if app.cfg.RestoreSnapshot != "" {
+		err := restoreSnapshot(app.cfg)
+		if err != nil {
+			log.Errorf("Restoring the database snapshot failed: %+v", err)
+			return err
+		}
+	}
+
+	// An invalid restored database is removed only after it's closed, so this
+	// must be deferred before the database is opened
+	isRestoredSnapshotInvalid := false
+	defer func() {
+		if !isRestoredSnapshotInvalid {
+			return
+		}
+		log.Infof("Removing the invalid restored database")
+		err := removeDatabase(app.cfg)
+		if err != nil {
+			log.Errorf("Failed to remove the invalid restored database: %s", err)
+		}
+	}()
+
 	// Open the database
 	databaseContext, err := openDB(app.cfg)
 	if err != nil {
@@ -126,6 +149,7 @@ func (app *kaspadApp) main(startedChan chan<- struct{}) error {
 	componentManager, err := NewComponentManager(app.cfg, databaseContext, interrupt)
 	if err != nil {
 		log.Errorf("Unable to start kaspad: %+v", err)
+		isRestoredSnapshotInvalid = errors.Is(err, errInvalidRestoredSnapshot)
 		return err
 	}
 
@@ -172,6 +196,11 @@ func removeDatabase(cfg *config.Config) error {
 }
 
 func openDB(cfg *config.Config) (database.Database, error) {
+	if !dbfactory.IsPersistent(cfg.DbType) {
+		log.Infof("Creating an in-memory database. The DAG will be lost on shutdown")
+		return dbfactory.Open(cfg.DbType, "", databaseCacheSizeMiB)
+	}
+
 	dbPath := databasePath(cfg)
 
 	err := checkDatabaseVersion(dbPath)
@@ -179,8 +208,13 @@ func openDB(cfg *config.Config) (database.Database, error) {
 		return nil, err
 	}
 
-	log.Infof("Loading database from '%s'", dbPath)
-	db, err := ldb.NewLevelDB(dbPath, leveldbCacheSizeMiB)
+	err = dbfactory.CheckType(dbPath, cfg.DbType)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Loading %s database from '%s'", cfg.DbType, dbPath)
+	db, err := dbfactory.Open(cfg.DbType, dbPath, databaseCacheSizeMiB)
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +163,34 @@ const (
 	CmdGetMempoolEntriesByAddressesResponseMessage
 	CmdGetCoinSupplyRequestMessage
 	CmdGetCoinSupplyResponseMessage
+	CmdGetTransactionRequestMessage
+	CmdGetTransactionResponseMessage
+	CmdGetTransactionAcceptanceRequestMessage
+	CmdGetTransactionAcceptanceResponseMessage
+	CmdGetAddressTransactionsRequestMessage
+	CmdGetAddressTransactionsResponseMessage
+	CmdGetFeeEstimateRequestMessage
+	CmdGetFeeEstimateResponseMessage
+	CmdSubmitTransactionReplacementRequestMessage
+	CmdSubmitTransactionReplacementResponseMessage
+	CmdSaveMempoolRequestMessage
+	CmdSaveMempoolResponseMessage
+	CmdNotifyMempoolChangedRequestMessage
+	CmdNotifyMempoolChangedResponseMessage
+	CmdMempoolChangedNotificationMessage
+	CmdNotifyVirtualChainChangedV2RequestMessage
+	CmdNotifyVirtualChainChangedV2ResponseMessage
+	CmdVirtualChainChangedV2NotificationMessage
+	CmdGetLogLevelsRequestMessage
+	CmdGetLogLevelsResponseMessage
+	CmdSetLogLevelRequestMessage
+	CmdSetLogLevelResponseMessage
+	CmdCreateSnapshotRequestMessage
+	CmdCreateSnapshotResponseMessage
+	CmdListBannedRequestMessage
+	CmdListBannedResponseMessage
+	CmdImportBansRequestMessage
+	CmdImportBansResponseMessage
 )
 
 // ProtocolMessageCommandToString maps all MessageCommands to their string representation
@@ -269,7 +297,7 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdGetUTXOsByAddressesRequestMessage:                          "GetUTXOsByAddressesRequest",
 	CmdGetUTXOsByAddressesResponseMessage:                         "GetUTXOsByAddressesResponse",
 	CmdGetBalanceByAddressRequestMessage:                          "GetBalanceByAddressRequest",
-	CmdGetBalanceByAddressResponseMessage:                         "GetBalancesByAddressResponse",
+	CmdGetBalanceByAddressResponseMessage:                         "GetBalanceByAddressResponse",
 	CmdGetVirtualSelectedParentBlueScoreRequestMessage:            "GetVirtualSelectedParentBlueScoreRequest",
 	CmdGetVirtualSelectedParentBlueScoreResponseMessage:           "GetVirtualSelectedParentBlueScoreResponse",
 	CmdNotifyVirtualSelectedParentBlueScoreChangedRequestMessage:  "NotifyVirtualSelectedParentBlueScoreChangedRequest",
@@ -280,7 +308,7 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdUnbanRequestMessage:                                        "UnbanRequest",
 	CmdUnbanResponseMessage:                                       "UnbanResponse",
 	CmdGetInfoRequestMessage:                                      "GetInfoRequest",
-	CmdGetInfoResponseMessage:                                     "GeInfoResponse",
+	CmdGetInfoResponseMessage:                                     "GetInfoResponse",
 	CmdNotifyPruningPointUTXOSetOverrideRequestMessage:            "NotifyPruningPointUTXOSetOverrideRequest",
 	CmdNotifyPruningPointUTXOSetOverrideResponseMessage:           "NotifyPruningPointUTXOSetOverrideResponse",
 	CmdPruningPointUTXOSetOverrideNotificationMessage:             "PruningPointUTXOSetOverrideNotification",
@@ -300,6 +328,34 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdGetMempoolEntriesByAddressesResponseMessage:                "GetMempoolEntriesByAddressesResponse",
 	CmdGetCoinSupplyRequestMessage:                                "GetCoinSupplyRequest",
 	CmdGetCoinSupplyResponseMessage:                               "GetCoinSupplyResponse",
+	CmdGetTransactionRequestMessage:                               "GetTransactionRequest",
+	CmdGetTransactionResponseMessage:                              "GetTransactionResponse",
+	CmdGetTransactionAcceptanceRequestMessage:                     "GetTransactionAcceptanceRequest",
+	CmdGetTransactionAcceptanceResponseMessage:                    "GetTransactionAcceptanceResponse",
+	CmdGetAddressTransactionsRequestMessage:                       "GetAddressTransactionsRequest",
+	CmdGetAddressTransactionsResponseMessage:                      "GetAddressTransactionsResponse",
+	CmdGetFeeEstimateRequestMessage:                               "GetFeeEstimateRequest",
+	CmdGetFeeEstimateResponseMessage:                              "GetFeeEstimateResponse",
+	CmdSubmitTransactionReplacementRequestMessage:                 "SubmitTransactionReplacementRequest",
+	CmdSubmitTransactionReplacementResponseMessage:                "SubmitTransactionReplacementResponse",
+	CmdSaveMempoolRequestMessage:                                  "SaveMempoolRequest",
+	CmdSaveMempoolResponseMessage:                                 "SaveMempoolResponse",
+	CmdNotifyMempoolChangedRequestMessage:                         "NotifyMempoolChangedRequest",
+	CmdNotifyMempoolChangedResponseMessage:                        "NotifyMempoolChangedResponse",
+	CmdMempoolChangedNotificationMessage:                          "MempoolChangedNotification",
+	CmdNotifyVirtualChainChangedV2RequestMessage:                  "NotifyVirtualChainChangedV2Request",
+	CmdNotifyVirtualChainChangedV2ResponseMessage:                 "NotifyVirtualChainChangedV2Response",
+	CmdVirtualChainChangedV2NotificationMessage:                   "VirtualChainChangedV2Notification",
+	CmdGetLogLevelsRequestMessage:                                 "GetLogLevelsRequest",
+	CmdGetLogLevelsResponseMessage:                                "GetLogLevelsResponse",
+	CmdSetLogLevelRequestMessage:                                  "SetLogLevelRequest",
+	CmdSetLogLevelResponseMessage:                                 "SetLogLevelResponse",
+	CmdCreateSnapshotRequestMessage:                               "CreateSnapshotRequest",
+	CmdCreateSnapshotResponseMessage:                              "CreateSnapshotResponse",
+	CmdListBannedRequestMessage:                                   "ListBannedRequest",
+	CmdListBannedResponseMessage:                                  "ListBannedResponse",
+	CmdImportBansRequestMessage:                                   "ImportBansRequest",
+	CmdImportBansResponseMessage:                                  "ImportBansResponse",
 }
 
 // Message is an interface that describes a kaspa message. A type that
@@ -5,9 +5,14 @@
 package appmessage
 
 import (
+	"encoding/base32"
 	"net"
+	"strconv"
+	"strings"
 
 	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
 )
 
 // NetAddress defines information about a peer on the network including the time
@@ -22,6 +27,37 @@ type NetAddress struct {
 	// Port the peer is using. This is encoded in big endian on the appmessage
 	// which differs from most everything else.
 	Port uint16
+
+	// OnionPublicKey is the public key of the peer's Tor v3 onion service, for
+	// peers that are reachable through Tor. IP is nil for such peers.
+	OnionPublicKey []byte
+}
+
+// OnionPublicKeySize is the size of the public key of a Tor v3 onion service
+const OnionPublicKeySize = 32
+
+const (
+	onionSuffix          = ".onion"
+	onionVersion         = 3
+	onionChecksumSize    = 2
+	onionChecksumPrefix  = ".onion checksum"
+	onionDecodedHostSize = OnionPublicKeySize + onionChecksumSize + 1
+)
+
+var onionEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// IsOnion returns whether the address is of a Tor onion service
+func (na *NetAddress) IsOnion() bool {
+	return len(na.OnionPublicKey) != 0
+}
+
+// Host returns the host part of the address: its IP, or its onion host for
+// addresses of Tor onion services
+func (na *NetAddress) Host() string {
+	if na.IsOnion() {
+		return OnionHost(na.OnionPublicKey)
+	}
+	return na.IP.String()
 }
 
 // TCPAddress converts the NetAddress to *net.TCPAddr
@@ -59,6 +95,103 @@ func NewNetAddress(addr *net.TCPAddr) *NetAddress {
 	return NewNetAddressIPPort(addr.IP, uint16(addr.Port))
 }
 
+// NewNetAddressOnion returns a new NetAddress of the Tor v3 onion service with
+// the given public key and port
+func NewNetAddressOnion(onionPublicKey []byte, port uint16) *NetAddress {
+	return &NetAddress{
+		Timestamp:      mstime.Now(),
+		OnionPublicKey: onionPublicKey,
+		Port:           port,
+	}
+}
+
+// NewNetAddressFromString returns a new NetAddress from a host:port string,
+// where host is either an IP or a Tor v3 onion host
+func NewNetAddressFromString(address string) (*NetAddress, error) {
+	host, portString, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid port in address %s", address)
+	}
+
+	if IsOnionHost(host) {
+		onionPublicKey, err := ParseOnionHost(host)
+		if err != nil {
+			return nil, err
+		}
+		return NewNetAddressOnion(onionPublicKey, uint16(port)), nil
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, errors.Errorf("%s is neither an IP nor an onion host", host)
+	}
+	return NewNetAddressIPPort(ip, uint16(port)), nil
+}
+
 func (na NetAddress) String() string {
+	if na.IsOnion() {
+		return net.JoinHostPort(na.Host(), strconv.Itoa(int(na.Port)))
+	}
 	return na.TCPAddress().String()
 }
+
+// Network returns the name of the network of the address, which is always "tcp".
+// Together with String, it makes NetAddress implement net.Addr
+func (na NetAddress) Network() string {
+	return "tcp"
+}
+
+// IsOnionHost returns whether the given host is an onion host. It doesn't
+// check whether the host is a valid Tor v3 onion host
+func IsOnionHost(host string) bool {
+	return strings.HasSuffix(strings.ToLower(host), onionSuffix)
+}
+
+// OnionHost returns the Tor v3 onion host of the onion service with the given
+// public key, e.g. "vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmcopnpyyd.onion"
+func OnionHost(onionPublicKey []byte) string {
+	decodedHost := make([]byte, 0, onionDecodedHostSize)
+	decodedHost = append(decodedHost, onionPublicKey...)
+	decodedHost = append(decodedHost, onionChecksum(onionPublicKey)...)
+	decodedHost = append(decodedHost, onionVersion)
+	return strings.ToLower(onionEncoding.EncodeToString(decodedHost)) + onionSuffix
+}
+
+// ParseOnionHost parses a Tor v3 onion host and returns the public key of its
+// onion service. Tor v2 onion hosts are not supported.
+func ParseOnionHost(host string) ([]byte, error) {
+	if !IsOnionHost(host) {
+		return nil, errors.Errorf("%s is not an onion host", host)
+	}
+	encodedHost := strings.ToUpper(strings.TrimSuffix(strings.ToLower(host), onionSuffix))
+	decodedHost, err := onionEncoding.DecodeString(encodedHost)
+	if err != nil || len(decodedHost) != onionDecodedHostSize {
+		return nil, errors.Errorf("%s is not a valid Tor v3 onion host", host)
+	}
+
+	onionPublicKey := decodedHost[:OnionPublicKeySize]
+	checksum := decodedHost[OnionPublicKeySize : OnionPublicKeySize+onionChecksumSize]
+	version := decodedHost[OnionPublicKeySize+onionChecksumSize]
+	if version != onionVersion {
+		return nil, errors.Errorf("unsupported onion service version %d in %s", version, host)
+	}
+	expectedChecksum := onionChecksum(onionPublicKey)
+	if checksum[0] != expectedChecksum[0] || checksum[1] != expectedChecksum[1] {
+		return nil, errors.Errorf("%s has an invalid checksum", host)
+	}
+	return onionPublicKey, nil
+}
+
+// onionChecksum returns the checksum of a Tor v3 onion host as defined in
+// the Tor rend-spec-v3: SHA3-256(".onion checksum" | PUBKEY | VERSION)[:2]
+func onionChecksum(onionPublicKey []byte) []byte {
+	hash := sha3.New256()
+	hash.Write([]byte(onionChecksumPrefix))
+	hash.Write(onionPublicKey)
+	hash.Write([]byte{onionVersion})
+	return hash.Sum(nil)[:onionChecksumSize]
+}
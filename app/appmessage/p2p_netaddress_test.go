@@ -26,3 +26,48 @@ func TestNetAddress(t *testing.T) {
 			port)
 	}
 }
+
+// TestOnionNetAddress tests the parsing and formatting of Tor v3 onion addresses.
+func TestOnionNetAddress(t *testing.T) {
+	onionHost := "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion"
+
+	na, err := NewNetAddressFromString(onionHost + ":16111")
+	if err != nil {
+		t.Fatalf("NewNetAddressFromString: unexpected error: %s", err)
+	}
+	if !na.IsOnion() {
+		t.Fatalf("NewNetAddressFromString: %s is not an onion address", na)
+	}
+	if na.IP != nil {
+		t.Errorf("NewNetAddressFromString: unexpected ip %v", na.IP)
+	}
+	if na.Host() != onionHost {
+		t.Errorf("NewNetAddressFromString: wrong host - got %s, want %s", na.Host(), onionHost)
+	}
+	if na.String() != onionHost+":16111" {
+		t.Errorf("NewNetAddressFromString: wrong string - got %s, want %s", na, onionHost+":16111")
+	}
+
+	na, err = NewNetAddressFromString("127.0.0.1:16111")
+	if err != nil {
+		t.Fatalf("NewNetAddressFromString: unexpected error: %s", err)
+	}
+	if na.IsOnion() || !na.IP.Equal(net.ParseIP("127.0.0.1")) {
+		t.Errorf("NewNetAddressFromString: unexpected address %s", na)
+	}
+
+	invalidHosts := []string{
+		// Wrong checksum
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczaa.onion",
+		// Tor v2 address
+		"3g2upl4pq6kufc4m.onion",
+		// Invalid base32
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzcz01.onion",
+	}
+	for _, host := range invalidHosts {
+		_, err := ParseOnionHost(host)
+		if err == nil {
+			t.Errorf("ParseOnionHost(%s): expected an error", host)
+		}
+	}
+}
@@ -5,7 +5,10 @@ package appmessage
 type BanRequestMessage struct {
 	baseMessage
 
-	IP string
+	IP              string
+	Reason          string
+	DurationSeconds uint64
+	IsPermanent     bool
 }
 
 // Command returns the protocol command string for the message
@@ -14,9 +17,12 @@ func (msg *BanRequestMessage) Command() MessageCommand {
 }
 
 // NewBanRequestMessage returns an instance of the message
-func NewBanRequestMessage(ip string) *BanRequestMessage {
+func NewBanRequestMessage(ip string, reason string, durationSeconds uint64, isPermanent bool) *BanRequestMessage {
 	return &BanRequestMessage{
-		IP: ip,
+		IP:              ip,
+		Reason:          reason,
+		DurationSeconds: durationSeconds,
+		IsPermanent:     isPermanent,
 	}
 }
 
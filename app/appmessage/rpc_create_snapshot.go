@@ -0,0 +1,49 @@
+package appmessage
+
+// CreateSnapshotRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type CreateSnapshotRequestMessage struct {
+	baseMessage
+	Path string
+}
+
+// Command returns the protocol command string for the message
+func (msg *CreateSnapshotRequestMessage) Command() MessageCommand {
+	return CmdCreateSnapshotRequestMessage
+}
+
+// NewCreateSnapshotRequestMessage returns a instance of the message
+func NewCreateSnapshotRequestMessage(path string) *CreateSnapshotRequestMessage {
+	return &CreateSnapshotRequestMessage{
+		Path: path,
+	}
+}
+
+// CreateSnapshotResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type CreateSnapshotResponseMessage struct {
+	baseMessage
+	PruningPointHash string
+	VirtualDAAScore  uint64
+	EntryCount       uint64
+	Checksum         string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *CreateSnapshotResponseMessage) Command() MessageCommand {
+	return CmdCreateSnapshotResponseMessage
+}
+
+// NewCreateSnapshotResponseMessage returns a instance of the message
+func NewCreateSnapshotResponseMessage(pruningPointHash string, virtualDAAScore uint64,
+	entryCount uint64, checksum string) *CreateSnapshotResponseMessage {
+
+	return &CreateSnapshotResponseMessage{
+		PruningPointHash: pruningPointHash,
+		VirtualDAAScore:  virtualDAAScore,
+		EntryCount:       entryCount,
+		Checksum:         checksum,
+	}
+}
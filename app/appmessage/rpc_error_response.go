@@ -0,0 +1,86 @@
+package appmessage
+
+import "github.com/pkg/errors"
+
+// errorResponseConstructors maps every RPC request command to a function
+// that constructs its respective response, carrying the given error
+var errorResponseConstructors = map[MessageCommand]func(rpcError *RPCError) Message{
+	CmdGetCurrentNetworkRequestMessage:    func(rpcError *RPCError) Message { return &GetCurrentNetworkResponseMessage{Error: rpcError} },
+	CmdSubmitBlockRequestMessage:          func(rpcError *RPCError) Message { return &SubmitBlockResponseMessage{Error: rpcError} },
+	CmdGetBlockTemplateRequestMessage:     func(rpcError *RPCError) Message { return &GetBlockTemplateResponseMessage{Error: rpcError} },
+	CmdNotifyBlockAddedRequestMessage:     func(rpcError *RPCError) Message { return &NotifyBlockAddedResponseMessage{Error: rpcError} },
+	CmdGetPeerAddressesRequestMessage:     func(rpcError *RPCError) Message { return &GetPeerAddressesResponseMessage{Error: rpcError} },
+	CmdGetSelectedTipHashRequestMessage:   func(rpcError *RPCError) Message { return &GetSelectedTipHashResponseMessage{Error: rpcError} },
+	CmdGetMempoolEntryRequestMessage:      func(rpcError *RPCError) Message { return &GetMempoolEntryResponseMessage{Error: rpcError} },
+	CmdGetConnectedPeerInfoRequestMessage: func(rpcError *RPCError) Message { return &GetConnectedPeerInfoResponseMessage{Error: rpcError} },
+	CmdAddPeerRequestMessage:              func(rpcError *RPCError) Message { return &AddPeerResponseMessage{Error: rpcError} },
+	CmdSubmitTransactionRequestMessage:    func(rpcError *RPCError) Message { return &SubmitTransactionResponseMessage{Error: rpcError} },
+	CmdNotifyVirtualSelectedParentChainChangedRequestMessage: func(rpcError *RPCError) Message {
+		return &NotifyVirtualSelectedParentChainChangedResponseMessage{Error: rpcError}
+	},
+	CmdGetBlockRequestMessage:      func(rpcError *RPCError) Message { return &GetBlockResponseMessage{Error: rpcError} },
+	CmdGetSubnetworkRequestMessage: func(rpcError *RPCError) Message { return &GetSubnetworkResponseMessage{Error: rpcError} },
+	CmdGetVirtualSelectedParentChainFromBlockRequestMessage: func(rpcError *RPCError) Message {
+		return &GetVirtualSelectedParentChainFromBlockResponseMessage{Error: rpcError}
+	},
+	CmdGetBlocksRequestMessage:                 func(rpcError *RPCError) Message { return &GetBlocksResponseMessage{Error: rpcError} },
+	CmdGetBlockCountRequestMessage:             func(rpcError *RPCError) Message { return &GetBlockCountResponseMessage{Error: rpcError} },
+	CmdGetBlockDAGInfoRequestMessage:           func(rpcError *RPCError) Message { return &GetBlockDAGInfoResponseMessage{Error: rpcError} },
+	CmdResolveFinalityConflictRequestMessage:   func(rpcError *RPCError) Message { return &ResolveFinalityConflictResponseMessage{Error: rpcError} },
+	CmdNotifyFinalityConflictsRequestMessage:   func(rpcError *RPCError) Message { return &NotifyFinalityConflictsResponseMessage{Error: rpcError} },
+	CmdGetMempoolEntriesRequestMessage:         func(rpcError *RPCError) Message { return &GetMempoolEntriesResponseMessage{Error: rpcError} },
+	CmdShutDownRequestMessage:                  func(rpcError *RPCError) Message { return &ShutDownResponseMessage{Error: rpcError} },
+	CmdGetHeadersRequestMessage:                func(rpcError *RPCError) Message { return &GetHeadersResponseMessage{Error: rpcError} },
+	CmdNotifyUTXOsChangedRequestMessage:        func(rpcError *RPCError) Message { return &NotifyUTXOsChangedResponseMessage{Error: rpcError} },
+	CmdStopNotifyingUTXOsChangedRequestMessage: func(rpcError *RPCError) Message { return &StopNotifyingUTXOsChangedResponseMessage{Error: rpcError} },
+	CmdGetUTXOsByAddressesRequestMessage:       func(rpcError *RPCError) Message { return &GetUTXOsByAddressesResponseMessage{Error: rpcError} },
+	CmdGetBalanceByAddressRequestMessage:       func(rpcError *RPCError) Message { return &GetBalanceByAddressResponseMessage{Error: rpcError} },
+	CmdGetVirtualSelectedParentBlueScoreRequestMessage: func(rpcError *RPCError) Message {
+		return &GetVirtualSelectedParentBlueScoreResponseMessage{Error: rpcError}
+	},
+	CmdNotifyVirtualSelectedParentBlueScoreChangedRequestMessage: func(rpcError *RPCError) Message {
+		return &NotifyVirtualSelectedParentBlueScoreChangedResponseMessage{Error: rpcError}
+	},
+	CmdBanRequestMessage:     func(rpcError *RPCError) Message { return &BanResponseMessage{Error: rpcError} },
+	CmdUnbanRequestMessage:   func(rpcError *RPCError) Message { return &UnbanResponseMessage{Error: rpcError} },
+	CmdGetInfoRequestMessage: func(rpcError *RPCError) Message { return &GetInfoResponseMessage{Error: rpcError} },
+	CmdNotifyPruningPointUTXOSetOverrideRequestMessage: func(rpcError *RPCError) Message {
+		return &NotifyPruningPointUTXOSetOverrideResponseMessage{Error: rpcError}
+	},
+	CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage: func(rpcError *RPCError) Message {
+		return &StopNotifyingPruningPointUTXOSetOverrideResponseMessage{Error: rpcError}
+	},
+	CmdEstimateNetworkHashesPerSecondRequestMessage: func(rpcError *RPCError) Message {
+		return &EstimateNetworkHashesPerSecondResponseMessage{Error: rpcError}
+	},
+	CmdNotifyVirtualDaaScoreChangedRequestMessage: func(rpcError *RPCError) Message { return &NotifyVirtualDaaScoreChangedResponseMessage{Error: rpcError} },
+	CmdGetBalancesByAddressesRequestMessage:       func(rpcError *RPCError) Message { return &GetBalancesByAddressesResponseMessage{Error: rpcError} },
+	CmdNotifyNewBlockTemplateRequestMessage:       func(rpcError *RPCError) Message { return &NotifyNewBlockTemplateResponseMessage{Error: rpcError} },
+	CmdNotifyMempoolChangedRequestMessage:         func(rpcError *RPCError) Message { return &NotifyMempoolChangedResponseMessage{Error: rpcError} },
+	CmdNotifyVirtualChainChangedV2RequestMessage: func(rpcError *RPCError) Message {
+		return &NotifyVirtualChainChangedV2ResponseMessage{Error: rpcError}
+	},
+	CmdGetMempoolEntriesByAddressesRequestMessage: func(rpcError *RPCError) Message { return &GetMempoolEntriesByAddressesResponseMessage{Error: rpcError} },
+	CmdGetCoinSupplyRequestMessage:                func(rpcError *RPCError) Message { return &GetCoinSupplyResponseMessage{Error: rpcError} },
+	CmdGetTransactionRequestMessage:               func(rpcError *RPCError) Message { return &GetTransactionResponseMessage{Error: rpcError} },
+	CmdGetTransactionAcceptanceRequestMessage:     func(rpcError *RPCError) Message { return &GetTransactionAcceptanceResponseMessage{Error: rpcError} },
+	CmdGetAddressTransactionsRequestMessage:       func(rpcError *RPCError) Message { return &GetAddressTransactionsResponseMessage{Error: rpcError} },
+	CmdGetFeeEstimateRequestMessage:               func(rpcError *RPCError) Message { return &GetFeeEstimateResponseMessage{Error: rpcError} },
+	CmdSubmitTransactionReplacementRequestMessage: func(rpcError *RPCError) Message { return &SubmitTransactionReplacementResponseMessage{Error: rpcError} },
+	CmdSaveMempoolRequestMessage:                  func(rpcError *RPCError) Message { return &SaveMempoolResponseMessage{Error: rpcError} },
+	CmdGetLogLevelsRequestMessage:                 func(rpcError *RPCError) Message { return &GetLogLevelsResponseMessage{Error: rpcError} },
+	CmdSetLogLevelRequestMessage:                  func(rpcError *RPCError) Message { return &SetLogLevelResponseMessage{Error: rpcError} },
+	CmdCreateSnapshotRequestMessage:               func(rpcError *RPCError) Message { return &CreateSnapshotResponseMessage{Error: rpcError} },
+	CmdListBannedRequestMessage:                   func(rpcError *RPCError) Message { return &ListBannedResponseMessage{Error: rpcError} },
+	CmdImportBansRequestMessage:                   func(rpcError *RPCError) Message { return &ImportBansResponseMessage{Error: rpcError} },
+}
+
+// NewErrorResponseMessage returns the response to a request with the given
+// command, carrying only the given error
+func NewErrorResponseMessage(requestCommand MessageCommand, rpcError *RPCError) (Message, error) {
+	errorResponseConstructor, ok := errorResponseConstructors[requestCommand]
+	if !ok {
+		return nil, errors.Errorf("%s is not an RPC request command", requestCommand)
+	}
+	return errorResponseConstructor(rpcError), nil
+}
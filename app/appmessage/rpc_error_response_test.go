@@ -0,0 +1,30 @@
+package appmessage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewErrorResponseMessage(t *testing.T) {
+	for command, commandString := range RPCMessageCommandToString {
+		if !strings.HasSuffix(commandString, "Request") {
+			_, err := NewErrorResponseMessage(command, RPCErrorf("error"))
+			if err == nil {
+				t.Errorf("NewErrorResponseMessage unexpectedly succeeded for non-request command %s", commandString)
+			}
+			continue
+		}
+
+		response, err := NewErrorResponseMessage(command, RPCErrorf("error"))
+		if err != nil {
+			t.Errorf("NewErrorResponseMessage failed for %s: %s", commandString, err)
+			continue
+		}
+		expectedResponseCommandString := strings.TrimSuffix(commandString, "Request") + "Response"
+		responseCommandString := RPCMessageCommandToString[response.Command()]
+		if responseCommandString != expectedResponseCommandString {
+			t.Errorf("Unexpected response for %s: expected %s but got %s",
+				commandString, expectedResponseCommandString, responseCommandString)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package appmessage
+
+// GetAddressTransactionsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAddressTransactionsRequestMessage struct {
+	baseMessage
+	Addresses     []string
+	StartDAAScore uint64
+	Limit         uint32
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAddressTransactionsRequestMessage) Command() MessageCommand {
+	return CmdGetAddressTransactionsRequestMessage
+}
+
+// NewGetAddressTransactionsRequestMessage returns a instance of the message
+func NewGetAddressTransactionsRequestMessage(addresses []string, startDAAScore uint64,
+	limit uint32) *GetAddressTransactionsRequestMessage {
+
+	return &GetAddressTransactionsRequestMessage{
+		Addresses:     addresses,
+		StartDAAScore: startDAAScore,
+		Limit:         limit,
+	}
+}
+
+// AddressTransaction represents a transaction that created or spent
+// outputs of an address
+type AddressTransaction struct {
+	Address                string
+	TransactionID          string
+	AcceptingBlockHash     string
+	AcceptingBlockDAAScore uint64
+	CreatedOutputs         bool
+	SpentOutputs           bool
+}
+
+// GetAddressTransactionsResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAddressTransactionsResponseMessage struct {
+	baseMessage
+	Entries      []*AddressTransaction
+	NextDAAScore uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAddressTransactionsResponseMessage) Command() MessageCommand {
+	return CmdGetAddressTransactionsResponseMessage
+}
+
+// NewGetAddressTransactionsResponseMessage returns a instance of the message
+func NewGetAddressTransactionsResponseMessage(entries []*AddressTransaction,
+	nextDAAScore uint64) *GetAddressTransactionsResponseMessage {
+
+	return &GetAddressTransactionsResponseMessage{
+		Entries:      entries,
+		NextDAAScore: nextDAAScore,
+	}
+}
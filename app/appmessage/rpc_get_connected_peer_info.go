@@ -47,4 +47,12 @@ type GetConnectedPeerInfoMessage struct {
 	AdvertisedProtocolVersion uint32
 	TimeConnected             int64
 	IsIBDPeer                 bool
+	MisbehaviorScore          uint32
+	MisbehaviorCategoryScores []*MisbehaviorCategoryScore
+}
+
+// MisbehaviorCategoryScore is the misbehavior score of a peer in a single category
+type MisbehaviorCategoryScore struct {
+	Category string
+	Score    uint32
 }
@@ -0,0 +1,44 @@
+package appmessage
+
+// GetLogLevelsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetLogLevelsRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetLogLevelsRequestMessage) Command() MessageCommand {
+	return CmdGetLogLevelsRequestMessage
+}
+
+// NewGetLogLevelsRequestMessage returns a instance of the message
+func NewGetLogLevelsRequestMessage() *GetLogLevelsRequestMessage {
+	return &GetLogLevelsRequestMessage{}
+}
+
+// GetLogLevelsResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetLogLevelsResponseMessage struct {
+	baseMessage
+	SubsystemLogLevels []*SubsystemLogLevel
+
+	Error *RPCError
+}
+
+// SubsystemLogLevel is the log level of a single logging subsystem
+type SubsystemLogLevel struct {
+	Subsystem string
+	LogLevel  string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetLogLevelsResponseMessage) Command() MessageCommand {
+	return CmdGetLogLevelsResponseMessage
+}
+
+// NewGetLogLevelsResponseMessage returns a instance of the message
+func NewGetLogLevelsResponseMessage(subsystemLogLevels []*SubsystemLogLevel) *GetLogLevelsResponseMessage {
+	return &GetLogLevelsResponseMessage{
+		SubsystemLogLevels: subsystemLogLevels,
+	}
+}
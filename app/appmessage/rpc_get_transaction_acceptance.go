@@ -0,0 +1,53 @@
+package appmessage
+
+// GetTransactionAcceptanceRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionAcceptanceRequestMessage struct {
+	baseMessage
+	TransactionIDs []string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionAcceptanceRequestMessage) Command() MessageCommand {
+	return CmdGetTransactionAcceptanceRequestMessage
+}
+
+// NewGetTransactionAcceptanceRequestMessage returns a instance of the message
+func NewGetTransactionAcceptanceRequestMessage(transactionIDs []string) *GetTransactionAcceptanceRequestMessage {
+	return &GetTransactionAcceptanceRequestMessage{
+		TransactionIDs: transactionIDs,
+	}
+}
+
+// TransactionAcceptance represents the acceptance status of a single transaction
+type TransactionAcceptance struct {
+	TransactionID           string
+	IsAccepted              bool
+	IncludingBlockHash      string
+	AcceptingBlockHash      string
+	AcceptingBlockBlueScore uint64
+	Confirmations           uint64
+}
+
+// GetTransactionAcceptanceResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionAcceptanceResponseMessage struct {
+	baseMessage
+	TransactionAcceptances []*TransactionAcceptance
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionAcceptanceResponseMessage) Command() MessageCommand {
+	return CmdGetTransactionAcceptanceResponseMessage
+}
+
+// NewGetTransactionAcceptanceResponseMessage returns a instance of the message
+func NewGetTransactionAcceptanceResponseMessage(
+	transactionAcceptances []*TransactionAcceptance) *GetTransactionAcceptanceResponseMessage {
+
+	return &GetTransactionAcceptanceResponseMessage{
+		TransactionAcceptances: transactionAcceptances,
+	}
+}
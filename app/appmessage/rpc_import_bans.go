@@ -0,0 +1,43 @@
+package appmessage
+
+// ImportBansRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type ImportBansRequestMessage struct {
+	baseMessage
+	Bans []*RPCBan
+}
+
+// Command returns the protocol command string for the message
+func (msg *ImportBansRequestMessage) Command() MessageCommand {
+	return CmdImportBansRequestMessage
+}
+
+// NewImportBansRequestMessage returns an instance of the message
+func NewImportBansRequestMessage(bans []*RPCBan) *ImportBansRequestMessage {
+	return &ImportBansRequestMessage{
+		Bans: bans,
+	}
+}
+
+// ImportBansResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type ImportBansResponseMessage struct {
+	baseMessage
+	ImportedCount  uint32
+	SkippedSubnets []string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *ImportBansResponseMessage) Command() MessageCommand {
+	return CmdImportBansResponseMessage
+}
+
+// NewImportBansResponseMessage returns an instance of the message
+func NewImportBansResponseMessage(importedCount uint32, skippedSubnets []string) *ImportBansResponseMessage {
+	return &ImportBansResponseMessage{
+		ImportedCount:  importedCount,
+		SkippedSubnets: skippedSubnets,
+	}
+}
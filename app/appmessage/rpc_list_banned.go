@@ -0,0 +1,48 @@
+package appmessage
+
+// ListBannedRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type ListBannedRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *ListBannedRequestMessage) Command() MessageCommand {
+	return CmdListBannedRequestMessage
+}
+
+// NewListBannedRequestMessage returns an instance of the message
+func NewListBannedRequestMessage() *ListBannedRequestMessage {
+	return &ListBannedRequestMessage{}
+}
+
+// ListBannedResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type ListBannedResponseMessage struct {
+	baseMessage
+	Bans []*RPCBan
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *ListBannedResponseMessage) Command() MessageCommand {
+	return CmdListBannedResponseMessage
+}
+
+// NewListBannedResponseMessage returns an instance of the message
+func NewListBannedResponseMessage(bans []*RPCBan) *ListBannedResponseMessage {
+	return &ListBannedResponseMessage{
+		Bans: bans,
+	}
+}
+
+// RPCBan is a ban of a single IP or of a subnet, as used by the RPC
+type RPCBan struct {
+	Subnet    string
+	Reason    string
+	CreatedAt int64
+
+	// ExpiresAt is 0 for bans that never expire
+	ExpiresAt int64
+}
@@ -74,7 +74,7 @@ type StopNotifyingPruningPointUTXOSetOverrideResponseMessage struct {
 
 // Command returns the protocol command string for the message
 func (msg *StopNotifyingPruningPointUTXOSetOverrideResponseMessage) Command() MessageCommand {
-	return CmdNotifyPruningPointUTXOSetOverrideResponseMessage
+	return CmdStopNotifyingPruningPointUTXOSetOverrideResponseMessage
 }
 
 // NewStopNotifyingPruningPointUTXOSetOverrideResponseMessage returns a instance of the message
@@ -0,0 +1,84 @@
+package appmessage
+
+// NotifyVirtualChainChangedV2RequestMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyVirtualChainChangedV2RequestMessage struct {
+	baseMessage
+	StartHash string
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyVirtualChainChangedV2RequestMessage) Command() MessageCommand {
+	return CmdNotifyVirtualChainChangedV2RequestMessage
+}
+
+// NewNotifyVirtualChainChangedV2RequestMessage returns a instance of the message
+func NewNotifyVirtualChainChangedV2RequestMessage(startHash string) *NotifyVirtualChainChangedV2RequestMessage {
+	return &NotifyVirtualChainChangedV2RequestMessage{
+		StartHash: startHash,
+	}
+}
+
+// NotifyVirtualChainChangedV2ResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyVirtualChainChangedV2ResponseMessage struct {
+	baseMessage
+	StartHash string
+	Error     *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyVirtualChainChangedV2ResponseMessage) Command() MessageCommand {
+	return CmdNotifyVirtualChainChangedV2ResponseMessage
+}
+
+// NewNotifyVirtualChainChangedV2ResponseMessage returns a instance of the message
+func NewNotifyVirtualChainChangedV2ResponseMessage(startHash string) *NotifyVirtualChainChangedV2ResponseMessage {
+	return &NotifyVirtualChainChangedV2ResponseMessage{
+		StartHash: startHash,
+	}
+}
+
+// VirtualChainChangedV2NotificationMessage is an appmessage corresponding to
+// its respective RPC message
+type VirtualChainChangedV2NotificationMessage struct {
+	baseMessage
+	RemovedChainBlocks []*RPCRemovedChainBlock
+	AddedChainBlocks   []*RPCAddedChainBlock
+}
+
+// RPCRemovedChainBlock is a block that was removed from the selected parent chain,
+// along with the transactions that it no longer accepts
+type RPCRemovedChainBlock struct {
+	Hash                     string
+	UnacceptedTransactionIDs []string
+}
+
+// RPCAddedChainBlock is a block that was added to the selected parent chain,
+// along with the transactions that it accepts
+type RPCAddedChainBlock struct {
+	Hash                 string
+	AcceptedTransactions []*RPCChainAcceptedTransaction
+}
+
+// RPCChainAcceptedTransaction is a transaction accepted by a chain block
+type RPCChainAcceptedTransaction struct {
+	TransactionID          string
+	InputPreviousOutpoints []*RPCOutpoint
+	Fee                    uint64
+}
+
+// Command returns the protocol command string for the message
+func (msg *VirtualChainChangedV2NotificationMessage) Command() MessageCommand {
+	return CmdVirtualChainChangedV2NotificationMessage
+}
+
+// NewVirtualChainChangedV2NotificationMessage returns a instance of the message
+func NewVirtualChainChangedV2NotificationMessage(removedChainBlocks []*RPCRemovedChainBlock,
+	addedChainBlocks []*RPCAddedChainBlock) *VirtualChainChangedV2NotificationMessage {
+
+	return &VirtualChainChangedV2NotificationMessage{
+		RemovedChainBlocks: removedChainBlocks,
+		AddedChainBlocks:   addedChainBlocks,
+	}
+}
@@ -0,0 +1,38 @@
+package appmessage
+
+// SaveMempoolRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type SaveMempoolRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *SaveMempoolRequestMessage) Command() MessageCommand {
+	return CmdSaveMempoolRequestMessage
+}
+
+// NewSaveMempoolRequestMessage returns a instance of the message
+func NewSaveMempoolRequestMessage() *SaveMempoolRequestMessage {
+	return &SaveMempoolRequestMessage{}
+}
+
+// SaveMempoolResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type SaveMempoolResponseMessage struct {
+	baseMessage
+	TransactionCount uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *SaveMempoolResponseMessage) Command() MessageCommand {
+	return CmdSaveMempoolResponseMessage
+}
+
+// NewSaveMempoolResponseMessage returns a instance of the message
+func NewSaveMempoolResponseMessage(transactionCount uint64) *SaveMempoolResponseMessage {
+	return &SaveMempoolResponseMessage{
+		TransactionCount: transactionCount,
+	}
+}
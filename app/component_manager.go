@@ -11,10 +11,13 @@ import (
 	"github.com/kaspanet/kaspad/app/protocol"
 	"github.com/kaspanet/kaspad/app/rpc"
 	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/addressindex"
 	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/txindex"
 	"github.com/kaspanet/kaspad/domain/utxoindex"
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	infrastructuredatabase "github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
 	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
@@ -30,6 +33,7 @@ type ComponentManager struct {
 	rpcManager        *rpc.Manager
 	connectionManager *connmanager.ConnectionManager
 	netAdapter        *netadapter.NetAdapter
+	mempoolPersister  *mempoolPersister
 
 	started, shutdown int32
 }
@@ -49,6 +53,14 @@ func (a *ComponentManager) Start() {
 	}
 
 	a.connectionManager.Start()
+
+	if a.cfg.Metrics != "" {
+		metrics.Start(a.cfg.Metrics)
+	}
+
+	if a.mempoolPersister != nil {
+		a.mempoolPersister.start()
+	}
 }
 
 // Stop gracefully shuts down all the kaspad services.
@@ -68,6 +80,12 @@ func (a *ComponentManager) Stop() {
 		log.Errorf("Error stopping the net adapter: %+v", err)
 	}
 
+	// The mempool is saved only after the connections are closed, so that no new
+	// transactions are missed
+	if a.mempoolPersister != nil {
+		a.mempoolPersister.stop()
+	}
+
 	a.protocolManager.Close()
 	close(a.protocolManager.Context().Domain().ConsensusEventsChannel())
 
@@ -87,12 +105,30 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 	mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
 	mempoolConfig.MaximumOrphanTransactionCount = cfg.MaxOrphanTxs
 	mempoolConfig.MinimumRelayTransactionFee = cfg.MinRelayTxFee
+	mempoolConfig.RandomizedBlockTemplateSelection = cfg.RandomTxSelection
 
 	domain, err := domain.New(&consensusConfig, mempoolConfig, db)
 	if err != nil {
 		return nil, err
 	}
 
+	if cfg.RestoreSnapshot != "" {
+		err = validateRestoredSnapshot(cfg, domain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var mempoolPersister *mempoolPersister
+	if cfg.PersistMempool {
+		mempoolPersister = newMempoolPersister(cfg, domain)
+		err = mempoolPersister.load()
+		if err != nil {
+			// A corrupted mempool file shouldn't prevent kaspad from starting
+			log.Warnf("Error loading the mempool from %s: %+v", cfg.MempoolFile, err)
+		}
+	}
+
 	netAdapter, err := netadapter.NewNetAdapter(cfg)
 	if err != nil {
 		return nil, err
@@ -113,6 +149,26 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		log.Infof("UTXO index started")
 	}
 
+	var txIndex *txindex.TXIndex
+	if cfg.TxIndex {
+		txIndex, err = txindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("TX index started")
+	}
+
+	var addressIndex *addressindex.AddressIndex
+	if cfg.AddressIndex {
+		addressIndex, err = addressindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Address index started")
+	}
+
 	connectionManager, err := connmanager.New(cfg, netAdapter, addressManager)
 	if err != nil {
 		return nil, err
@@ -121,7 +177,13 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 	if err != nil {
 		return nil, err
 	}
-	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, domain.ConsensusEventsChannel(), interrupt)
+	if cfg.Metrics != "" {
+		addMetricsCollectors(domain, db, protocolManager, connectionManager)
+	}
+	rpcManager, err := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, txIndex, addressIndex, domain.ConsensusEventsChannel(), interrupt)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ComponentManager{
 		cfg:               cfg,
@@ -130,6 +192,7 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		connectionManager: connectionManager,
 		netAdapter:        netAdapter,
 		addressManager:    addressManager,
+		mempoolPersister:  mempoolPersister,
 	}, nil
 
 }
@@ -142,11 +205,13 @@ func setupRPC(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressIndex *addressindex.AddressIndex,
 	consensusEventsChan chan externalapi.ConsensusEvent,
 	shutDownChan chan<- struct{},
-) *rpc.Manager {
+) (*rpc.Manager, error) {
 
-	rpcManager := rpc.NewManager(
+	rpcManager, err := rpc.NewManager(
 		cfg,
 		domain,
 		netAdapter,
@@ -154,13 +219,19 @@ func setupRPC(
 		connectionManager,
 		addressManager,
 		utxoIndex,
+		txIndex,
+		addressIndex,
 		consensusEventsChan,
 		shutDownChan,
 	)
+	if err != nil {
+		return nil, err
+	}
 	protocolManager.SetOnNewBlockTemplateHandler(rpcManager.NotifyNewBlockTemplate)
 	protocolManager.SetOnPruningPointUTXOSetOverrideHandler(rpcManager.NotifyPruningPointUTXOSetOverride)
+	domain.MiningManager().SetOnMempoolChangedHandler(rpcManager.NotifyMempoolChanged)
 
-	return rpcManager
+	return rpcManager, nil
 }
 
 // P2PNodeID returns the network ID associated with this ComponentManager
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"time"
+
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/util/panics"
+)
+
+// mempoolPersister reloads the mempool on startup, and saves it periodically and on shutdown
+type mempoolPersister struct {
+	cfg    *config.Config
+	domain domain.Domain
+
+	quit chan struct{}
+	done chan struct{}
+}
+
+func newMempoolPersister(cfg *config.Config, domain domain.Domain) *mempoolPersister {
+	return &mempoolPersister{
+		cfg:    cfg,
+		domain: domain,
+		quit:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+func (mp *mempoolPersister) load() error {
+	transactionCount, err := mp.domain.MiningManager().LoadMempool(mp.cfg.MempoolFile)
+	if err != nil {
+		return err
+	}
+	log.Infof("Loaded %d transactions from %s into the mempool", transactionCount, mp.cfg.MempoolFile)
+	return nil
+}
+
+func (mp *mempoolPersister) save() {
+	transactionCount, err := mp.domain.MiningManager().SaveMempool(mp.cfg.MempoolFile)
+	if err != nil {
+		log.Errorf("Error saving the mempool to %s: %+v", mp.cfg.MempoolFile, err)
+		return
+	}
+	log.Debugf("Saved %d mempool transactions to %s", transactionCount, mp.cfg.MempoolFile)
+}
+
+func (mp *mempoolPersister) start() {
+	spawn := panics.GoroutineWrapperFunc(log)
+	spawn("mempoolPersister.saveLoop", mp.saveLoop)
+}
+
+func (mp *mempoolPersister) saveLoop() {
+	defer close(mp.done)
+
+	if mp.cfg.MempoolSaveInterval == 0 {
+		<-mp.quit
+		return
+	}
+
+	ticker := time.NewTicker(mp.cfg.MempoolSaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			mp.save()
+		case <-mp.quit:
+			return
+		}
+	}
+}
+
+// stop stops saving the mempool periodically, and saves it one last time
+func (mp *mempoolPersister) stop() {
+	close(mp.quit)
+	<-mp.done
+
+	mp.save()
+	log.Infof("Saved the mempool to %s", mp.cfg.MempoolFile)
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"strconv"
+
+	"github.com/kaspanet/kaspad/app/protocol"
+	"github.com/kaspanet/kaspad/domain"
+	infrastructuredatabase "github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
+	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
+)
+
+// addMetricsCollectors adds collectors that sample the state of kaspad's components
+// every time the metrics are scraped
+func addMetricsCollectors(domain domain.Domain, db infrastructuredatabase.Database,
+	protocolManager *protocol.Manager, connectionManager *connmanager.ConnectionManager) {
+
+	metrics.AddCollector(func() {
+		virtualDAAScore, err := domain.Consensus().GetVirtualDAAScore()
+		if err != nil {
+			log.Warnf("Error getting the virtual DAA score for metrics: %s", err)
+			return
+		}
+		metrics.VirtualDAAScore.Set(float64(virtualDAAScore))
+	})
+
+	metrics.AddCollector(func() {
+		miningManager := domain.MiningManager()
+		metrics.MempoolTransactions.Set(float64(miningManager.TransactionCount(true, false)))
+		metrics.MempoolOrphanTransactions.Set(float64(miningManager.TransactionCount(false, true)))
+		metrics.MempoolMass.Set(float64(miningManager.TransactionPoolMass()))
+	})
+
+	metrics.AddCollector(func() {
+		inbound, outbound := connectionManager.ConnectionCountByDirection()
+		metrics.Peers.WithLabelValues(metrics.PeerDirectionInbound).Set(float64(inbound))
+		metrics.Peers.WithLabelValues(metrics.PeerDirectionOutbound).Set(float64(outbound))
+	})
+
+	metrics.AddCollector(func() {
+		ibdRunning := 0.0
+		if protocolManager.Context().IsIBDRunning() {
+			ibdRunning = 1
+		}
+		metrics.IBDRunning.Set(ibdRunning)
+	})
+
+	levelDB, ok := db.(*ldb.LevelDB)
+	if !ok {
+		return
+	}
+	metrics.AddCollector(func() {
+		stats, err := levelDB.Stats()
+		if err != nil {
+			log.Warnf("Error getting the LevelDB stats for metrics: %s", err)
+			return
+		}
+		metrics.LevelDBReadBytes.Set(float64(stats.IORead))
+		metrics.LevelDBWrittenBytes.Set(float64(stats.IOWrite))
+		metrics.LevelDBBlockCacheBytes.Set(float64(stats.BlockCacheSize))
+		metrics.LevelDBOpenTables.Set(float64(stats.OpenedTablesCount))
+		metrics.LevelDBWriteDelays.Set(float64(stats.WriteDelayCount))
+		for level, size := range stats.LevelSizes {
+			metrics.LevelDBLevelSizeBytes.WithLabelValues(strconv.Itoa(level)).Set(float64(size))
+		}
+		for level, tableCount := range stats.LevelTablesCounts {
+			metrics.LevelDBLevelTables.WithLabelValues(strconv.Itoa(level)).Set(float64(tableCount))
+		}
+	})
+}
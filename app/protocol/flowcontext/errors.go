@@ -13,6 +13,9 @@ import (
 var (
 	// ErrPingTimeout signifies that a ping operation timed out.
 	ErrPingTimeout = protocolerrors.New(false, "timeout expired on ping")
+
+	// ErrBanThresholdReached signifies that the misbehavior score of a peer reached --banthreshold.
+	ErrBanThresholdReached = errors.New("misbehavior score reached the ban threshold")
 )
 
 // HandleError handles an error from a flow,
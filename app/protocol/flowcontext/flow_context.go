@@ -55,6 +55,8 @@ type FlowContext struct {
 	peers      map[id.ID]*peerpkg.Peer
 	peersMutex sync.RWMutex
 
+	misbehaviorTracker *peerpkg.MisbehaviorTracker
+
 	orphans      map[externalapi.DomainHash]*externalapi.DomainBlock
 	orphansMutex sync.RWMutex
 
@@ -78,6 +80,7 @@ func New(cfg *config.Config, domain domain.Domain, addressManager *addressmanage
 		sharedRequestedTransactions:      NewSharedRequestedTransactions(),
 		sharedRequestedBlocks:            NewSharedRequestedBlocks(),
 		peers:                            make(map[id.ID]*peerpkg.Peer),
+		misbehaviorTracker:               peerpkg.NewMisbehaviorTracker(),
 		orphans:                          make(map[externalapi.DomainHash]*externalapi.DomainBlock),
 		timeStarted:                      mstime.Now().UnixMilliseconds(),
 		transactionIDsToPropagate:        []*externalapi.DomainTransactionID{},
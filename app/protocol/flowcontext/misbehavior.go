@@ -0,0 +1,69 @@
+package flowcontext
+
+import (
+	peerpkg "github.com/kaspanet/kaspad/app/protocol/peer"
+	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
+)
+
+// MisbehaviorTracker returns the tracker of the misbehavior scores of peers
+func (f *FlowContext) MisbehaviorTracker() *peerpkg.MisbehaviorTracker {
+	return f.misbehaviorTracker
+}
+
+// MisbehaviorKey returns the key by which the misbehavior score of the given connection is
+// kept. Onion peers have no IP, so they're keyed by their onion address. All inbound connections
+// through our own onion service come from the local Tor daemon, so they can't be told apart
+// across reconnections, and have no key. All other peers are keyed by IP
+func (f *FlowContext) MisbehaviorKey(netConnection *netadapter.NetConnection) (key string, hasKey bool) {
+	netAddress := netConnection.NetAddress()
+	if netAddress.IsOnion() {
+		return netAddress.Host(), true
+	}
+	if f.cfg.Onion != "" && netAddress.IP.IsLoopback() && !netConnection.IsOutbound() {
+		return "", false
+	}
+	return netAddress.IP.String(), true
+}
+
+// MisbehaviorScore returns the misbehavior score of the given connection
+func (f *FlowContext) MisbehaviorScore(netConnection *netadapter.NetConnection) uint32 {
+	key, hasKey := f.MisbehaviorKey(netConnection)
+	if !hasKey {
+		return 0
+	}
+	return f.misbehaviorTracker.Score(key)
+}
+
+// AddMisbehaviorScore adds an occurrence of the given misbehavior to the score of the given
+// connection, and returns whether the score reached --banthreshold.
+// Connections without a misbehavior key can't be scored, since a score kept per connection
+// would be reset by reconnecting, so they reach the threshold on their first misbehavior.
+// Such connections are never banned, so this only gets them disconnected
+func (f *FlowContext) AddMisbehaviorScore(netConnection *netadapter.NetConnection,
+	category peerpkg.MisbehaviorCategory, reason string) (isBanThresholdReached bool) {
+
+	key, hasKey := f.MisbehaviorKey(netConnection)
+	if !hasKey {
+		log.WithFields(logger.Fields{logger.FieldPeer: netConnection.Address()}).
+			Debugf("%s misbehaved due to %s (reason: %s), and can't be scored", netConnection, category, reason)
+		return true
+	}
+
+	score := f.misbehaviorTracker.Add(key, category)
+	log.WithFields(logger.Fields{logger.FieldPeer: netConnection.Address()}).
+		Debugf("Misbehavior score of %s increased to %d due to %s (reason: %s)", netConnection, score, category, reason)
+
+	return score >= f.cfg.BanThreshold
+}
+
+// AddMisbehavior adds an occurrence of the given misbehavior to the score of the given peer.
+// If the score reached --banthreshold, it returns a banning ProtocolError that's expected to
+// be returned from the flow. Otherwise, the flow may carry on.
+func (f *FlowContext) AddMisbehavior(peer *peerpkg.Peer, category peerpkg.MisbehaviorCategory, reason string) error {
+	if !f.AddMisbehaviorScore(peer.Connection(), category, reason) {
+		return nil
+	}
+	return protocolerrors.Wrapf(true, ErrBanThresholdReached, "%s (last misbehavior: %s)", category, reason)
+}
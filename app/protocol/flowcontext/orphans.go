@@ -28,7 +28,8 @@ func (f *FlowContext) AddOrphan(orphanBlock *externalapi.DomainBlock) {
 		f.evictRandomOrphan()
 	}
 
-	log.Infof("Received a block with missing parents, adding to orphan pool: %s", orphanHash)
+	log.WithFields(logger.Fields{logger.FieldBlockHash: orphanHash}).
+		Infof("Received a block with missing parents, adding to orphan pool: %s", orphanHash)
 }
 
 func (f *FlowContext) evictRandomOrphan() {
@@ -1,6 +1,8 @@
 package blockrelay
 
 import (
+	"fmt"
+
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/protocol/common"
 	"github.com/kaspanet/kaspad/app/protocol/flowcontext"
@@ -13,6 +15,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/hashset"
 	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
 )
@@ -37,6 +40,7 @@ type RelayInvsContext interface {
 	IsIBDRunning() bool
 	IsRecoverableError(err error) bool
 	IsNearlySynced() (bool, error)
+	AddMisbehavior(peer *peerpkg.Peer, category peerpkg.MisbehaviorCategory, reason string) error
 }
 
 type invRelayBlock struct {
@@ -231,7 +235,11 @@ func (flow *handleRelayInvsFlow) start() error {
 			}
 		}
 
-		log.Infof("Accepted block %s via relay", inv.Hash)
+		log.WithFields(logger.Fields{
+			logger.FieldBlockHash: inv.Hash,
+			logger.FieldPeer:      flow.peer.Address(),
+			logger.FieldDAAScore:  block.Header.DAAScore(),
+		}).Infof("Accepted block %s via relay", inv.Hash)
 		err = flow.OnNewBlock(block)
 		if err != nil {
 			return err
@@ -292,7 +300,12 @@ func (flow *handleRelayInvsFlow) requestBlock(requestHash *externalapi.DomainHas
 	block := appmessage.MsgBlockToDomainBlock(msgBlock)
 	blockHash := consensushashing.BlockHash(block)
 	if !blockHash.Equal(requestHash) {
-		return nil, false, protocolerrors.Errorf(true, "got unrequested block %s", blockHash)
+		reason := fmt.Sprintf("got unrequested block %s", blockHash)
+		err := flow.AddMisbehavior(flow.peer, peerpkg.MisbehaviorUnrequestedMessage, reason)
+		if err != nil {
+			return nil, false, err
+		}
+		return nil, false, protocolerrors.New(false, reason)
 	}
 
 	return block, false, nil
@@ -333,7 +346,10 @@ func (flow *handleRelayInvsFlow) processBlock(block *externalapi.DomainBlock) ([
 		}
 		// A duplicate block should not appear to the user as a warning and is already reported in the calling function
 		if !errors.Is(err, ruleerrors.ErrDuplicateBlock) {
-			log.Warnf("Rejected block %s from %s: %s", blockHash, flow.peer, err)
+			log.WithFields(logger.Fields{
+				logger.FieldBlockHash: blockHash,
+				logger.FieldPeer:      flow.peer.Address(),
+			}).Warnf("Rejected block %s from %s: %s", blockHash, flow.peer, err)
 		}
 		return nil, protocolerrors.Wrapf(true, err, "got invalid block %s from relay", blockHash)
 	}
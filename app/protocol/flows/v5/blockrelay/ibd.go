@@ -11,6 +11,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
 	"time"
@@ -27,6 +28,7 @@ type IBDContext interface {
 	TrySetIBDRunning(ibdPeer *peerpkg.Peer) bool
 	UnsetIBDRunning()
 	IsRecoverableError(err error) bool
+	AddMisbehavior(peer *peerpkg.Peer, category peerpkg.MisbehaviorCategory, reason string) error
 }
 
 type handleIBDFlow struct {
@@ -57,6 +59,12 @@ func (flow *handleIBDFlow) start() error {
 		}
 		err := flow.runIBDIfNotRunning(block)
 		if err != nil {
+			if errors.Is(err, router.ErrTimeout) {
+				banErr := flow.AddMisbehavior(flow.peer, peerpkg.MisbehaviorSlowIBDResponse, err.Error())
+				if banErr != nil {
+					return banErr
+				}
+			}
 			return err
 		}
 	}
@@ -281,7 +289,8 @@ func (flow *handleIBDFlow) logIBDFinished(isFinishedSuccessfully bool) {
 	if !isFinishedSuccessfully {
 		successString = "(interrupted)"
 	}
-	log.Infof("IBD with peer %s finished %s", flow.peer, successString)
+	log.WithFields(logger.Fields{logger.FieldPeer: flow.peer.Address()}).
+		Infof("IBD with peer %s finished %s", flow.peer, successString)
 }
 
 func (flow *handleIBDFlow) getSyncerChainBlockLocator(
@@ -331,7 +340,8 @@ func (flow *handleIBDFlow) syncPruningPointFutureHeaders(consensus externalapi.C
 	if err != nil {
 		return err
 	}
-	progressReporter := newIBDProgressReporter(highestSharedBlockHeader.DAAScore(), highBlockDAAScoreHint, "block headers")
+	progressReporter := newIBDProgressReporter(highestSharedBlockHeader.DAAScore(), highBlockDAAScoreHint, "block headers",
+		metrics.IBDStageHeaders)
 
 	// Keep a short queue of BlockHeadersMessages so that there's
 	// never a moment when the node is not validating and inserting
@@ -497,7 +507,10 @@ func (flow *handleIBDFlow) processHeader(consensus externalapi.Consensus, msgBlo
 		if errors.Is(err, ruleerrors.ErrDuplicateBlock) {
 			log.Debugf("Skipping block header %s as it is a duplicate", blockHash)
 		} else {
-			log.Infof("Rejected block header %s from %s during IBD: %s", blockHash, flow.peer, err)
+			log.WithFields(logger.Fields{
+				logger.FieldBlockHash: blockHash,
+				logger.FieldPeer:      flow.peer.Address(),
+			}).Infof("Rejected block header %s from %s during IBD: %s", blockHash, flow.peer, err)
 			return protocolerrors.Wrapf(true, err, "got invalid block header %s during IBD", blockHash)
 		}
 	}
@@ -615,7 +628,8 @@ func (flow *handleIBDFlow) syncMissingBlockBodies(highHash *externalapi.DomainHa
 	if err != nil {
 		return err
 	}
-	progressReporter := newIBDProgressReporter(lowBlockHeader.DAAScore(), highBlockHeader.DAAScore(), "blocks")
+	progressReporter := newIBDProgressReporter(lowBlockHeader.DAAScore(), highBlockHeader.DAAScore(), "blocks",
+		metrics.IBDStageBlocks)
 	highestProcessedDAAScore := lowBlockHeader.DAAScore()
 
 	for offset := 0; offset < len(hashes); offset += ibdBatchSize {
@@ -1,23 +1,34 @@
 package blockrelay
 
+import (
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
+)
+
 type ibdProgressReporter struct {
 	lowDAAScore                 uint64
 	highDAAScore                uint64
 	objectName                  string
+	metricsStage                string
 	totalDAAScoreDifference     uint64
 	lastReportedProgressPercent int
 	processed                   int
 }
 
-func newIBDProgressReporter(lowDAAScore uint64, highDAAScore uint64, objectName string) *ibdProgressReporter {
+func newIBDProgressReporter(lowDAAScore uint64, highDAAScore uint64, objectName string,
+	metricsStage string) *ibdProgressReporter {
+
 	if highDAAScore <= lowDAAScore {
 		// Avoid a zero or negative diff
 		highDAAScore = lowDAAScore + 1
 	}
+	metrics.IBDProgress.WithLabelValues(metricsStage).Set(0)
+	metrics.IBDProcessedObjects.WithLabelValues(metricsStage).Set(0)
 	return &ibdProgressReporter{
 		lowDAAScore:                 lowDAAScore,
 		highDAAScore:                highDAAScore,
 		objectName:                  objectName,
+		metricsStage:                metricsStage,
 		totalDAAScoreDifference:     highDAAScore - lowDAAScore,
 		lastReportedProgressPercent: 0,
 		processed:                   0,
@@ -37,9 +48,14 @@ func (ipr *ibdProgressReporter) reportProgress(processedDelta int, highestProces
 		// Avoid a negative diff
 		relativeDAAScore = highestProcessedDAAScore - ipr.lowDAAScore
 	}
-	progressPercent := int((float64(relativeDAAScore) / float64(ipr.totalDAAScoreDifference)) * 100)
+	progress := float64(relativeDAAScore) / float64(ipr.totalDAAScoreDifference)
+	metrics.IBDProgress.WithLabelValues(ipr.metricsStage).Set(progress)
+	metrics.IBDProcessedObjects.WithLabelValues(ipr.metricsStage).Set(float64(ipr.processed))
+
+	progressPercent := int(progress * 100)
 	if progressPercent > ipr.lastReportedProgressPercent {
-		log.Infof("IBD: Processed %d %s (%d%%)", ipr.processed, ipr.objectName, progressPercent)
+		log.WithFields(logger.Fields{logger.FieldDAAScore: highestProcessedDAAScore}).
+			Infof("IBD: Processed %d %s (%d%%)", ipr.processed, ipr.objectName, progressPercent)
 		ipr.lastReportedProgressPercent = progressPercent
 	}
 }
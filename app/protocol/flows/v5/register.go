@@ -183,7 +183,7 @@ func registerTransactionRelayFlow(m protocolManager, router *routerpkg.Router, i
 		m.RegisterFlowWithCapacity("HandleRelayedTransactions", 10_000, router,
 			[]appmessage.MessageCommand{appmessage.CmdInvTransaction, appmessage.CmdTx, appmessage.CmdTransactionNotFound}, isStopping, errChan,
 			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
-				return transactionrelay.HandleRelayedTransactions(m.Context(), incomingRoute, outgoingRoute)
+				return transactionrelay.HandleRelayedTransactions(m.Context(), incomingRoute, outgoingRoute, peer)
 			},
 		),
 		m.RegisterFlow("HandleRequestTransactions", router,
@@ -1,9 +1,12 @@
 package transactionrelay
 
 import (
+	"fmt"
+
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/protocol/common"
 	"github.com/kaspanet/kaspad/app/protocol/flowcontext"
+	peerpkg "github.com/kaspanet/kaspad/app/protocol/peer"
 	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
 	"github.com/kaspanet/kaspad/domain"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
@@ -21,6 +24,7 @@ type TransactionsRelayContext interface {
 	Domain() domain.Domain
 	SharedRequestedTransactions() *flowcontext.SharedRequestedTransactions
 	OnTransactionAddedToMempool()
+	AddMisbehavior(peer *peerpkg.Peer, category peerpkg.MisbehaviorCategory, reason string) error
 	EnqueueTransactionIDsForPropagation(transactionIDs []*externalapi.DomainTransactionID) error
 	IsNearlySynced() (bool, error)
 }
@@ -28,16 +32,20 @@ type TransactionsRelayContext interface {
 type handleRelayedTransactionsFlow struct {
 	TransactionsRelayContext
 	incomingRoute, outgoingRoute *router.Route
+	peer                         *peerpkg.Peer
 	invsQueue                    []*appmessage.MsgInvTransaction
 }
 
 // HandleRelayedTransactions listens to appmessage.MsgInvTransaction messages, requests their corresponding transactions if they
 // are missing, adds them to the mempool and propagates them to the rest of the network.
-func HandleRelayedTransactions(context TransactionsRelayContext, incomingRoute *router.Route, outgoingRoute *router.Route) error {
+func HandleRelayedTransactions(context TransactionsRelayContext, incomingRoute *router.Route, outgoingRoute *router.Route,
+	peer *peerpkg.Peer) error {
+
 	flow := &handleRelayedTransactionsFlow{
 		TransactionsRelayContext: context,
 		incomingRoute:            incomingRoute,
 		outgoingRoute:            outgoingRoute,
+		peer:                     peer,
 		invsQueue:                make([]*appmessage.MsgInvTransaction, 0),
 	}
 	return flow.start()
@@ -170,8 +178,7 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 		}
 		if msgTxNotFound != nil {
 			if !msgTxNotFound.ID.Equal(expectedID) {
-				return protocolerrors.Errorf(true, "expected transaction %s, but got %s",
-					expectedID, msgTxNotFound.ID)
+				return flow.unrequestedTransactionError(expectedID, msgTxNotFound.ID)
 			}
 
 			continue
@@ -179,8 +186,7 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 		tx := appmessage.MsgTxToDomainTransaction(msgTx)
 		txID := consensushashing.TransactionID(tx)
 		if !txID.Equal(expectedID) {
-			return protocolerrors.Errorf(true, "expected transaction %s, but got %s",
-				expectedID, txID)
+			return flow.unrequestedTransactionError(expectedID, txID)
 		}
 
 		acceptedTransactions, err :=
@@ -191,18 +197,23 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 				return errors.Wrapf(err, "failed to process transaction %s", txID)
 			}
 
-			shouldBan := false
+			isInvalid := false
 			if txRuleErr := (&mempool.TxRuleError{}); errors.As(ruleErr.Err, txRuleErr) {
 				if txRuleErr.RejectCode == mempool.RejectInvalid {
-					shouldBan = true
+					isInvalid = true
 				}
 			}
 
-			if !shouldBan {
+			if !isInvalid {
 				continue
 			}
 
-			return protocolerrors.Errorf(true, "rejected transaction %s: %s", txID, ruleErr)
+			err := flow.AddMisbehavior(flow.peer, peerpkg.MisbehaviorInvalidTransaction,
+				fmt.Sprintf("rejected transaction %s: %s", txID, ruleErr))
+			if err != nil {
+				return err
+			}
+			continue
 		}
 		err = flow.broadcastAcceptedTransactions(consensushashing.TransactionIDs(acceptedTransactions))
 		if err != nil {
@@ -212,3 +223,16 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 	}
 	return nil
 }
+
+// unrequestedTransactionError scores the peer for sending a transaction other than the expected one,
+// and returns the error that ends the flow
+func (flow *handleRelayedTransactionsFlow) unrequestedTransactionError(
+	expectedID *externalapi.DomainTransactionID, receivedID *externalapi.DomainTransactionID) error {
+
+	reason := fmt.Sprintf("expected transaction %s, but got %s", expectedID, receivedID)
+	err := flow.AddMisbehavior(flow.peer, peerpkg.MisbehaviorUnrequestedMessage, reason)
+	if err != nil {
+		return err
+	}
+	return protocolerrors.New(false, reason)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/kaspanet/kaspad/app/protocol/flowcontext"
 	"github.com/kaspanet/kaspad/app/protocol/flows/v5/transactionrelay"
+	peerpkg "github.com/kaspanet/kaspad/app/protocol/peer"
 	"strings"
 	"testing"
 
@@ -51,6 +52,10 @@ func (m *mocTransactionsRelayContext) IsNearlySynced() (bool, error) {
 	return true, nil
 }
 
+func (m *mocTransactionsRelayContext) AddMisbehavior(*peerpkg.Peer, peerpkg.MisbehaviorCategory, string) error {
+	return nil
+}
+
 // TestHandleRelayedTransactionsNotFound tests the flow of  HandleRelayedTransactions when the peer doesn't
 // have the requested transactions in the mempool.
 func TestHandleRelayedTransactionsNotFound(t *testing.T) {
@@ -128,7 +133,7 @@ func TestHandleRelayedTransactionsNotFound(t *testing.T) {
 			}
 		})
 
-		err = transactionrelay.HandleRelayedTransactions(context, incomingRoute, peerIncomingRoute)
+		err = transactionrelay.HandleRelayedTransactions(context, incomingRoute, peerIncomingRoute, peerpkg.New(nil))
 		// Since we inserted an unexpected message type to stop the infinity loop,
 		// we expect the error will be infected from this specific message and also the
 		// error will count as a protocol message.
@@ -188,7 +193,7 @@ func TestOnClosedIncomingRoute(t *testing.T) {
 			t.Fatalf("Unexpected error from incomingRoute.Enqueue: %v", err)
 		}
 		incomingRoute.Close()
-		err = transactionrelay.HandleRelayedTransactions(context, incomingRoute, outgoingRoute)
+		err = transactionrelay.HandleRelayedTransactions(context, incomingRoute, outgoingRoute, peerpkg.New(nil))
 		if err == nil || !errors.Is(err, router.ErrRouteClosed) {
 			t.Fatalf("Unexpected error: expected: %v, got : %v", router.ErrRouteClosed, err)
 		}
@@ -37,6 +37,7 @@ func NewManager(cfg *config.Config, domain domain.Domain, netAdapter *netadapter
 	}
 
 	netAdapter.SetP2PRouterInitializer(manager.routerInitializer)
+	connectionManager.SetMisbehaviorScoreFunc(manager.context.MisbehaviorScore)
 	return &manager, nil
 }
 
@@ -68,6 +69,14 @@ func (m *Manager) AddTransaction(tx *externalapi.DomainTransaction, allowOrphan
 	return m.context.AddTransaction(tx, allowOrphan)
 }
 
+// AddTransactionReplacement adds transaction to the mempool in place of the transactions
+// it double-spends, and propagates it.
+func (m *Manager) AddTransactionReplacement(tx *externalapi.DomainTransaction) (
+	replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return m.context.AddTransactionReplacement(tx)
+}
+
 // AddBlock adds the given block to the DAG and propagates it.
 func (m *Manager) AddBlock(block *externalapi.DomainBlock) error {
 	return m.context.AddBlock(block)
@@ -0,0 +1,165 @@
+package peer
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// MisbehaviorCategory is a kind of peer misbehavior that adds to the peer's misbehavior score
+type MisbehaviorCategory uint8
+
+const (
+	// MisbehaviorInvalidBlock means the peer sent a block that breaks the consensus rules
+	MisbehaviorInvalidBlock MisbehaviorCategory = iota
+
+	// MisbehaviorInvalidTransaction means the peer relayed a transaction that can never be valid
+	MisbehaviorInvalidTransaction
+
+	// MisbehaviorUnrequestedMessage means the peer sent a block or a transaction that wasn't requested from it
+	MisbehaviorUnrequestedMessage
+
+	// MisbehaviorSlowIBDResponse means the peer didn't respond in time while serving IBD
+	MisbehaviorSlowIBDResponse
+
+	// MisbehaviorProtocolViolation means the peer violated the p2p protocol in any other way
+	MisbehaviorProtocolViolation
+
+	misbehaviorCategoryCount
+)
+
+var misbehaviorCategoryStrings = map[MisbehaviorCategory]string{
+	MisbehaviorInvalidBlock:       "invalidBlock",
+	MisbehaviorInvalidTransaction: "invalidTransaction",
+	MisbehaviorUnrequestedMessage: "unrequestedMessage",
+	MisbehaviorSlowIBDResponse:    "slowIBDResponse",
+	MisbehaviorProtocolViolation:  "protocolViolation",
+}
+
+func (category MisbehaviorCategory) String() string {
+	if categoryString, ok := misbehaviorCategoryStrings[category]; ok {
+		return categoryString
+	}
+	return "unknown"
+}
+
+// misbehaviorPoints is the amount of points that every occurrence of each category adds to
+// the misbehavior score. They're weighed against a ban threshold of 100, which is the
+// default of --banthreshold
+var misbehaviorPoints = [misbehaviorCategoryCount]float64{
+	MisbehaviorInvalidBlock:       50,
+	MisbehaviorInvalidTransaction: 10,
+	MisbehaviorUnrequestedMessage: 25,
+	MisbehaviorSlowIBDResponse:    20,
+	MisbehaviorProtocolViolation:  100,
+}
+
+// MisbehaviorScoreHalfLife is the time it takes a misbehavior score to decay to half of its value
+const MisbehaviorScoreHalfLife = 10 * time.Minute
+
+type misbehaviorScore struct {
+	categoryScores [misbehaviorCategoryCount]float64
+	lastDecayTime  time.Time
+}
+
+func (score *misbehaviorScore) decay(now time.Time) {
+	elapsed := now.Sub(score.lastDecayTime)
+	if elapsed <= 0 {
+		return
+	}
+	decayFactor := math.Exp2(-float64(elapsed) / float64(MisbehaviorScoreHalfLife))
+	for category := range score.categoryScores {
+		score.categoryScores[category] *= decayFactor
+	}
+	score.lastDecayTime = now
+}
+
+func (score *misbehaviorScore) total() uint32 {
+	total := float64(0)
+	for _, categoryScore := range score.categoryScores {
+		total += categoryScore
+	}
+	return uint32(total)
+}
+
+// MisbehaviorTracker keeps the misbehavior scores of peers. Every misbehavior adds points to
+// the score of the peer's key, which decay exponentially over time, so that only misbehavior
+// that accumulates over a short period results in a high score.
+// Scores are usually keyed by IP rather than by connection, so that reconnecting doesn't reset
+// them. See FlowContext.MisbehaviorKey for the peers that can't be told apart by their IP.
+type MisbehaviorTracker struct {
+	lock    sync.Mutex
+	scores  map[string]*misbehaviorScore
+	timeNow func() time.Time
+}
+
+// NewMisbehaviorTracker returns a new MisbehaviorTracker
+func NewMisbehaviorTracker() *MisbehaviorTracker {
+	return &MisbehaviorTracker{
+		scores:  make(map[string]*misbehaviorScore),
+		timeNow: time.Now,
+	}
+}
+
+// Add adds an occurrence of the given misbehavior category to the score of the given key,
+// and returns the resulting total score
+func (mt *MisbehaviorTracker) Add(key string, category MisbehaviorCategory) uint32 {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	now := mt.timeNow()
+	mt.removeDecayedScores(now)
+
+	score, ok := mt.scores[key]
+	if !ok {
+		score = &misbehaviorScore{lastDecayTime: now}
+		mt.scores[key] = score
+	}
+	score.decay(now)
+	score.categoryScores[category] += misbehaviorPoints[category]
+	return score.total()
+}
+
+// Score returns the total misbehavior score of the given key
+func (mt *MisbehaviorTracker) Score(key string) uint32 {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	score, ok := mt.scores[key]
+	if !ok {
+		return 0
+	}
+	score.decay(mt.timeNow())
+	return score.total()
+}
+
+// CategoryScores returns the misbehavior score of the given key in each category.
+// Categories that don't contribute to the score are omitted
+func (mt *MisbehaviorTracker) CategoryScores(key string) map[MisbehaviorCategory]uint32 {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+
+	categoryScores := make(map[MisbehaviorCategory]uint32)
+	score, ok := mt.scores[key]
+	if !ok {
+		return categoryScores
+	}
+	score.decay(mt.timeNow())
+	for category, categoryScore := range score.categoryScores {
+		if uint32(categoryScore) > 0 {
+			categoryScores[MisbehaviorCategory(category)] = uint32(categoryScore)
+		}
+	}
+	return categoryScores
+}
+
+// removeDecayedScores removes the scores that decayed to nothing, so that the tracker
+// doesn't grow with every peer that ever misbehaved
+func (mt *MisbehaviorTracker) removeDecayedScores(now time.Time) {
+	for key, score := range mt.scores {
+		score.decay(now)
+		if score.total() == 0 {
+			delete(mt.scores, key)
+		}
+	}
+}
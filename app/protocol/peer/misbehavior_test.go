@@ -0,0 +1,58 @@
+package peer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMisbehaviorTracker(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	tracker := NewMisbehaviorTracker()
+	tracker.timeNow = func() time.Time { return now }
+
+	ip := "1.2.3.4"
+	otherIP := "5.6.7.8"
+
+	if score := tracker.Score(ip); score != 0 {
+		t.Fatalf("Unexpected score of an IP that never misbehaved: %d", score)
+	}
+
+	score := tracker.Add(ip, MisbehaviorInvalidBlock)
+	if score != 50 {
+		t.Fatalf("Unexpected score after an invalid block. Want: 50, got: %d", score)
+	}
+	score = tracker.Add(ip, MisbehaviorInvalidTransaction)
+	if score != 60 {
+		t.Fatalf("Unexpected score after an invalid transaction. Want: 60, got: %d", score)
+	}
+	if score := tracker.Score(otherIP); score != 0 {
+		t.Fatalf("Misbehavior of one IP unexpectedly affected another: %d", score)
+	}
+
+	categoryScores := tracker.CategoryScores(ip)
+	if len(categoryScores) != 2 || categoryScores[MisbehaviorInvalidBlock] != 50 ||
+		categoryScores[MisbehaviorInvalidTransaction] != 10 {
+		t.Fatalf("Unexpected category scores: %v", categoryScores)
+	}
+
+	// Scores decay by half every half-life
+	now = now.Add(MisbehaviorScoreHalfLife)
+	if score := tracker.Score(ip); score != 30 {
+		t.Fatalf("Unexpected score after a half-life. Want: 30, got: %d", score)
+	}
+	score = tracker.Add(ip, MisbehaviorSlowIBDResponse)
+	if score != 50 {
+		t.Fatalf("Unexpected score after a slow IBD response. Want: 50, got: %d", score)
+	}
+
+	// Scores that decayed to nothing are removed
+	tracker.Add(otherIP, MisbehaviorUnrequestedMessage)
+	now = now.Add(20 * MisbehaviorScoreHalfLife)
+	tracker.Add(otherIP, MisbehaviorUnrequestedMessage)
+	if _, ok := tracker.scores[ip]; ok {
+		t.Fatalf("A score that decayed to nothing was unexpectedly kept")
+	}
+	if score := tracker.Score(otherIP); score != 25 {
+		t.Fatalf("Unexpected score after the previous one decayed. Want: 25, got: %d", score)
+	}
+}
@@ -2,8 +2,10 @@ package protocol
 
 import (
 	"github.com/kaspanet/kaspad/app/protocol/common"
+	"github.com/kaspanet/kaspad/app/protocol/flowcontext"
 	"github.com/kaspanet/kaspad/app/protocol/flows/ready"
 	"github.com/kaspanet/kaspad/app/protocol/flows/v5"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"sync"
 	"sync/atomic"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/kaspanet/kaspad/app/protocol/flows/handshake"
 	peerpkg "github.com/kaspanet/kaspad/app/protocol/peer"
 	"github.com/kaspanet/kaspad/app/protocol/protocolerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
 	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
@@ -106,10 +109,17 @@ func (m *Manager) routerInitializer(router *routerpkg.Router, netConnection *net
 
 func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection, outgoingRoute *routerpkg.Route) {
 	if protocolErr := (protocolerrors.ProtocolError{}); errors.As(err, &protocolErr) {
-		if m.context.Config().EnableBanning && protocolErr.ShouldBan {
-			log.Warnf("Banning %s (reason: %s)", netConnection, protocolErr.Cause)
+		shouldBan := false
+		if protocolErr.ShouldBan {
+			// Errors that are the result of reaching the ban threshold were already scored
+			shouldBan = errors.Is(err, flowcontext.ErrBanThresholdReached) ||
+				m.context.AddMisbehaviorScore(netConnection, misbehaviorCategory(err), protocolErr.Cause.Error())
+		}
+		if m.context.Config().EnableBanning && shouldBan {
+			log.WithFields(logger.Fields{logger.FieldPeer: netConnection.Address()}).
+				Warnf("Banning %s (reason: %s)", netConnection, protocolErr.Cause)
 
-			err := m.context.ConnectionManager().Ban(netConnection)
+			err := m.context.ConnectionManager().Ban(netConnection, protocolErr.Cause.Error())
 			if err != nil && !errors.Is(err, connmanager.ErrCannotBanPermanent) {
 				panic(err)
 			}
@@ -119,7 +129,8 @@ func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection
 				panic(err)
 			}
 		}
-		log.Infof("Disconnecting from %s (reason: %s)", netConnection, protocolErr.Cause)
+		log.WithFields(logger.Fields{logger.FieldPeer: netConnection.Address()}).
+			Infof("Disconnecting from %s (reason: %s)", netConnection, protocolErr.Cause)
 		netConnection.Disconnect()
 		return
 	}
@@ -134,6 +145,14 @@ func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection
 	panic(err)
 }
 
+// misbehaviorCategory returns the category of the misbehavior that resulted in the given banning error
+func misbehaviorCategory(err error) peerpkg.MisbehaviorCategory {
+	if errors.As(err, &ruleerrors.RuleError{}) {
+		return peerpkg.MisbehaviorInvalidBlock
+	}
+	return peerpkg.MisbehaviorProtocolViolation
+}
+
 // RegisterFlow registers a flow to the given router.
 func (m *Manager) RegisterFlow(name string, router *routerpkg.Router, messageTypes []appmessage.MessageCommand, isStopping *uint32,
 	errChan chan error, initializeFunc common.FlowInitializeFunc) *common.Flow {
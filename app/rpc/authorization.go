@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"strings"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/pkg/errors"
+)
+
+// rpcRolePermissions is the set of request commands that clients with some RPC role may send
+type rpcRolePermissions struct {
+	allowsAllMethods bool
+	allowedCommands  map[appmessage.MessageCommand]struct{}
+}
+
+func (p *rpcRolePermissions) isAllowed(requestCommand appmessage.MessageCommand) bool {
+	if p.allowsAllMethods {
+		return true
+	}
+	_, ok := p.allowedCommands[requestCommand]
+	return ok
+}
+
+// resolveRPCRolePermissions resolves the RPC method names of the given roles to the
+// request commands they stand for. Method names are case-insensitive
+func resolveRPCRolePermissions(roles []*config.RPCRole) (map[*config.RPCRole]*rpcRolePermissions, error) {
+	requestCommandsByMethod := make(map[string]appmessage.MessageCommand)
+	for command, commandString := range appmessage.RPCMessageCommandToString {
+		if strings.HasSuffix(commandString, "Request") {
+			method := strings.ToLower(strings.TrimSuffix(commandString, "Request"))
+			requestCommandsByMethod[method] = command
+		}
+	}
+
+	permissionsByRole := make(map[*config.RPCRole]*rpcRolePermissions, len(roles))
+	for _, role := range roles {
+		permissions := &rpcRolePermissions{
+			allowedCommands: make(map[appmessage.MessageCommand]struct{}, len(role.Methods)),
+		}
+		for _, method := range role.Methods {
+			if method == config.AllRPCMethods {
+				permissions.allowsAllMethods = true
+				continue
+			}
+			command, ok := requestCommandsByMethod[strings.ToLower(method)]
+			if !ok {
+				return nil, errors.Errorf("RPC role '%s' refers to unknown RPC method '%s'", role.Name, method)
+			}
+			permissions.allowedCommands[command] = struct{}{}
+		}
+		permissionsByRole[role] = permissions
+	}
+	return permissionsByRole, nil
+}
+
+// authenticate returns the RPC role of the given connection. It returns an
+// RPCError instead if the connection presented invalid credentials, or no
+// credentials while no anonymous role is defined
+func (m *Manager) authenticate(netConnection *netadapter.NetConnection) (*config.RPCRole, *appmessage.RPCError) {
+	rpcAuthorization := m.context.Config.RPCAuthorization
+
+	credentials, err := server.ParseAuthorization(netConnection.Authorization())
+	if err != nil {
+		log.Warnf("RPC client %s presented invalid credentials: %s", netConnection, err)
+		return nil, appmessage.RPCErrorf("Invalid credentials: %s", err)
+	}
+
+	if credentials == nil {
+		if rpcAuthorization.AnonymousRole == nil {
+			return nil, appmessage.RPCErrorf("Authentication required")
+		}
+		return rpcAuthorization.AnonymousRole, nil
+	}
+
+	var role *config.RPCRole
+	if credentials.Token != "" {
+		role = rpcAuthorization.TokenRole(credentials.Token)
+	} else {
+		role = rpcAuthorization.UserRole(credentials.Username, credentials.Password)
+	}
+	if role == nil {
+		log.Warnf("RPC client %s presented wrong credentials", netConnection)
+		return nil, appmessage.RPCErrorf("Invalid credentials")
+	}
+
+	log.Debugf("RPC client %s authenticated with role %s", netConnection, role.Name)
+	return role, nil
+}
+
+// authorize returns an RPCError if a client with the given role may not make the
+// given request. authenticationError is the error authenticate returned for the client
+func (m *Manager) authorize(role *config.RPCRole, authenticationError *appmessage.RPCError,
+	request appmessage.Message) *appmessage.RPCError {
+
+	if m.context.Config.RPCAuthorization == nil {
+		return nil
+	}
+	if authenticationError != nil {
+		return authenticationError
+	}
+	if !m.rolePermissions[role].isAllowed(request.Command()) {
+		method := strings.TrimSuffix(appmessage.RPCMessageCommandToString[request.Command()], "Request")
+		return appmessage.RPCErrorf("RPC method %s is not allowed for role %s", method, role.Name)
+	}
+	return nil
+}
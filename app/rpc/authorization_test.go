@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+)
+
+func TestResolveRPCRolePermissions(t *testing.T) {
+	adminRole := &config.RPCRole{Name: "admin", Methods: []string{config.AllRPCMethods}}
+	readerRole := &config.RPCRole{Name: "reader", Methods: []string{"GetInfo", "getBlockDagInfo", "GetBlock"}}
+
+	permissionsByRole, err := resolveRPCRolePermissions([]*config.RPCRole{adminRole, readerRole})
+	if err != nil {
+		t.Fatalf("resolveRPCRolePermissions: %s", err)
+	}
+
+	if !permissionsByRole[adminRole].isAllowed(appmessage.CmdSubmitBlockRequestMessage) {
+		t.Fatalf("admin is unexpectedly not allowed to submit blocks")
+	}
+
+	readerPermissions := permissionsByRole[readerRole]
+	for _, command := range []appmessage.MessageCommand{appmessage.CmdGetInfoRequestMessage,
+		appmessage.CmdGetBlockDAGInfoRequestMessage, appmessage.CmdGetBlockRequestMessage} {
+
+		if !readerPermissions.isAllowed(command) {
+			t.Fatalf("reader is unexpectedly not allowed to make %s requests", command)
+		}
+	}
+	if readerPermissions.isAllowed(appmessage.CmdSubmitBlockRequestMessage) {
+		t.Fatalf("reader is unexpectedly allowed to submit blocks")
+	}
+}
+
+func TestResolveRPCRolePermissionsUnknownMethod(t *testing.T) {
+	role := &config.RPCRole{Name: "admin", Methods: []string{"GetInfo", "NoSuchMethod"}}
+	_, err := resolveRPCRolePermissions([]*config.RPCRole{role})
+	if err == nil {
+		t.Fatalf("Expected an error for an unknown RPC method but got none")
+	}
+}
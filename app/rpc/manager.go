@@ -5,7 +5,10 @@ import (
 	"github.com/kaspanet/kaspad/app/protocol"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/addressindex"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+	"github.com/kaspanet/kaspad/domain/txindex"
 	"github.com/kaspanet/kaspad/domain/utxoindex"
 	"github.com/kaspanet/kaspad/infrastructure/config"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
@@ -17,7 +20,8 @@ import (
 
 // Manager is an RPC manager
 type Manager struct {
-	context *rpccontext.Context
+	context         *rpccontext.Context
+	rolePermissions map[*config.RPCRole]*rpcRolePermissions
 }
 
 // NewManager creates a new RPC Manager
@@ -29,8 +33,19 @@ func NewManager(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressIndex *addressindex.AddressIndex,
 	consensusEventsChan chan externalapi.ConsensusEvent,
-	shutDownChan chan<- struct{}) *Manager {
+	shutDownChan chan<- struct{}) (*Manager, error) {
+
+	var rolePermissions map[*config.RPCRole]*rpcRolePermissions
+	if cfg.RPCAuthorization != nil {
+		var err error
+		rolePermissions, err = resolveRPCRolePermissions(cfg.RPCAuthorization.Roles())
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	manager := Manager{
 		context: rpccontext.NewContext(
@@ -41,14 +56,17 @@ func NewManager(
 			connectionManager,
 			addressManager,
 			utxoIndex,
+			txIndex,
+			addressIndex,
 			shutDownChan,
 		),
+		rolePermissions: rolePermissions,
 	}
 	netAdapter.SetRPCRouterInitializer(manager.routerInitializer)
 
 	manager.initConsensusEventsHandler(consensusEventsChan)
 
-	return &manager
+	return &manager, nil
 }
 
 func (m *Manager) initConsensusEventsHandler(consensusEventsChan chan externalapi.ConsensusEvent) {
@@ -113,6 +131,20 @@ func (m *Manager) notifyVirtualChange(virtualChangeSet *externalapi.VirtualChang
 		}
 	}
 
+	if m.context.Config.TxIndex {
+		err := m.updateTXIndex(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.AddressIndex {
+		err := m.updateAddressIndex(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := m.notifyVirtualSelectedParentBlueScoreChanged(virtualChangeSet.VirtualSelectedParentBlueScore)
 	if err != nil {
 		return err
@@ -135,6 +167,11 @@ func (m *Manager) notifyVirtualChange(virtualChangeSet *externalapi.VirtualChang
 		return err
 	}
 
+	err = m.notifyVirtualChainChangedV2()
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -145,12 +182,41 @@ func (m *Manager) NotifyNewBlockTemplate() error {
 	return m.context.NotificationManager.NotifyNewBlockTemplate(notification)
 }
 
+// NotifyMempoolChanged notifies the manager that transactions were
+// accepted to the mempool or removed from it
+func (m *Manager) NotifyMempoolChanged(changes []*miningmanagermodel.MempoolChange) {
+	// Before converting the changes, we check if any listeners are interested.
+	// This is done since most nodes do not use this event.
+	if !m.context.NotificationManager.HasMempoolChangedListeners() {
+		return
+	}
+
+	err := m.context.NotificationManager.NotifyMempoolChanged(changes)
+	if err != nil {
+		log.Warnf("Error sending mempool changed notifications: %s", err)
+	}
+}
+
 // NotifyPruningPointUTXOSetOverride notifies the manager whenever the UTXO index
 // resets due to pruning point change via IBD.
 func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyPruningPointUTXOSetOverride")
 	defer onEnd()
 
+	if m.context.Config.TxIndex {
+		err := m.context.TXIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.AddressIndex {
+		err := m.context.AddressIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
 	if m.context.Config.UTXOIndex {
 		err := m.notifyPruningPointUTXOSetOverride()
 		if err != nil {
@@ -191,6 +257,20 @@ func (m *Manager) notifyUTXOsChanged(virtualChangeSet *externalapi.VirtualChange
 	return m.context.NotificationManager.NotifyUTXOsChanged(utxoIndexChanges)
 }
 
+func (m *Manager) updateTXIndex(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.updateTXIndex")
+	defer onEnd()
+
+	return m.context.TXIndex.Update(virtualChangeSet)
+}
+
+func (m *Manager) updateAddressIndex(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.updateAddressIndex")
+	defer onEnd()
+
+	return m.context.AddressIndex.Update(virtualChangeSet)
+}
+
 func (m *Manager) notifyPruningPointUTXOSetOverride() error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyPruningPointUTXOSetOverride")
 	defer onEnd()
@@ -219,6 +299,13 @@ func (m *Manager) notifyVirtualDaaScoreChanged(virtualDAAScore uint64) error {
 	return m.context.NotificationManager.NotifyVirtualDaaScoreChanged(notification)
 }
 
+func (m *Manager) notifyVirtualChainChangedV2() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyVirtualChainChangedV2")
+	defer onEnd()
+
+	return m.context.NotifyVirtualChainChangedV2()
+}
+
 func (m *Manager) notifyVirtualSelectedParentChainChanged(virtualChangeSet *externalapi.VirtualChangeSet) error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyVirtualSelectedParentChainChanged")
 	defer onEnd()
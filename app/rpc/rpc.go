@@ -4,9 +4,12 @@ import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/app/rpc/rpchandlers"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
+	"time"
 )
 
 type handler func(context *rpccontext.Context, router *router.Router, request appmessage.Message) (appmessage.Message, error)
@@ -43,14 +46,27 @@ var handlers = map[appmessage.MessageCommand]handler{
 	appmessage.CmdNotifyVirtualSelectedParentBlueScoreChangedRequestMessage: rpchandlers.HandleNotifyVirtualSelectedParentBlueScoreChanged,
 	appmessage.CmdBanRequestMessage:                                         rpchandlers.HandleBan,
 	appmessage.CmdUnbanRequestMessage:                                       rpchandlers.HandleUnban,
+	appmessage.CmdListBannedRequestMessage:                                  rpchandlers.HandleListBanned,
+	appmessage.CmdImportBansRequestMessage:                                  rpchandlers.HandleImportBans,
 	appmessage.CmdGetInfoRequestMessage:                                     rpchandlers.HandleGetInfo,
 	appmessage.CmdNotifyPruningPointUTXOSetOverrideRequestMessage:           rpchandlers.HandleNotifyPruningPointUTXOSetOverrideRequest,
 	appmessage.CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage:    rpchandlers.HandleStopNotifyingPruningPointUTXOSetOverrideRequest,
 	appmessage.CmdEstimateNetworkHashesPerSecondRequestMessage:              rpchandlers.HandleEstimateNetworkHashesPerSecond,
 	appmessage.CmdNotifyVirtualDaaScoreChangedRequestMessage:                rpchandlers.HandleNotifyVirtualDaaScoreChanged,
 	appmessage.CmdNotifyNewBlockTemplateRequestMessage:                      rpchandlers.HandleNotifyNewBlockTemplate,
+	appmessage.CmdNotifyMempoolChangedRequestMessage:                        rpchandlers.HandleNotifyMempoolChanged,
+	appmessage.CmdNotifyVirtualChainChangedV2RequestMessage:                 rpchandlers.HandleNotifyVirtualChainChangedV2,
 	appmessage.CmdGetCoinSupplyRequestMessage:                               rpchandlers.HandleGetCoinSupply,
 	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:                rpchandlers.HandleGetMempoolEntriesByAddresses,
+	appmessage.CmdGetTransactionRequestMessage:                              rpchandlers.HandleGetTransaction,
+	appmessage.CmdGetTransactionAcceptanceRequestMessage:                    rpchandlers.HandleGetTransactionAcceptance,
+	appmessage.CmdGetAddressTransactionsRequestMessage:                      rpchandlers.HandleGetAddressTransactions,
+	appmessage.CmdGetFeeEstimateRequestMessage:                              rpchandlers.HandleGetFeeEstimate,
+	appmessage.CmdSubmitTransactionReplacementRequestMessage:                rpchandlers.HandleSubmitTransactionReplacement,
+	appmessage.CmdSaveMempoolRequestMessage:                                 rpchandlers.HandleSaveMempool,
+	appmessage.CmdGetLogLevelsRequestMessage:                                rpchandlers.HandleGetLogLevels,
+	appmessage.CmdSetLogLevelRequestMessage:                                 rpchandlers.HandleSetLogLevel,
+	appmessage.CmdCreateSnapshotRequestMessage:                              rpchandlers.HandleCreateSnapshot,
 }
 
 func (m *Manager) routerInitializer(router *router.Router, netConnection *netadapter.NetConnection) {
@@ -64,15 +80,23 @@ func (m *Manager) routerInitializer(router *router.Router, netConnection *netada
 	}
 	m.context.NotificationManager.AddListener(router)
 
+	var role *config.RPCRole
+	var authenticationError *appmessage.RPCError
+	if m.context.Config.RPCAuthorization != nil {
+		role, authenticationError = m.authenticate(netConnection)
+	}
+
 	spawn("routerInitializer-handleIncomingMessages", func() {
 		defer m.context.NotificationManager.RemoveListener(router)
 
-		err := m.handleIncomingMessages(router, incomingRoute)
+		err := m.handleIncomingMessages(router, incomingRoute, role, authenticationError)
 		m.handleError(err, netConnection)
 	})
 }
 
-func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route) error {
+func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route,
+	role *config.RPCRole, authenticationError *appmessage.RPCError) error {
+
 	outgoingRoute := router.OutgoingRoute()
 	for {
 		request, err := incomingRoute.Dequeue()
@@ -83,7 +107,19 @@ func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *r
 		if !ok {
 			return err
 		}
-		response, err := handler(m.context, router, request)
+
+		var response appmessage.Message
+		authorizationError := m.authorize(role, authenticationError, request)
+		if authorizationError != nil {
+			log.Debugf("Rejecting %s: %s", request.Command(), authorizationError)
+			response, err = appmessage.NewErrorResponseMessage(request.Command(), authorizationError)
+		} else {
+			method := appmessage.RPCMessageCommandToString[request.Command()]
+			handlingStart := time.Now()
+			response, err = handler(m.context, router, request)
+			metrics.RPCRequests.WithLabelValues(method).Inc()
+			metrics.RPCRequestDuration.WithLabelValues(method).ObserveDuration(handlingStart)
+		}
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,25 @@
+package rpccontext
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/addressindex"
+)
+
+// ConvertAddressTransactionsToAppMessageAddressTransactions converts
+// the address index transactions of the given address to a slice of appmessage.AddressTransaction
+func ConvertAddressTransactionsToAppMessageAddressTransactions(address string,
+	addressTransactions []*addressindex.AddressTransaction) []*appmessage.AddressTransaction {
+
+	appMessageAddressTransactions := make([]*appmessage.AddressTransaction, len(addressTransactions))
+	for i, addressTransaction := range addressTransactions {
+		appMessageAddressTransactions[i] = &appmessage.AddressTransaction{
+			Address:                address,
+			TransactionID:          addressTransaction.TransactionID.String(),
+			AcceptingBlockHash:     addressTransaction.AcceptingBlockHash.String(),
+			AcceptingBlockDAAScore: addressTransaction.AcceptingBlockDAAScore,
+			CreatedOutputs:         addressTransaction.CreatedOutputs,
+			SpentOutputs:           addressTransaction.SpentOutputs,
+		}
+	}
+	return appMessageAddressTransactions
+}
@@ -0,0 +1,84 @@
+package rpccontext
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+	"github.com/kaspanet/kaspad/domain/utxoindex"
+)
+
+// convertedMempoolChange is a mempool change converted to its RPC representation,
+// along with the data required to filter it for each listener
+type convertedMempoolChange struct {
+	transactionID          externalapi.DomainTransactionID
+	scriptPublicKeyStrings []utxoindex.ScriptPublicKeyString
+	rpcMempoolChange       *appmessage.RPCMempoolChange
+}
+
+func convertMempoolChanges(changes []*miningmanagermodel.MempoolChange) []*convertedMempoolChange {
+	convertedChanges := make([]*convertedMempoolChange, len(changes))
+	for i, change := range changes {
+		transaction := change.Transaction
+		transactionID := consensushashing.TransactionID(transaction)
+
+		// Collect the addresses the transaction pays to, as well as the ones it
+		// spends from, if the UTXO entries of its inputs are known
+		scriptPublicKeyStrings := make([]utxoindex.ScriptPublicKeyString, 0, len(transaction.Inputs)+len(transaction.Outputs))
+		for _, input := range transaction.Inputs {
+			if input.UTXOEntry != nil {
+				scriptPublicKeyStrings = append(scriptPublicKeyStrings,
+					utxoindex.ScriptPublicKeyString(input.UTXOEntry.ScriptPublicKey().String()))
+			}
+		}
+		for _, output := range transaction.Outputs {
+			scriptPublicKeyStrings = append(scriptPublicKeyStrings,
+				utxoindex.ScriptPublicKeyString(output.ScriptPublicKey.String()))
+		}
+
+		rpcMempoolChange := &appmessage.RPCMempoolChange{
+			TransactionID: transactionID.String(),
+			Transaction:   appmessage.DomainTransactionToRPCTransaction(transaction),
+			IsRemoved:     change.IsRemoved,
+		}
+		if change.IsRemoved {
+			rpcMempoolChange.RemovalReason = change.RemovalReason.String()
+		}
+
+		convertedChanges[i] = &convertedMempoolChange{
+			transactionID:          *transactionID,
+			scriptPublicKeyStrings: scriptPublicKeyStrings,
+			rpcMempoolChange:       rpcMempoolChange,
+		}
+	}
+	return convertedChanges
+}
+
+func (nl *NotificationListener) filterMempoolChanges(
+	changes []*convertedMempoolChange) *appmessage.MempoolChangedNotificationMessage {
+
+	notification := appmessage.NewMempoolChangedNotificationMessage(nil)
+	for _, change := range changes {
+		if nl.isMempoolChangeRelevant(change) {
+			notification.Changes = append(notification.Changes, change.rpcMempoolChange)
+		}
+	}
+	return notification
+}
+
+func (nl *NotificationListener) isMempoolChangeRelevant(change *convertedMempoolChange) bool {
+	if len(nl.propagateMempoolChangedNotificationAddresses) == 0 &&
+		len(nl.propagateMempoolChangedNotificationTransactionIDs) == 0 {
+		return true
+	}
+
+	if _, ok := nl.propagateMempoolChangedNotificationTransactionIDs[change.transactionID]; ok {
+		return true
+	}
+	for _, scriptPublicKeyString := range change.scriptPublicKeyStrings {
+		if _, ok := nl.propagateMempoolChangedNotificationAddresses[scriptPublicKeyString]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/domain/utxoindex"
@@ -41,9 +42,24 @@ type NotificationListener struct {
 	propagateVirtualDaaScoreChangedNotifications                bool
 	propagatePruningPointUTXOSetOverrideNotifications           bool
 	propagateNewBlockTemplateNotifications                      bool
+	propagateMempoolChangedNotifications                        bool
+	propagateVirtualChainChangedV2Notifications                 bool
 
 	propagateUTXOsChangedNotificationAddresses                                    map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress
 	includeAcceptedTransactionIDsInVirtualSelectedParentChainChangedNotifications bool
+
+	propagateMempoolChangedNotificationAddresses      map[utxoindex.ScriptPublicKeyString]struct{}
+	propagateMempoolChangedNotificationTransactionIDs map[externalapi.DomainTransactionID]struct{}
+
+	// virtualChainChangedV2LastSentChainBlock is the highest chain block this listener had been notified of
+	virtualChainChangedV2LastSentChainBlock *externalapi.DomainHash
+	// virtualChainChangedV2SubscriptionGeneration is incremented whenever the listener subscribes to
+	// virtualChainChangedV2 notifications, so that a send that started before a re-subscription doesn't
+	// overwrite the new start hash
+	virtualChainChangedV2SubscriptionGeneration uint64
+	// virtualChainChangedV2SendLock makes sure that virtualChainChangedV2 notifications, which are sent
+	// without holding the NotificationManager lock, are sent to this listener one update at a time
+	virtualChainChangedV2SendLock sync.Mutex
 }
 
 // NewNotificationManager creates a new NotificationManager
@@ -284,6 +300,49 @@ func (nm *NotificationManager) NotifyNewBlockTemplate(
 	return nil
 }
 
+// HasMempoolChangedListeners indicates if the notification manager has any listeners for `MempoolChanged` events
+func (nm *NotificationManager) HasMempoolChangedListeners() bool {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for _, listener := range nm.listeners {
+		if listener.propagateMempoolChangedNotifications {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyMempoolChanged notifies the notification manager that transactions were accepted
+// to the mempool or removed from it
+func (nm *NotificationManager) NotifyMempoolChanged(changes []*miningmanagermodel.MempoolChange) error {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	var convertedChanges []*convertedMempoolChange
+	for router, listener := range nm.listeners {
+		if listener.propagateMempoolChangedNotifications {
+			// Convert the changes only once, and only if anyone listens to them
+			if convertedChanges == nil {
+				convertedChanges = convertMempoolChanges(changes)
+			}
+
+			notification := listener.filterMempoolChanges(convertedChanges)
+
+			// Don't send the notification if it's empty
+			if len(notification.Changes) == 0 {
+				continue
+			}
+
+			err := router.OutgoingRoute().MaybeEnqueue(notification)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // NotifyPruningPointUTXOSetOverride notifies the notification manager that the UTXO index
 // reset due to pruning point change via IBD.
 func (nm *NotificationManager) NotifyPruningPointUTXOSetOverride() error {
@@ -313,6 +372,8 @@ func newNotificationListener(params *dagconfig.Params) *NotificationListener {
 		propagateVirtualSelectedParentBlueScoreChangedNotifications: false,
 		propagateNewBlockTemplateNotifications:                      false,
 		propagatePruningPointUTXOSetOverrideNotifications:           false,
+		propagateMempoolChangedNotifications:                        false,
+		propagateVirtualChainChangedV2Notifications:                 false,
 	}
 }
 
@@ -478,6 +539,34 @@ func (nl *NotificationListener) PropagateNewBlockTemplateNotifications() {
 	nl.propagateNewBlockTemplateNotifications = true
 }
 
+// PropagateMempoolChangedNotifications instructs the listener to send mempool changed notifications
+// to the remote listener for transactions that pay to or spend from any of the given addresses, or
+// that have any of the given transaction IDs. If no addresses nor transaction IDs are given, all
+// mempool changes are sent. Subsequent calls add the given addresses and transaction IDs to the
+// existing ones.
+func (nm *NotificationManager) PropagateMempoolChangedNotifications(nl *NotificationListener,
+	addresses []*UTXOsChangedNotificationAddress, transactionIDs []*externalapi.DomainTransactionID) {
+
+	// Apply a write-lock since the internal listener filter maps are modified
+	nm.Lock()
+	defer nm.Unlock()
+
+	if !nl.propagateMempoolChangedNotifications {
+		nl.propagateMempoolChangedNotifications = true
+		nl.propagateMempoolChangedNotificationAddresses =
+			make(map[utxoindex.ScriptPublicKeyString]struct{}, len(addresses))
+		nl.propagateMempoolChangedNotificationTransactionIDs =
+			make(map[externalapi.DomainTransactionID]struct{}, len(transactionIDs))
+	}
+
+	for _, address := range addresses {
+		nl.propagateMempoolChangedNotificationAddresses[address.ScriptPublicKeyString] = struct{}{}
+	}
+	for _, transactionID := range transactionIDs {
+		nl.propagateMempoolChangedNotificationTransactionIDs[*transactionID] = struct{}{}
+	}
+}
+
 // PropagatePruningPointUTXOSetOverrideNotifications instructs the listener to send pruning point UTXO set override notifications
 // to the remote listener.
 func (nl *NotificationListener) PropagatePruningPointUTXOSetOverrideNotifications() {
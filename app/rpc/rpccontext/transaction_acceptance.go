@@ -0,0 +1,69 @@
+package rpccontext
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/txindex"
+	"github.com/pkg/errors"
+)
+
+// ErrTransactionAcceptanceBlockNotFound indicates that a block that's needed to tell the acceptance
+// of a transaction, either its accepting block or the virtual's selected parent, doesn't exist,
+// typically since it was pruned
+var ErrTransactionAcceptanceBlockNotFound = errors.New("ErrTransactionAcceptanceBlockNotFound")
+
+// VirtualSelectedParentBlueScore returns the blue score of the virtual's selected parent
+func (ctx *Context) VirtualSelectedParentBlueScore() (uint64, error) {
+	virtualSelectedParent, err := ctx.Domain.Consensus().GetVirtualSelectedParent()
+	if err != nil {
+		return 0, err
+	}
+	virtualSelectedParentInfo, err := ctx.Domain.Consensus().GetBlockInfo(virtualSelectedParent)
+	if err != nil {
+		return 0, err
+	}
+	if !virtualSelectedParentInfo.Exists {
+		return 0, errors.Wrapf(ErrTransactionAcceptanceBlockNotFound,
+			"virtual selected parent %s does not exist", virtualSelectedParent)
+	}
+	return virtualSelectedParentInfo.BlueScore, nil
+}
+
+// ConvertTxAcceptanceDataToTransactionAcceptance converts the given TX index acceptance data
+// to an appmessage.TransactionAcceptance. A nil txAcceptanceData denotes an unaccepted transaction.
+func (ctx *Context) ConvertTxAcceptanceDataToTransactionAcceptance(transactionID *externalapi.DomainTransactionID,
+	txAcceptanceData *txindex.TxAcceptanceData, virtualSelectedParentBlueScore uint64) (
+	*appmessage.TransactionAcceptance, error) {
+
+	if txAcceptanceData == nil {
+		return &appmessage.TransactionAcceptance{
+			TransactionID: transactionID.String(),
+			IsAccepted:    false,
+		}, nil
+	}
+
+	acceptingBlockInfo, err := ctx.Domain.Consensus().GetBlockInfo(txAcceptanceData.AcceptingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !acceptingBlockInfo.Exists {
+		return nil, errors.Wrapf(ErrTransactionAcceptanceBlockNotFound,
+			"accepting block %s of transaction %s does not exist", txAcceptanceData.AcceptingBlockHash, transactionID)
+	}
+
+	// The virtual might have changed since the acceptance data was read, in
+	// which case the confirmations are momentarily unknown
+	confirmations := uint64(0)
+	if virtualSelectedParentBlueScore >= acceptingBlockInfo.BlueScore {
+		confirmations = virtualSelectedParentBlueScore - acceptingBlockInfo.BlueScore + 1
+	}
+
+	return &appmessage.TransactionAcceptance{
+		TransactionID:           transactionID.String(),
+		IsAccepted:              true,
+		IncludingBlockHash:      txAcceptanceData.IncludingBlockHash.String(),
+		AcceptingBlockHash:      txAcceptanceData.AcceptingBlockHash.String(),
+		AcceptingBlockBlueScore: acceptingBlockInfo.BlueScore,
+		Confirmations:           confirmations,
+	}, nil
+}
@@ -0,0 +1,206 @@
+package rpccontext
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	routerpkg "github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// maxAddedChainBlocksPerVirtualChainChangedV2Notification limits the size of a single
+// virtualChainChangedV2 notification. Longer chain changes are split into several notifications
+const maxAddedChainBlocksPerVirtualChainChangedV2Notification = 1000
+
+// maxVirtualChainChangedV2NotificationsPerUpdate limits the amount of notifications sent to
+// a single listener at once, so that catching up doesn't block other notifications for too
+// long. The rest of the changes are sent on subsequent chain changes
+const maxVirtualChainChangedV2NotificationsPerUpdate = 10
+
+// PropagateVirtualChainChangedV2Notifications instructs the listener to send virtualChainChangedV2
+// notifications to the remote listener, starting from the given chain block, or from the current
+// virtual selected parent if startHash is nil. Notifications that bring the listener up to date
+// with the current virtual are sent right away.
+// Returns the chain block from which notifications start.
+func (ctx *Context) PropagateVirtualChainChangedV2Notifications(router *routerpkg.Router, nl *NotificationListener,
+	startHash *externalapi.DomainHash) (*externalapi.DomainHash, error) {
+
+	if startHash == nil {
+		var err error
+		startHash, err = ctx.Domain.Consensus().GetVirtualSelectedParent()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Make sure the chain path from startHash can be calculated before registering the listener
+	_, err := ctx.Domain.Consensus().GetVirtualSelectedParentChainFromBlock(startHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply a write-lock since the listener's last sent chain block is modified
+	ctx.NotificationManager.Lock()
+	nl.propagateVirtualChainChangedV2Notifications = true
+	nl.virtualChainChangedV2LastSentChainBlock = startHash
+	nl.virtualChainChangedV2SubscriptionGeneration++
+	ctx.NotificationManager.Unlock()
+
+	err = ctx.sendVirtualChainChangedV2Notifications(router, nl)
+	if err != nil {
+		return nil, err
+	}
+	return startHash, nil
+}
+
+// NotifyVirtualChainChangedV2 sends every virtualChainChangedV2 listener the chain changes
+// since the last chain block it was sent
+func (ctx *Context) NotifyVirtualChainChangedV2() error {
+	// Catching a listener up might query many chain blocks, so it's done without holding
+	// the NotificationManager lock, which would block all the other notifications
+	ctx.NotificationManager.RLock()
+	listeners := make(map[*routerpkg.Router]*NotificationListener)
+	for router, listener := range ctx.NotificationManager.listeners {
+		if listener.propagateVirtualChainChangedV2Notifications {
+			listeners[router] = listener
+		}
+	}
+	ctx.NotificationManager.RUnlock()
+
+	for router, listener := range listeners {
+		err := ctx.sendVirtualChainChangedV2Notifications(router, listener)
+		if err != nil {
+			// This mostly happens when the last chain block sent to the listener had been
+			// pruned, in which case there's no way to bring the listener up to date
+			log.Warnf("Stopping virtualChainChangedV2 notifications for a listener "+
+				"that couldn't be brought up to date: %s", err)
+			ctx.NotificationManager.Lock()
+			listener.propagateVirtualChainChangedV2Notifications = false
+			ctx.NotificationManager.Unlock()
+		}
+	}
+	return nil
+}
+
+// sendVirtualChainChangedV2Notifications sends the listener the chain changes since the last chain
+// block it was sent. It must not be called while holding the NotificationManager lock, which is
+// only taken to read and update the listener's last sent chain block
+func (ctx *Context) sendVirtualChainChangedV2Notifications(router *routerpkg.Router, nl *NotificationListener) error {
+	nl.virtualChainChangedV2SendLock.Lock()
+	defer nl.virtualChainChangedV2SendLock.Unlock()
+
+	for i := 0; i < maxVirtualChainChangedV2NotificationsPerUpdate; i++ {
+		ctx.NotificationManager.RLock()
+		lastSentChainBlock := nl.virtualChainChangedV2LastSentChainBlock
+		subscriptionGeneration := nl.virtualChainChangedV2SubscriptionGeneration
+		ctx.NotificationManager.RUnlock()
+
+		chainPath, err := ctx.Domain.Consensus().GetVirtualSelectedParentChainFromBlock(lastSentChainBlock)
+		if err != nil {
+			return err
+		}
+		if len(chainPath.Added) == 0 && len(chainPath.Removed) == 0 {
+			return nil
+		}
+
+		added := chainPath.Added
+		if len(added) > maxAddedChainBlocksPerVirtualChainChangedV2Notification {
+			added = added[:maxAddedChainBlocksPerVirtualChainChangedV2Notification]
+		}
+		notification, err := ctx.convertChainChangesToVirtualChainChangedV2Notification(chainPath.Removed, added)
+		if err != nil {
+			return err
+		}
+
+		var lastChainBlock *externalapi.DomainHash
+		if len(added) > 0 {
+			lastChainBlock = added[len(added)-1]
+		} else {
+			// Only blocks were removed, so the chain now ends at the selected parent of the lowest removed block
+			lowestRemovedBlockInfo, err := ctx.Domain.Consensus().GetBlockInfo(chainPath.Removed[len(chainPath.Removed)-1])
+			if err != nil {
+				return err
+			}
+			lastChainBlock = lowestRemovedBlockInfo.SelectedParent
+		}
+
+		err = router.OutgoingRoute().Enqueue(notification)
+		if err != nil {
+			if errors.Is(err, routerpkg.ErrRouteClosed) || errors.Is(err, routerpkg.ErrRouteCapacityReached) {
+				// The listener's last sent chain block is left as is, so these changes
+				// are sent again on the next update
+				log.Debugf("Couldn't send virtualChainChangedV2 notification: %s", err)
+				return nil
+			}
+			return err
+		}
+
+		ctx.NotificationManager.Lock()
+		if nl.virtualChainChangedV2SubscriptionGeneration != subscriptionGeneration {
+			// The listener re-subscribed while this notification was being sent, so its new
+			// start hash is kept, and the re-subscription sends the changes since it
+			ctx.NotificationManager.Unlock()
+			return nil
+		}
+		nl.virtualChainChangedV2LastSentChainBlock = lastChainBlock
+		ctx.NotificationManager.Unlock()
+	}
+	return nil
+}
+
+func (ctx *Context) convertChainChangesToVirtualChainChangedV2Notification(removed []*externalapi.DomainHash,
+	added []*externalapi.DomainHash) (*appmessage.VirtualChainChangedV2NotificationMessage, error) {
+
+	removedChainBlocksAcceptanceData, err := ctx.Domain.Consensus().GetBlocksAcceptanceData(removed)
+	if err != nil {
+		return nil, err
+	}
+	removedChainBlocks := make([]*appmessage.RPCRemovedChainBlock, len(removed))
+	for i, removedChainBlock := range removed {
+		removedChainBlocks[i] = &appmessage.RPCRemovedChainBlock{
+			Hash: removedChainBlock.String(),
+		}
+		for _, blockAcceptanceData := range removedChainBlocksAcceptanceData[i] {
+			for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+				if transactionAcceptanceData.IsAccepted {
+					removedChainBlocks[i].UnacceptedTransactionIDs = append(removedChainBlocks[i].UnacceptedTransactionIDs,
+						consensushashing.TransactionID(transactionAcceptanceData.Transaction).String())
+				}
+			}
+		}
+	}
+
+	addedChainBlocksAcceptanceData, err := ctx.Domain.Consensus().GetBlocksAcceptanceData(added)
+	if err != nil {
+		return nil, err
+	}
+	addedChainBlocks := make([]*appmessage.RPCAddedChainBlock, len(added))
+	for i, addedChainBlock := range added {
+		addedChainBlocks[i] = &appmessage.RPCAddedChainBlock{
+			Hash: addedChainBlock.String(),
+		}
+		for _, blockAcceptanceData := range addedChainBlocksAcceptanceData[i] {
+			for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+				if !transactionAcceptanceData.IsAccepted {
+					continue
+				}
+				transaction := transactionAcceptanceData.Transaction
+				inputPreviousOutpoints := make([]*appmessage.RPCOutpoint, len(transaction.Inputs))
+				for j, input := range transaction.Inputs {
+					inputPreviousOutpoints[j] = &appmessage.RPCOutpoint{
+						TransactionID: input.PreviousOutpoint.TransactionID.String(),
+						Index:         input.PreviousOutpoint.Index,
+					}
+				}
+				addedChainBlocks[i].AcceptedTransactions = append(addedChainBlocks[i].AcceptedTransactions,
+					&appmessage.RPCChainAcceptedTransaction{
+						TransactionID:          consensushashing.TransactionID(transaction).String(),
+						InputPreviousOutpoints: inputPreviousOutpoints,
+						Fee:                    transactionAcceptanceData.Fee,
+					})
+			}
+		}
+	}
+
+	return appmessage.NewVirtualChainChangedV2NotificationMessage(removedChainBlocks, addedChainBlocks), nil
+}
@@ -1,10 +1,13 @@
 package rpchandlers
 
 import (
+	"time"
+
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
-	"net"
+	"github.com/kaspanet/kaspad/util/mstime"
 )
 
 // HandleBan handles the respectively named RPC command
@@ -18,18 +21,30 @@ func HandleBan(context *rpccontext.Context, _ *router.Router, request appmessage
 	}
 
 	banRequest := request.(*appmessage.BanRequestMessage)
-	ip := net.ParseIP(banRequest.IP)
-	if ip == nil {
+	subnet, err := addressmanager.ParseSubnet(banRequest.IP)
+	if err != nil {
 		hint := ""
-		if banRequest.IP[0] == '[' {
+		if len(banRequest.IP) > 0 && banRequest.IP[0] == '[' {
 			hint = " (try to remove “[” and “]” symbols)"
 		}
 		errorMessage := &appmessage.BanResponseMessage{}
-		errorMessage.Error = appmessage.RPCErrorf("Could not parse IP%s: %s", hint, banRequest.IP)
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse IP or subnet%s: %s", hint, err)
 		return errorMessage, nil
 	}
 
-	err := context.ConnectionManager.BanByIP(ip)
+	duration := context.Config.BanDuration
+	if banRequest.IsPermanent {
+		duration = 0
+	} else if banRequest.DurationSeconds != 0 {
+		duration = time.Duration(banRequest.DurationSeconds) * time.Second
+	}
+
+	err = context.ConnectionManager.BanSubnet(&addressmanager.BanInfo{
+		Subnet:    subnet,
+		Reason:    banRequest.Reason,
+		CreatedAt: mstime.Now(),
+		ExpiresAt: addressmanager.BanExpiry(duration),
+	})
 	if err != nil {
 		errorMessage := &appmessage.BanResponseMessage{}
 		errorMessage.Error = appmessage.RPCErrorf("Could not ban IP: %s", err)
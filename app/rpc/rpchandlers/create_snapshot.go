@@ -0,0 +1,41 @@
+package rpchandlers
+
+import (
+	"path/filepath"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleCreateSnapshot handles the respectively named RPC command
+func HandleCreateSnapshot(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("CreateSnapshot RPC command called while node in safe RPC mode -- ignoring.")
+		response := &appmessage.CreateSnapshotResponseMessage{}
+		response.Error =
+			appmessage.RPCErrorf("CreateSnapshot RPC command called while node in safe RPC mode")
+		return response, nil
+	}
+
+	createSnapshotRequest := request.(*appmessage.CreateSnapshotRequestMessage)
+	if !filepath.IsAbs(createSnapshotRequest.Path) {
+		errorMessage := &appmessage.CreateSnapshotResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("The snapshot path must be absolute, but got %s",
+			createSnapshotRequest.Path)
+		return errorMessage, nil
+	}
+
+	log.Infof("Creating a database snapshot in %s", createSnapshotRequest.Path)
+	summary, err := context.Domain.CreateSnapshot(createSnapshotRequest.Path)
+	if err != nil {
+		log.Errorf("Error creating a database snapshot in %s: %+v", createSnapshotRequest.Path, err)
+		errorMessage := &appmessage.CreateSnapshotResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Error creating the snapshot: %s", err)
+		return errorMessage, nil
+	}
+
+	log.Infof("Created a database snapshot of %d entries in %s", summary.EntryCount, createSnapshotRequest.Path)
+	return appmessage.NewCreateSnapshotResponseMessage(summary.Header.PruningPoint,
+		summary.Header.VirtualDAAScore, summary.EntryCount, summary.Checksum), nil
+}
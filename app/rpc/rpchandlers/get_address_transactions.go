@@ -0,0 +1,90 @@
+package rpchandlers
+
+import (
+	"math"
+	"sort"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/util"
+)
+
+const maxGetAddressTransactionsLimit = 10000
+
+// HandleGetAddressTransactions handles the respectively named RPC command
+func HandleGetAddressTransactions(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.AddressIndex {
+		errorMessage := &appmessage.GetAddressTransactionsResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when kaspad is run without --addressindex")
+		return errorMessage, nil
+	}
+
+	getAddressTransactionsRequest := request.(*appmessage.GetAddressTransactionsRequestMessage)
+
+	limit := int(getAddressTransactionsRequest.Limit)
+	if limit == 0 || limit > maxGetAddressTransactionsLimit {
+		limit = maxGetAddressTransactionsLimit
+	}
+
+	// When an address has more than `limit` transactions to return, its history is
+	// only known to be complete up to the DAA score of the last one that was returned
+	completeUpToDAAScore := uint64(math.MaxUint64)
+
+	allEntries := make([]*appmessage.AddressTransaction, 0)
+	for _, addressString := range getAddressTransactionsRequest.Addresses {
+		address, err := util.DecodeAddress(addressString, context.Config.ActiveNetParams.Prefix)
+		if err != nil {
+			errorMessage := &appmessage.GetAddressTransactionsResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not decode address '%s': %s", addressString, err)
+			return errorMessage, nil
+		}
+		scriptPublicKey, err := txscript.PayToAddrScript(address)
+		if err != nil {
+			errorMessage := &appmessage.GetAddressTransactionsResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not create a scriptPublicKey for address '%s': %s", addressString, err)
+			return errorMessage, nil
+		}
+		addressTransactions, err := context.AddressIndex.AddressTransactions(
+			scriptPublicKey, getAddressTransactionsRequest.StartDAAScore, limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(addressTransactions) >= limit {
+			lastDAAScore := addressTransactions[len(addressTransactions)-1].AcceptingBlockDAAScore
+			if lastDAAScore < completeUpToDAAScore {
+				completeUpToDAAScore = lastDAAScore
+			}
+		}
+		entries := rpccontext.ConvertAddressTransactionsToAppMessageAddressTransactions(addressString, addressTransactions)
+		allEntries = append(allEntries, entries...)
+	}
+
+	sort.SliceStable(allEntries, func(i, j int) bool {
+		return allEntries[i].AcceptingBlockDAAScore < allEntries[j].AcceptingBlockDAAScore
+	})
+
+	// Cut the page so that it never ends in the middle of a DAA score, nor goes
+	// beyond the point up to which the history of all addresses is complete
+	pageLength := 0
+	for pageLength < len(allEntries) {
+		daaScore := allEntries[pageLength].AcceptingBlockDAAScore
+		if daaScore > completeUpToDAAScore {
+			break
+		}
+		if pageLength >= limit && daaScore != allEntries[pageLength-1].AcceptingBlockDAAScore {
+			break
+		}
+		pageLength++
+	}
+	page := allEntries[:pageLength]
+
+	nextDAAScore := getAddressTransactionsRequest.StartDAAScore
+	if len(page) > 0 {
+		nextDAAScore = page[len(page)-1].AcceptingBlockDAAScore + 1
+	}
+
+	response := appmessage.NewGetAddressTransactionsResponseMessage(page, nextDAAScore)
+	return response, nil
+}
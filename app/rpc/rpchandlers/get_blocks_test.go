@@ -17,6 +17,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
 	"github.com/kaspanet/kaspad/domain/miningmanager"
 	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/dbsnapshot"
 )
 
 type fakeDomain struct {
@@ -43,6 +44,10 @@ func (d fakeDomain) CommitStagingConsensus() error {
 	panic("implement me")
 }
 
+func (d fakeDomain) CreateSnapshot(path string) (*dbsnapshot.Summary, error) {
+	panic("implement me")
+}
+
 func (d fakeDomain) Consensus() externalapi.Consensus           { return d }
 func (d fakeDomain) MiningManager() miningmanager.MiningManager { return nil }
 
@@ -1,7 +1,10 @@
 package rpchandlers
 
 import (
+	"sort"
+
 	"github.com/kaspanet/kaspad/app/appmessage"
+	peerpkg "github.com/kaspanet/kaspad/app/protocol/peer"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 )
@@ -10,8 +13,16 @@ import (
 func HandleGetConnectedPeerInfo(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
 	peers := context.ProtocolManager.Peers()
 	ibdPeer := context.ProtocolManager.IBDPeer()
+	flowContext := context.ProtocolManager.Context()
+	misbehaviorTracker := flowContext.MisbehaviorTracker()
 	infos := make([]*appmessage.GetConnectedPeerInfoMessage, 0, len(peers))
 	for _, peer := range peers {
+		misbehaviorScore := uint32(0)
+		categoryScores := map[peerpkg.MisbehaviorCategory]uint32{}
+		if misbehaviorKey, hasKey := flowContext.MisbehaviorKey(peer.Connection()); hasKey {
+			misbehaviorScore = misbehaviorTracker.Score(misbehaviorKey)
+			categoryScores = misbehaviorTracker.CategoryScores(misbehaviorKey)
+		}
 		info := &appmessage.GetConnectedPeerInfoMessage{
 			ID:                        peer.ID().String(),
 			Address:                   peer.Address(),
@@ -22,9 +33,28 @@ func HandleGetConnectedPeerInfo(context *rpccontext.Context, _ *router.Router, _
 			AdvertisedProtocolVersion: peer.AdvertisedProtocolVersion(),
 			TimeConnected:             peer.TimeConnected().Milliseconds(),
 			IsIBDPeer:                 peer == ibdPeer,
+			MisbehaviorScore:          misbehaviorScore,
+			MisbehaviorCategoryScores: misbehaviorCategoryScores(categoryScores),
 		}
 		infos = append(infos, info)
 	}
 	response := appmessage.NewGetConnectedPeerInfoResponseMessage(infos)
 	return response, nil
 }
+
+func misbehaviorCategoryScores(categoryScores map[peerpkg.MisbehaviorCategory]uint32) []*appmessage.MisbehaviorCategoryScore {
+	categories := make([]peerpkg.MisbehaviorCategory, 0, len(categoryScores))
+	for category := range categoryScores {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i] < categories[j] })
+
+	misbehaviorCategoryScores := make([]*appmessage.MisbehaviorCategoryScore, len(categories))
+	for i, category := range categories {
+		misbehaviorCategoryScores[i] = &appmessage.MisbehaviorCategoryScore{
+			Category: category.String(),
+			Score:    categoryScores[category],
+		}
+	}
+	return misbehaviorCategoryScores
+}
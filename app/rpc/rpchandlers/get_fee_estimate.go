@@ -0,0 +1,28 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetFeeEstimate handles the respectively named RPC command
+func HandleGetFeeEstimate(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	feeEstimate := context.Domain.MiningManager().GetFeeEstimate()
+
+	estimate := appmessage.RPCFeeEstimate{
+		PriorityBucket: appmessage.RPCFeeRateBucket(feeEstimate.PriorityBucket),
+		NormalBuckets:  convertFeeRateBuckets(feeEstimate.NormalBuckets),
+		LowBuckets:     convertFeeRateBuckets(feeEstimate.LowBuckets),
+	}
+	return appmessage.NewGetFeeEstimateResponseMessage(estimate), nil
+}
+
+func convertFeeRateBuckets(buckets []miningmanagermodel.FeeRateBucket) []appmessage.RPCFeeRateBucket {
+	rpcBuckets := make([]appmessage.RPCFeeRateBucket, len(buckets))
+	for i, bucket := range buckets {
+		rpcBuckets[i] = appmessage.RPCFeeRateBucket(bucket)
+	}
+	return rpcBuckets
+}
@@ -0,0 +1,27 @@
+package rpchandlers
+
+import (
+	"sort"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleGetLogLevels handles the respectively named RPC command
+func HandleGetLogLevels(_ *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	logLevels := logger.SubsystemLogLevels()
+	subsystemLogLevels := make([]*appmessage.SubsystemLogLevel, 0, len(logLevels))
+	for subsystem, logLevel := range logLevels {
+		subsystemLogLevels = append(subsystemLogLevels, &appmessage.SubsystemLogLevel{
+			Subsystem: subsystem,
+			LogLevel:  logLevel.Name(),
+		})
+	}
+	sort.Slice(subsystemLogLevels, func(i, j int) bool {
+		return subsystemLogLevels[i].Subsystem < subsystemLogLevels[j].Subsystem
+	})
+
+	return appmessage.NewGetLogLevelsResponseMessage(subsystemLogLevels), nil
+}
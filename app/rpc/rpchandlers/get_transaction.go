@@ -0,0 +1,95 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionid"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetTransaction handles the respectively named RPC command
+func HandleGetTransaction(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.TxIndex {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when kaspad is run without --txindex")
+		return errorMessage, nil
+	}
+
+	getTransactionRequest := request.(*appmessage.GetTransactionRequestMessage)
+
+	transactionID, err := transactionid.FromString(getTransactionRequest.TransactionID)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction ID could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	txAcceptanceData, found, err := context.TXIndex.TxAcceptanceData(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found in the transaction index", transactionID)
+		return errorMessage, nil
+	}
+
+	includingBlock, err := context.Domain.Consensus().GetBlock(txAcceptanceData.IncludingBlockHash)
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			errorMessage := &appmessage.GetTransactionResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Transaction %s was included in block %s, which was pruned",
+				transactionID, txAcceptanceData.IncludingBlockHash)
+			return errorMessage, nil
+		}
+		return nil, err
+	}
+
+	var domainTransaction *externalapi.DomainTransaction
+	for _, transaction := range includingBlock.Transactions {
+		if consensushashing.TransactionID(transaction).Equal(transactionID) {
+			domainTransaction = transaction
+			break
+		}
+	}
+	if domainTransaction == nil {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found in its including block %s",
+			transactionID, txAcceptanceData.IncludingBlockHash)
+		return errorMessage, nil
+	}
+
+	rpcTransaction := appmessage.DomainTransactionToRPCTransaction(domainTransaction)
+	err = context.PopulateTransactionWithVerboseData(rpcTransaction, includingBlock.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	virtualSelectedParentBlueScore, err := context.VirtualSelectedParentBlueScore()
+	if err != nil {
+		if errors.Is(err, rpccontext.ErrTransactionAcceptanceBlockNotFound) {
+			errorMessage := &appmessage.GetTransactionResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not get the acceptance of transaction %s: %s", transactionID, err)
+			return errorMessage, nil
+		}
+		return nil, err
+	}
+	transactionAcceptance, err := context.ConvertTxAcceptanceDataToTransactionAcceptance(
+		transactionID, txAcceptanceData, virtualSelectedParentBlueScore)
+	if err != nil {
+		if errors.Is(err, rpccontext.ErrTransactionAcceptanceBlockNotFound) {
+			errorMessage := &appmessage.GetTransactionResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not get the acceptance of transaction %s: %s", transactionID, err)
+			return errorMessage, nil
+		}
+		return nil, err
+	}
+
+	return appmessage.NewGetTransactionResponseMessage(rpcTransaction,
+		transactionAcceptance.IncludingBlockHash, transactionAcceptance.AcceptingBlockHash,
+		transactionAcceptance.AcceptingBlockBlueScore, transactionAcceptance.Confirmations), nil
+}
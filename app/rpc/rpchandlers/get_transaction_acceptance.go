@@ -0,0 +1,63 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionid"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleGetTransactionAcceptance handles the respectively named RPC command
+func HandleGetTransactionAcceptance(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.TxIndex {
+		errorMessage := &appmessage.GetTransactionAcceptanceResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when kaspad is run without --txindex")
+		return errorMessage, nil
+	}
+
+	getTransactionAcceptanceRequest := request.(*appmessage.GetTransactionAcceptanceRequestMessage)
+
+	transactionIDs := make([]*externalapi.DomainTransactionID, len(getTransactionAcceptanceRequest.TransactionIDs))
+	for i, transactionIDString := range getTransactionAcceptanceRequest.TransactionIDs {
+		transactionID, err := transactionid.FromString(transactionIDString)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionAcceptanceResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Transaction ID %s could not be parsed: %s", transactionIDString, err)
+			return errorMessage, nil
+		}
+		transactionIDs[i] = transactionID
+	}
+
+	txsAcceptanceData, err := context.TXIndex.TxsAcceptanceData(transactionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	virtualSelectedParentBlueScore, err := context.VirtualSelectedParentBlueScore()
+	if err != nil {
+		if errors.Is(err, rpccontext.ErrTransactionAcceptanceBlockNotFound) {
+			errorMessage := &appmessage.GetTransactionAcceptanceResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not get the acceptance of the transactions: %s", err)
+			return errorMessage, nil
+		}
+		return nil, err
+	}
+
+	transactionAcceptances := make([]*appmessage.TransactionAcceptance, len(transactionIDs))
+	for i, transactionID := range transactionIDs {
+		transactionAcceptances[i], err = context.ConvertTxAcceptanceDataToTransactionAcceptance(
+			transactionID, txsAcceptanceData[*transactionID], virtualSelectedParentBlueScore)
+		if err != nil {
+			if errors.Is(err, rpccontext.ErrTransactionAcceptanceBlockNotFound) {
+				errorMessage := &appmessage.GetTransactionAcceptanceResponseMessage{}
+				errorMessage.Error = appmessage.RPCErrorf("Could not get the acceptance of transaction %s: %s", transactionID, err)
+				return errorMessage, nil
+			}
+			return nil, err
+		}
+	}
+
+	return appmessage.NewGetTransactionAcceptanceResponseMessage(transactionAcceptances), nil
+}
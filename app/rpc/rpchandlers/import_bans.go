@@ -0,0 +1,76 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
+	"github.com/kaspanet/kaspad/infrastructure/network/connmanager"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+// HandleImportBans handles the respectively named RPC command
+func HandleImportBans(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("ImportBans RPC command called while node in safe RPC mode -- ignoring.")
+		response := appmessage.NewImportBansResponseMessage(0, nil)
+		response.Error =
+			appmessage.RPCErrorf("ImportBans RPC command called while node in safe RPC mode")
+		return response, nil
+	}
+
+	importBansRequest := request.(*appmessage.ImportBansRequestMessage)
+
+	// Validate all the bans before importing any of them, so that a malformed
+	// ban list is rejected as a whole
+	banInfos := make([]*addressmanager.BanInfo, len(importBansRequest.Bans))
+	for i, rpcBan := range importBansRequest.Bans {
+		subnet, err := addressmanager.ParseSubnet(rpcBan.Subnet)
+		if err != nil {
+			errorMessage := &appmessage.ImportBansResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse ban %d: %s", i, err)
+			return errorMessage, nil
+		}
+		if rpcBan.CreatedAt < 0 || rpcBan.ExpiresAt < 0 {
+			errorMessage := &appmessage.ImportBansResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Ban %d of %s has a negative timestamp", i, rpcBan.Subnet)
+			return errorMessage, nil
+		}
+		banInfo := &addressmanager.BanInfo{
+			Subnet:    subnet,
+			Reason:    rpcBan.Reason,
+			CreatedAt: mstime.UnixMilliseconds(rpcBan.CreatedAt),
+		}
+		if rpcBan.ExpiresAt != 0 {
+			banInfo.ExpiresAt = mstime.UnixMilliseconds(rpcBan.ExpiresAt)
+		}
+		banInfos[i] = banInfo
+	}
+
+	now := mstime.Now()
+	importedCount := uint32(0)
+	skippedSubnets := make([]string, 0)
+	for _, banInfo := range banInfos {
+		if !banInfo.ExpiresAt.IsZero() && !now.Before(banInfo.ExpiresAt) {
+			continue
+		}
+		err := context.ConnectionManager.BanSubnet(banInfo)
+		if err != nil {
+			if errors.Is(err, connmanager.ErrCannotBanPermanent) {
+				subnetString := addressmanager.SubnetString(banInfo.Subnet)
+				log.Infof("Skipping imported ban of %s: %s", subnetString, err)
+				skippedSubnets = append(skippedSubnets, subnetString)
+				continue
+			}
+			errorMessage := &appmessage.ImportBansResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not import the ban of %s: %s",
+				addressmanager.SubnetString(banInfo.Subnet), err)
+			return errorMessage, nil
+		}
+		importedCount++
+	}
+	log.Infof("Imported %d bans", importedCount)
+
+	return appmessage.NewImportBansResponseMessage(importedCount, skippedSubnets), nil
+}
@@ -0,0 +1,39 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleListBanned handles the respectively named RPC command
+func HandleListBanned(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("ListBanned RPC command called while node in safe RPC mode -- ignoring.")
+		response := appmessage.NewListBannedResponseMessage(nil)
+		response.Error =
+			appmessage.RPCErrorf("ListBanned RPC command called while node in safe RPC mode")
+		return response, nil
+	}
+
+	bans := context.AddressManager.Bans()
+	rpcBans := make([]*appmessage.RPCBan, len(bans))
+	for i, ban := range bans {
+		rpcBans[i] = banInfoToRPCBan(ban)
+	}
+	return appmessage.NewListBannedResponseMessage(rpcBans), nil
+}
+
+func banInfoToRPCBan(ban *addressmanager.BanInfo) *appmessage.RPCBan {
+	expiresAt := int64(0)
+	if !ban.ExpiresAt.IsZero() {
+		expiresAt = ban.ExpiresAt.UnixMilliseconds()
+	}
+	return &appmessage.RPCBan{
+		Subnet:    addressmanager.SubnetString(ban.Subnet),
+		Reason:    ban.Reason,
+		CreatedAt: ban.CreatedAt.UnixMilliseconds(),
+		ExpiresAt: expiresAt,
+	}
+}
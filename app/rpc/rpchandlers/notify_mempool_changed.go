@@ -0,0 +1,40 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionid"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleNotifyMempoolChanged handles the respectively named RPC command
+func HandleNotifyMempoolChanged(context *rpccontext.Context, router *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	notifyMempoolChangedRequest := request.(*appmessage.NotifyMempoolChangedRequestMessage)
+	addresses, err := context.ConvertAddressStringsToUTXOsChangedNotificationAddresses(notifyMempoolChangedRequest.Addresses)
+	if err != nil {
+		errorMessage := appmessage.NewNotifyMempoolChangedResponseMessage()
+		errorMessage.Error = appmessage.RPCErrorf("Parsing error: %s", err)
+		return errorMessage, nil
+	}
+
+	transactionIDs := make([]*externalapi.DomainTransactionID, len(notifyMempoolChangedRequest.TransactionIDs))
+	for i, transactionIDString := range notifyMempoolChangedRequest.TransactionIDs {
+		transactionID, err := transactionid.FromString(transactionIDString)
+		if err != nil {
+			errorMessage := appmessage.NewNotifyMempoolChangedResponseMessage()
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction ID '%s': %s", transactionIDString, err)
+			return errorMessage, nil
+		}
+		transactionIDs[i] = transactionID
+	}
+
+	listener, err := context.NotificationManager.Listener(router)
+	if err != nil {
+		return nil, err
+	}
+	context.NotificationManager.PropagateMempoolChangedNotifications(listener, addresses, transactionIDs)
+
+	response := appmessage.NewNotifyMempoolChangedResponseMessage()
+	return response, nil
+}
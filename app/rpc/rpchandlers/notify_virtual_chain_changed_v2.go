@@ -0,0 +1,40 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleNotifyVirtualChainChangedV2 handles the respectively named RPC command
+func HandleNotifyVirtualChainChangedV2(context *rpccontext.Context, router *router.Router,
+	request appmessage.Message) (appmessage.Message, error) {
+
+	notifyVirtualChainChangedV2Request := request.(*appmessage.NotifyVirtualChainChangedV2RequestMessage)
+
+	var startHash *externalapi.DomainHash
+	if notifyVirtualChainChangedV2Request.StartHash != "" {
+		var err error
+		startHash, err = externalapi.NewDomainHashFromString(notifyVirtualChainChangedV2Request.StartHash)
+		if err != nil {
+			errorMessage := appmessage.NewNotifyVirtualChainChangedV2ResponseMessage("")
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse startHash: %s", err)
+			return errorMessage, nil
+		}
+	}
+
+	listener, err := context.NotificationManager.Listener(router)
+	if err != nil {
+		return nil, err
+	}
+	startHash, err = context.PropagateVirtualChainChangedV2Notifications(router, listener, startHash)
+	if err != nil {
+		errorMessage := appmessage.NewNotifyVirtualChainChangedV2ResponseMessage("")
+		errorMessage.Error = appmessage.RPCErrorf("Could not start notifying from the given startHash: %s", err)
+		return errorMessage, nil
+	}
+
+	response := appmessage.NewNotifyVirtualChainChangedV2ResponseMessage(startHash.String())
+	return response, nil
+}
@@ -0,0 +1,29 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleSaveMempool handles the respectively named RPC command
+func HandleSaveMempool(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("SaveMempool RPC command called while node in safe RPC mode -- ignoring.")
+		response := &appmessage.SaveMempoolResponseMessage{}
+		response.Error =
+			appmessage.RPCErrorf("SaveMempool RPC command called while node in safe RPC mode")
+		return response, nil
+	}
+
+	transactionCount, err := context.Domain.MiningManager().SaveMempool(context.Config.MempoolFile)
+	if err != nil {
+		log.Errorf("Error saving the mempool to %s: %+v", context.Config.MempoolFile, err)
+		errorMessage := &appmessage.SaveMempoolResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Error saving the mempool: %s", err)
+		return errorMessage, nil
+	}
+
+	log.Infof("Saved %d mempool transactions to %s", transactionCount, context.Config.MempoolFile)
+	return appmessage.NewSaveMempoolResponseMessage(uint64(transactionCount)), nil
+}
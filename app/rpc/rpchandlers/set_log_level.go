@@ -0,0 +1,30 @@
+package rpchandlers
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+)
+
+// HandleSetLogLevel handles the respectively named RPC command
+func HandleSetLogLevel(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if context.Config.SafeRPC {
+		log.Warn("SetLogLevel RPC command called while node in safe RPC mode -- ignoring.")
+		response := appmessage.NewSetLogLevelResponseMessage()
+		response.Error =
+			appmessage.RPCErrorf("SetLogLevel RPC command called while node in safe RPC mode")
+		return response, nil
+	}
+
+	setLogLevelRequest := request.(*appmessage.SetLogLevelRequestMessage)
+	err := logger.ParseAndSetLogLevels(setLogLevelRequest.LogLevel)
+	if err != nil {
+		errorMessage := &appmessage.SetLogLevelResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not set the log level: %s", err)
+		return errorMessage, nil
+	}
+
+	log.Infof("Log level set to %s via RPC", setLogLevelRequest.LogLevel)
+	return appmessage.NewSetLogLevelResponseMessage(), nil
+}
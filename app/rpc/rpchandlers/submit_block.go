@@ -7,6 +7,7 @@ import (
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
 	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
 )
@@ -76,7 +77,11 @@ func HandleSubmitBlock(context *rpccontext.Context, _ *router.Router, request ap
 		}, nil
 	}
 
-	log.Infof("Accepted block %s via submitBlock", consensushashing.BlockHash(domainBlock))
+	blockHash := consensushashing.BlockHash(domainBlock)
+	log.WithFields(logger.Fields{
+		logger.FieldBlockHash: blockHash,
+		logger.FieldDAAScore:  domainBlock.Header.DAAScore(),
+	}).Infof("Accepted block %s via submitBlock", blockHash)
 
 	response := appmessage.NewSubmitBlockResponseMessage()
 	return response, nil
@@ -3,8 +3,8 @@ package rpchandlers
 import (
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/app/rpc/rpccontext"
+	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
-	"net"
 )
 
 // HandleUnban handles the respectively named RPC command
@@ -18,17 +18,17 @@ func HandleUnban(context *rpccontext.Context, _ *router.Router, request appmessa
 	}
 
 	unbanRequest := request.(*appmessage.UnbanRequestMessage)
-	ip := net.ParseIP(unbanRequest.IP)
-	if ip == nil {
+	subnet, err := addressmanager.ParseSubnet(unbanRequest.IP)
+	if err != nil {
 		hint := ""
-		if unbanRequest.IP[0] == '[' {
+		if len(unbanRequest.IP) > 0 && unbanRequest.IP[0] == '[' {
 			hint = " (try to remove “[” and “]” symbols)"
 		}
 		errorMessage := &appmessage.UnbanResponseMessage{}
-		errorMessage.Error = appmessage.RPCErrorf("Could not parse IP%s: %s", hint, unbanRequest.IP)
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse IP or subnet%s: %s", hint, err)
 		return errorMessage, nil
 	}
-	err := context.AddressManager.Unban(appmessage.NewNetAddressIPPort(ip, 0))
+	err = context.AddressManager.UnbanSubnet(subnet)
 	if err != nil {
 		errorMessage := &appmessage.UnbanResponseMessage{}
 		errorMessage.Error = appmessage.RPCErrorf("Could not unban IP: %s", err)
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
+	"github.com/kaspanet/kaspad/infrastructure/db/dbsnapshot"
+	"github.com/pkg/errors"
+)
+
+// errInvalidRestoredSnapshot is returned from NewComponentManager when the database
+// restored from a snapshot doesn't pass validation
+var errInvalidRestoredSnapshot = errors.New("invalid restored snapshot")
+
+// restoreSnapshot restores the database from the snapshot file given in
+// --restore-snapshot. It refuses to overwrite an existing database.
+// Note that the restored database is only validated once the domain is
+// loaded, see validateRestoredSnapshot.
+func restoreSnapshot(cfg *config.Config) (err error) {
+	header, err := dbsnapshot.ReadHeader(cfg.RestoreSnapshot)
+	if err != nil {
+		return err
+	}
+	if header.Network != cfg.ActiveNetParams.Name {
+		return errors.Errorf("the snapshot %s is of network %s, but kaspad is running on %s",
+			cfg.RestoreSnapshot, header.Network, cfg.ActiveNetParams.Name)
+	}
+
+	dbPath := databasePath(cfg)
+	existingType, err := dbfactory.ReadType(dbPath)
+	if err != nil {
+		return err
+	}
+	if existingType != "" {
+		return errors.Errorf("cannot restore a snapshot into %s because it already contains a database. "+
+			"Either remove it first, or run kaspad with --reset-db", dbPath)
+	}
+
+	defer func() {
+		if err != nil {
+			removeErr := removeDatabase(cfg)
+			if removeErr != nil {
+				log.Errorf("Failed to remove the partially restored database: %s", removeErr)
+			}
+		}
+	}()
+
+	err = checkDatabaseVersion(dbPath)
+	if err != nil {
+		return err
+	}
+	err = dbfactory.CheckType(dbPath, cfg.DbType)
+	if err != nil {
+		return err
+	}
+	db, err := dbfactory.Open(cfg.DbType, dbPath, databaseCacheSizeMiB)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Restoring the %s database snapshot of pruning point %s from %s",
+		header.Network, header.PruningPoint, cfg.RestoreSnapshot)
+	summary, err := dbsnapshot.Restore(cfg.RestoreSnapshot, db)
+	if err != nil {
+		db.Close()
+		return err
+	}
+	err = db.Close()
+	if err != nil {
+		return err
+	}
+	log.Infof("Restored %d database entries from %s", summary.EntryCount, cfg.RestoreSnapshot)
+
+	return nil
+}
+
+// validateRestoredSnapshot makes sure that the database restored by restoreSnapshot
+// is at the pruning point recorded in the snapshot header, and that its UTXO sets
+// match their commitments
+func validateRestoredSnapshot(cfg *config.Config, domain domain.Domain) error {
+	header, err := dbsnapshot.ReadHeader(cfg.RestoreSnapshot)
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+	if pruningPoint.String() != header.PruningPoint {
+		return errors.Wrapf(errInvalidRestoredSnapshot, "the restored database is at pruning point %s, "+
+			"but the snapshot header says %s", pruningPoint, header.PruningPoint)
+	}
+
+	log.Infof("Verifying the UTXO commitments of the restored database")
+	err = domain.Consensus().VerifyUTXOCommitments()
+	if err != nil {
+		return errors.Wrapf(errInvalidRestoredSnapshot, "the UTXO commitments of the restored database "+
+			"don't match: %s", err)
+	}
+	log.Infof("The restored database is valid")
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"reflect"
 	"strconv"
 	"strings"
@@ -48,6 +49,28 @@ func setField(commandValue reflect.Value, parameterValue reflect.Value, paramete
 	parameterField.Set(parameterValue)
 }
 
+var protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+// stringToMessageSlice parses a JSON array of protobuf messages, such as the bans returned by ListBanned
+func stringToMessageSlice(parameterDesc *parameterDescription, valueStr string) (reflect.Value, error) {
+	var rawElements []json.RawMessage
+	err := json.Unmarshal([]byte(valueStr), &rawElements)
+	if err != nil {
+		return reflect.Value{}, errors.Wrapf(err, "parameter '%s' must be a JSON array", parameterDesc.name)
+	}
+
+	slice := reflect.MakeSlice(parameterDesc.typeof, len(rawElements), len(rawElements))
+	for i, rawElement := range rawElements {
+		element := reflect.New(parameterDesc.typeof.Elem().Elem())
+		err := protojson.Unmarshal(rawElement, element.Interface().(proto.Message))
+		if err != nil {
+			return reflect.Value{}, errors.WithStack(err)
+		}
+		slice.Index(i).Set(element)
+	}
+	return slice, nil
+}
+
 func stringToValue(parameterDesc *parameterDescription, valueStr string) (reflect.Value, error) {
 	if valueStr == "-" {
 		return reflect.Zero(parameterDesc.typeof), nil
@@ -152,6 +175,9 @@ func stringToValue(parameterDesc *parameterDescription, valueStr string) (reflec
 
 	case reflect.Slice:
 		sliceType := parameterDesc.typeof.Elem()
+		if sliceType.Kind() == reflect.Ptr && sliceType.Implements(protoMessageType) {
+			return stringToMessageSlice(parameterDesc, valueStr)
+		}
 		if sliceType.Kind() != reflect.String {
 			return reflect.Value{},
 				errors.Errorf("Unsupported slice type '%s' for parameter '%s'",
@@ -35,12 +35,26 @@ var commandTypes = []reflect.Type{
 
 	reflect.TypeOf(protowire.KaspadMessage_SubmitTransactionRequest{}),
 
+	reflect.TypeOf(protowire.KaspadMessage_GetTransactionRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetTransactionAcceptanceRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetAddressTransactionsRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_GetFeeEstimateRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_SubmitTransactionReplacementRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_SaveMempoolRequest{}),
+
 	reflect.TypeOf(protowire.KaspadMessage_GetUtxosByAddressesRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_GetBalanceByAddressRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_GetCoinSupplyRequest{}),
 
 	reflect.TypeOf(protowire.KaspadMessage_BanRequest{}),
 	reflect.TypeOf(protowire.KaspadMessage_UnbanRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_ListBannedRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_ImportBansRequest{}),
+
+	reflect.TypeOf(protowire.KaspadMessage_GetLogLevelsRequest{}),
+	reflect.TypeOf(protowire.KaspadMessage_SetLogLevelRequest{}),
+
+	reflect.TypeOf(protowire.KaspadMessage_CreateSnapshotRequest{}),
 }
 
 type commandDescription struct {
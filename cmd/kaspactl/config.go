@@ -19,6 +19,7 @@ type configFlags struct {
 	AllowConnectionToDifferentVersions bool   `short:"a" long:"allow-connection-to-different-versions" description:"Allow connections to versions different than kaspactl's version'"`
 	CommandAndParameters               []string
 	config.NetworkFlags
+	config.RPCCredentialFlags
 }
 
 func parseConfig() (*configFlags, error) {
@@ -44,6 +45,11 @@ func parseConfig() (*configFlags, error) {
 		return nil, err
 	}
 
+	err = cfg.ValidateRPCCredentials()
+	if err != nil {
+		return nil, err
+	}
+
 	cfg.CommandAndParameters = remainingArgs
 	if len(cfg.CommandAndParameters) == 0 && cfg.RequestJSON == "" ||
 		len(cfg.CommandAndParameters) > 0 && cfg.RequestJSON != "" {
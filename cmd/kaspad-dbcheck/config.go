@@ -0,0 +1,53 @@
+package main
+
+import (
+	"path/filepath"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/pkg/errors"
+)
+
+// defaultDataDirname is the name of kaspad's database directory inside
+// its network-specific app directory
+const defaultDataDirname = "datadir2"
+
+const defaultMaxFailures = 100
+
+type configFlags struct {
+	AppDir      string `long:"appdir" short:"b" description:"Kaspad's app directory (default: ~/.kaspad (*nix), %LOCALAPPDATA%\\Kaspad (Windows))"`
+	MaxFailures int    `long:"maxfailures" description:"The maximum number of failures to list for every check. Failures beyond that are only counted"`
+	Output      string `long:"output" short:"o" description:"Write the report to the given file instead of to the standard output"`
+	config.NetworkFlags
+}
+
+// dataDir returns the path of the database directory of the selected network
+func (cfg *configFlags) dataDir() string {
+	appDir := cfg.AppDir
+	if appDir == "" {
+		appDir = config.DefaultAppDir
+	}
+	return filepath.Join(appDir, cfg.NetParams().Name, defaultDataDirname)
+}
+
+func parseConfig() (*configFlags, error) {
+	cfg := &configFlags{
+		MaxFailures: defaultMaxFailures,
+	}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+	_, err := parser.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.ResolveNetwork(parser)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.MaxFailures < 0 {
+		return nil, errors.Errorf("--maxfailures may not be negative")
+	}
+
+	return cfg, nil
+}
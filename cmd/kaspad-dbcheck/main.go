@@ -0,0 +1,116 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/kaspanet/kaspad/domain/dbcheck"
+	"github.com/kaspanet/kaspad/domain/prefixmanager"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
+	"github.com/pkg/errors"
+)
+
+// checkCacheSizeMiB is the cache size of the checked database
+const checkCacheSizeMiB = 256
+
+// exitCodeInconsistent is the exit code when the check itself succeeds, but finds
+// inconsistencies in the database. Errors that prevent the check from completing
+// exit with 1
+const exitCodeInconsistent = 2
+
+// report is the written report. It adds the details of the checked database to the
+// report of dbcheck
+type report struct {
+	Network string `json:"network"`
+	DataDir string `json:"dataDir"`
+	DbType  string `json:"dbType"`
+	*dbcheck.Report
+}
+
+func main() {
+	cfg, err := parseConfig()
+	if err != nil {
+		// Errors of the flags parser are already printed by it
+		var flagsErr *flags.Error
+		if ok := errors.As(err, &flagsErr); ok {
+			if flagsErr.Type == flags.ErrHelp {
+				os.Exit(0)
+			}
+			os.Exit(1)
+		}
+		printErrorAndExit(err)
+	}
+
+	checkReport, err := check(cfg)
+	if err != nil {
+		printErrorAndExit(err)
+	}
+
+	err = writeReport(cfg, checkReport)
+	if err != nil {
+		printErrorAndExit(err)
+	}
+
+	if !checkReport.OK {
+		os.Exit(exitCodeInconsistent)
+	}
+}
+
+func check(cfg *configFlags) (*report, error) {
+	dataDir := cfg.dataDir()
+	dbType, err := dbfactory.ReadType(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	if dbType == "" {
+		return nil, errors.Errorf("no database found in %s", dataDir)
+	}
+
+	db, err := dbfactory.OpenReadOnly(dbType, dataDir, checkCacheSizeMiB)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open the database in %s. Note that kaspad "+
+			"must not be running while its database is checked", dataDir)
+	}
+	defer db.Close()
+
+	activePrefix, exists, err := prefixmanager.ActivePrefix(db)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Errorf("the database in %s doesn't contain a consensus", dataDir)
+	}
+
+	checkReport, err := dbcheck.Check(db, activePrefix, cfg.MaxFailures)
+	if err != nil {
+		return nil, err
+	}
+
+	return &report{
+		Network: cfg.NetParams().Name,
+		DataDir: dataDir,
+		DbType:  dbType,
+		Report:  checkReport,
+	}, nil
+}
+
+func writeReport(cfg *configFlags, checkReport *report) error {
+	reportJSON, err := json.MarshalIndent(checkReport, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	reportJSON = append(reportJSON, '\n')
+
+	if cfg.Output == "" {
+		_, err = os.Stdout.Write(reportJSON)
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(cfg.Output, reportJSON, 0600))
+}
+
+func printErrorAndExit(err error) {
+	fmt.Fprintf(os.Stderr, "%s\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
+	"github.com/pkg/errors"
+)
+
+const (
+	convertSubCmd = "convert"
+)
+
+// defaultDataDirname is the name of kaspad's database directory inside
+// its network-specific app directory
+const defaultDataDirname = "datadir2"
+
+type configFlags struct {
+	config.NetworkFlags
+}
+
+type convertConfig struct {
+	AppDir string `long:"appdir" short:"b" description:"Kaspad's app directory (default: ~/.kaspad (*nix), %LOCALAPPDATA%\\Kaspad (Windows))"`
+	DbType string `long:"dbtype" description:"The database backend to convert to {leveldb, pebble} -- pebble is only available in kaspadb builds with -tags pebble" default:"pebble"`
+	config.NetworkFlags
+}
+
+// dataDir returns the path of the database directory of the selected network
+func (cfg *convertConfig) dataDir() string {
+	appDir := cfg.AppDir
+	if appDir == "" {
+		appDir = config.DefaultAppDir
+	}
+	return filepath.Join(appDir, cfg.NetParams().Name, defaultDataDirname)
+}
+
+func parseCommandLine() (subCommand string, config interface{}) {
+	cfg := &configFlags{}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+
+	convertConf := &convertConfig{}
+	parser.AddCommand(convertSubCmd, "Converts a kaspad database to a different backend",
+		"Copies every entry of an existing kaspad database into a new database of the given backend, "+
+			"and then swaps the two. The original database is kept as a backup next to the converted one. "+
+			"kaspad must not be running while the database is converted.", convertConf)
+
+	_, err := parser.Parse()
+	if err != nil {
+		var flagsErr *flags.Error
+		if ok := errors.As(err, &flagsErr); ok && flagsErr.Type == flags.ErrHelp {
+			os.Exit(0)
+		} else {
+			os.Exit(1)
+		}
+		return "", nil
+	}
+
+	switch parser.Command.Active.Name {
+	case convertSubCmd:
+		combineNetworkFlags(&convertConf.NetworkFlags, &cfg.NetworkFlags)
+		err := convertConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = dbfactory.ValidateType(convertConf.DbType)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		if !dbfactory.IsPersistent(convertConf.DbType) {
+			printErrorAndExit(errors.Errorf("cannot convert to a %s database", convertConf.DbType))
+		}
+		config = convertConf
+	}
+
+	return parser.Command.Active.Name, config
+}
+
+func combineNetworkFlags(dst, src *config.NetworkFlags) {
+	dst.Testnet = dst.Testnet || src.Testnet
+	dst.Simnet = dst.Simnet || src.Simnet
+	dst.Devnet = dst.Devnet || src.Devnet
+	if dst.OverrideDAGParamsFile == "" {
+		dst.OverrideDAGParamsFile = src.OverrideDAGParamsFile
+	}
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
+	"github.com/pkg/errors"
+)
+
+const (
+	// convertCacheSizeMiB is the cache size of both the source and the destination databases
+	convertCacheSizeMiB = 256
+
+	// convertBatchSize is the number of entries that are copied in a single transaction
+	convertBatchSize = 10_000
+
+	// convertProgressInterval is the number of copied entries between progress reports
+	convertProgressInterval = 1_000_000
+)
+
+// filesToCopy are the files in the database directory that aren't managed by the
+// database backend itself, and have to be carried over to the converted database
+var filesToCopy = []string{"version"}
+
+func convert(cfg *convertConfig) error {
+	dataDir := cfg.dataDir()
+	sourceType, err := dbfactory.ReadType(dataDir)
+	if err != nil {
+		return err
+	}
+	if sourceType == "" {
+		return errors.Errorf("no database found in %s", dataDir)
+	}
+	if sourceType == cfg.DbType {
+		return errors.Errorf("the database in %s is already a %s database", dataDir, sourceType)
+	}
+
+	convertedDataDir := dataDir + ".converting"
+	backupDataDir := fmt.Sprintf("%s.%s-backup", dataDir, sourceType)
+	if _, err := os.Stat(backupDataDir); err == nil {
+		return errors.Errorf("a backup of a previous conversion already exists in %s. "+
+			"Delete it or move it elsewhere before converting again", backupDataDir)
+	}
+	// A leftover converted database is the result of a conversion that was interrupted
+	err = os.RemoveAll(convertedDataDir)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Converting the %s database in %s to %s\n", sourceType, dataDir, cfg.DbType)
+	copiedCount, err := convertDatabase(dataDir, sourceType, convertedDataDir, cfg.DbType)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Copied %d entries\n", copiedCount)
+
+	for _, fileName := range filesToCopy {
+		err := copyFile(filepath.Join(dataDir, fileName), filepath.Join(convertedDataDir, fileName))
+		if err != nil {
+			return err
+		}
+	}
+	err = dbfactory.WriteType(convertedDataDir, cfg.DbType)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(dataDir, backupDataDir)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = os.Rename(convertedDataDir, dataDir)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Done. Start kaspad with --dbtype=%s. The original database was moved to %s, "+
+		"and can be deleted once the converted database is confirmed to work\n", cfg.DbType, backupDataDir)
+	return nil
+}
+
+// convertDatabase copies the database in sourcePath into a new database in destinationPath,
+// and returns the number of copied entries
+func convertDatabase(sourcePath string, sourceType string,
+	destinationPath string, destinationType string) (copiedCount int, err error) {
+
+	source, err := dbfactory.Open(sourceType, sourcePath, convertCacheSizeMiB)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		closeErr := source.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+
+	destination, err := dbfactory.Open(destinationType, destinationPath, convertCacheSizeMiB)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		closeErr := destination.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+
+	copiedCount, err = copyDatabase(source, destination)
+	if err != nil {
+		return 0, err
+	}
+	return copiedCount, destination.Compact()
+}
+
+// copyDatabase copies every entry in source into destination, and returns
+// the number of copied entries
+func copyDatabase(source database.Database, destination database.Database) (int, error) {
+	cursor, err := source.Cursor(database.MakeBucket(nil))
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close()
+
+	dbTx, err := destination.Begin()
+	if err != nil {
+		return 0, err
+	}
+	// dbTx is replaced after every batch, so it must be evaluated only when returning
+	defer func() {
+		_ = dbTx.RollbackUnlessClosed()
+	}()
+
+	copiedCount := 0
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return 0, err
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			return 0, err
+		}
+		err = dbTx.Put(key, value)
+		if err != nil {
+			return 0, err
+		}
+		copiedCount++
+
+		if copiedCount%convertBatchSize == 0 {
+			err = dbTx.Commit()
+			if err != nil {
+				return 0, err
+			}
+			dbTx, err = destination.Begin()
+			if err != nil {
+				return 0, err
+			}
+		}
+		if copiedCount%convertProgressInterval == 0 {
+			fmt.Printf("Copied %d entries...\n", copiedCount)
+		}
+	}
+
+	err = dbTx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return copiedCount, nil
+}
+
+func copyFile(sourcePath string, destinationPath string) error {
+	content, err := os.ReadFile(sourcePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(destinationPath, content, 0600))
+}
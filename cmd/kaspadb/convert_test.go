@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
+)
+
+func TestConvertDatabase(t *testing.T) {
+	sourcePath := t.TempDir()
+	destinationPath := filepath.Join(t.TempDir(), "converted")
+
+	const entryCount = convertBatchSize*2 + 1
+	source, err := dbfactory.Open(dbfactory.TypeLevelDB, sourcePath, 8)
+	if err != nil {
+		t.Fatalf("Open: %s", err)
+	}
+	bucket := database.MakeBucket([]byte("bucket"))
+	for i := 0; i < entryCount; i++ {
+		err := source.Put(bucket.Key([]byte(fmt.Sprintf("key%d", i))), []byte(fmt.Sprintf("value%d", i)))
+		if err != nil {
+			t.Fatalf("Put: %s", err)
+		}
+	}
+	err = source.Close()
+	if err != nil {
+		t.Fatalf("Close: %s", err)
+	}
+
+	copiedCount, err := convertDatabase(sourcePath, dbfactory.TypeLevelDB, destinationPath, dbfactory.TypeLevelDB)
+	if err != nil {
+		t.Fatalf("convertDatabase: %s", err)
+	}
+	if copiedCount != entryCount {
+		t.Fatalf("Unexpected copied count. Want: %d, got: %d", entryCount, copiedCount)
+	}
+
+	destination, err := dbfactory.Open(dbfactory.TypeLevelDB, destinationPath, 8)
+	if err != nil {
+		t.Fatalf("Open: %s", err)
+	}
+	defer destination.Close()
+	for i := 0; i < entryCount; i++ {
+		value, err := destination.Get(bucket.Key([]byte(fmt.Sprintf("key%d", i))))
+		if err != nil {
+			t.Fatalf("Get: %s", err)
+		}
+		if !bytes.Equal(value, []byte(fmt.Sprintf("value%d", i))) {
+			t.Fatalf("Unexpected value for key%d: %s", i, value)
+		}
+	}
+}
+
+func TestConvertRejectsSameType(t *testing.T) {
+	appDir := t.TempDir()
+	cfg := &convertConfig{AppDir: appDir, DbType: dbfactory.TypeLevelDB}
+	cfg.ActiveNetParams = &dagconfig.SimnetParams
+
+	dataDir := cfg.dataDir()
+	err := os.MkdirAll(dataDir, 0700)
+	if err != nil {
+		t.Fatalf("MkdirAll: %s", err)
+	}
+	err = dbfactory.WriteType(dataDir, dbfactory.TypeLevelDB)
+	if err != nil {
+		t.Fatalf("WriteType: %s", err)
+	}
+
+	err = convert(cfg)
+	if err == nil {
+		t.Fatalf("convert unexpectedly succeeded converting a leveldb database to leveldb")
+	}
+}
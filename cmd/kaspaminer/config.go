@@ -39,6 +39,7 @@ type configFlags struct {
 	Profile               string   `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	TargetBlocksPerSecond *float64 `long:"target-blocks-per-second" description:"Sets a maximum block rate. 0 means no limit (The default one is 2 * target network block rate)"`
 	config.NetworkFlags
+	config.RPCCredentialFlags
 }
 
 func parseConfig() (*configFlags, error) {
@@ -81,6 +82,11 @@ func parseConfig() (*configFlags, error) {
 		return nil, errors.New("--miningaddr is required")
 	}
 
+	err = cfg.ValidateRPCCredentials()
+	if err != nil {
+		return nil, err
+	}
+
 	initLog(defaultLogFile, defaultErrLogFile)
 
 	return cfg, nil
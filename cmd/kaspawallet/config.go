@@ -14,6 +14,7 @@ const (
 	balanceSubCmd                   = "balance"
 	sendSubCmd                      = "send"
 	sweepSubCmd                     = "sweep"
+	bumpFeeSubCmd                   = "bump-fee"
 	createUnsignedTransactionSubCmd = "create-unsigned-transaction"
 	signSubCmd                      = "sign"
 	broadcastSubCmd                 = "broadcast"
@@ -22,6 +23,11 @@ const (
 	newAddressSubCmd                = "new-address"
 	dumpUnencryptedDataSubCmd       = "dump-unencrypted-data"
 	startDaemonSubCmd               = "start-daemon"
+	historySubCmd                   = "history"
+	setLabelSubCmd                  = "set-label"
+	listUTXOsSubCmd                 = "list-utxos"
+	lockUTXOsSubCmd                 = "lock-utxos"
+	unlockUTXOsSubCmd               = "unlock-utxos"
 )
 
 const (
@@ -55,10 +61,24 @@ type sendConfig struct {
 	KeysFile                 string   `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kaspawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Kaspawallet\\key.json (Windows))"`
 	Password                 string   `long:"password" short:"p" description:"Wallet password"`
 	DaemonAddress            string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
-	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Kaspa to" required:"true"`
+	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Kaspa to. Either this and --send-amount, or --outputs-file, are required"`
 	FromAddresses            []string `long:"from-address" short:"a" description:"Specific public address to send Kaspa from. Use multiple times to accept several addresses" required:"false"`
-	SendAmount               float64  `long:"send-amount" short:"v" description:"An amount to send in Kaspa (e.g. 1234.12345678)" required:"true"`
+	IncludeOutpoints         []string `long:"include-outpoint" description:"An outpoint, in the format <txID>:<index>, that must be spent by the transaction. Use multiple times to include several outpoints"`
+	ExcludeOutpoints         []string `long:"exclude-outpoint" description:"An outpoint, in the format <txID>:<index>, that must not be spent by the transaction. Use multiple times to exclude several outpoints"`
+	SendAmount               float64  `long:"send-amount" short:"v" description:"An amount to send in Kaspa (e.g. 1234.12345678)"`
+	OutputsFile              string   `long:"outputs-file" description:"A CSV file with an <address>,<amount in Kaspa> line per output, optionally preceded by an address,amount header line, or a JSON file (with a .json extension) with an array of {\"address\": ..., \"amount\": ...} objects, to pay several addresses in a single transaction"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
+	FeeRate                  float64  `long:"fee-rate" description:"Fee rate in sompi per gram of transaction mass (default: estimated by kaspad)"`
+	config.NetworkFlags
+}
+
+type bumpFeeConfig struct {
+	KeysFile                 string  `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kaspawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Kaspawallet\\key.json (Windows))"`
+	Password                 string  `long:"password" short:"p" description:"Wallet password"`
+	DaemonAddress            string  `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	TxID                     string  `long:"txid" description:"The ID of the pending transaction to replace" required:"true"`
+	FeeRate                  float64 `long:"fee-rate" description:"Fee rate in sompi per gram of transaction mass (default: the higher of the kaspad estimate and twice the fee rate of the replaced transaction)"`
+	UseExistingChangeAddress bool    `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
 	config.NetworkFlags
 }
 
@@ -70,10 +90,14 @@ type sweepConfig struct {
 
 type createUnsignedTransactionConfig struct {
 	DaemonAddress            string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
-	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Kaspa to" required:"true"`
+	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Kaspa to. Either this and --send-amount, or --outputs-file, are required"`
 	FromAddresses            []string `long:"from-address" short:"a" description:"Specific public address to send Kaspa from. Use multiple times to accept several addresses" required:"false"`
-	SendAmount               float64  `long:"send-amount" short:"v" description:"An amount to send in Kaspa (e.g. 1234.12345678)" required:"true"`
+	IncludeOutpoints         []string `long:"include-outpoint" description:"An outpoint, in the format <txID>:<index>, that must be spent by the transaction. Use multiple times to include several outpoints"`
+	ExcludeOutpoints         []string `long:"exclude-outpoint" description:"An outpoint, in the format <txID>:<index>, that must not be spent by the transaction. Use multiple times to exclude several outpoints"`
+	SendAmount               float64  `long:"send-amount" short:"v" description:"An amount to send in Kaspa (e.g. 1234.12345678)"`
+	OutputsFile              string   `long:"outputs-file" description:"A CSV file with an <address>,<amount in Kaspa> line per output, optionally preceded by an address,amount header line, or a JSON file (with a .json extension) with an array of {\"address\": ..., \"amount\": ...} objects, to pay several addresses in a single transaction"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
+	FeeRate                  float64  `long:"fee-rate" description:"Fee rate in sompi per gram of transaction mass (default: estimated by kaspad)"`
 	config.NetworkFlags
 }
 
@@ -109,6 +133,38 @@ type newAddressConfig struct {
 	config.NetworkFlags
 }
 
+type historyConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	CSV           bool   `long:"csv" description:"Print the history in CSV format, for importing into accounting software"`
+	config.NetworkFlags
+}
+
+type setLabelConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Address       string `long:"address" short:"a" description:"The address to label. Either this or --txid must be given"`
+	TxID          string `long:"txid" description:"The ID of the transaction to label. Either this or --address must be given"`
+	Label         string `long:"label" short:"l" description:"The label. If empty, the existing label is removed"`
+	config.NetworkFlags
+}
+
+type listUTXOsConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Addresses     []string `long:"address" short:"a" description:"Only list the UTXOs of this address. Use multiple times to list the UTXOs of several addresses"`
+	config.NetworkFlags
+}
+
+type lockUTXOsConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Outpoints     []string `long:"outpoint" short:"o" description:"The outpoint to lock, in the format <txID>:<index>. Use multiple times to lock several outpoints" required:"true"`
+	config.NetworkFlags
+}
+
+type unlockUTXOsConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Outpoints     []string `long:"outpoint" short:"o" description:"The outpoint to unlock, in the format <txID>:<index>. Use multiple times to unlock several outpoints" required:"true"`
+	config.NetworkFlags
+}
+
 type startDaemonConfig struct {
 	KeysFile  string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.kaspawallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Kaspawallet\\key.json (Windows))"`
 	Password  string `long:"password" short:"p" description:"Wallet password"`
@@ -117,6 +173,7 @@ type startDaemonConfig struct {
 	Timeout   uint32 `long:"wait-timeout" short:"w" description:"Waiting timeout for RPC calls, seconds (default: 30 s)"`
 	Profile   string `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	config.NetworkFlags
+	config.RPCCredentialFlags
 }
 
 type dumpUnencryptedDataConfig struct {
@@ -142,6 +199,11 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	parser.AddCommand(sendSubCmd, "Sends a Kaspa transaction to a public address",
 		"Sends a Kaspa transaction to a public address", sendConf)
 
+	bumpFeeConf := &bumpFeeConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(bumpFeeSubCmd, "Replaces a pending transaction with one that pays a higher fee",
+		"Replaces a pending transaction of the current wallet with one that pays the same outputs at a higher fee rate, "+
+			"so that it's mined sooner", bumpFeeConf)
+
 	sweepConf := &sweepConfig{DaemonAddress: defaultListen}
 	parser.AddCommand(sweepSubCmd, "Sends all funds associated with the given schnorr private key to a new address of the current wallet",
 		"Sends all funds associated with the given schnorr private key to a newly created external (i.e. not a change) address of the "+
@@ -177,6 +239,31 @@ func parseCommandLine() (subCommand string, config interface{}) {
 		"Prints the unencrypted wallet data including its private keys. Anyone that sees it can access "+
 			"the funds. Use only on safe environment.", dumpUnencryptedDataConf)
 
+	historyConf := &historyConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(historySubCmd, "Shows the transaction history of the wallet",
+		"Shows the transactions that sent funds to or from the wallet, as recorded by the wallet daemon, along with "+
+			"their labels. Use --csv to export the history for accounting.", historyConf)
+
+	setLabelConf := &setLabelConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(setLabelSubCmd, "Labels an address or a transaction",
+		"Sets the label of an address or of a transaction in the history of the wallet. Labels are shown by "+
+			"the 'history' command", setLabelConf)
+
+	listUTXOsConf := &listUTXOsConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(listUTXOsSubCmd, "Lists the UTXOs of the wallet",
+		"Lists the UTXOs of the wallet along with their outpoints, which can be passed to --include-outpoint and "+
+			"--exclude-outpoint when creating a transaction, or to 'lock-utxos'", listUTXOsConf)
+
+	lockUTXOsConf := &lockUTXOsConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(lockUTXOsSubCmd, "Locks UTXOs so that they are not spent",
+		"Locks UTXOs of the wallet so that new transactions don't spend them unless they're included explicitly "+
+			"with --include-outpoint. The locks are kept until the UTXOs are unlocked with 'unlock-utxos'", lockUTXOsConf)
+
+	unlockUTXOsConf := &unlockUTXOsConfig{DaemonAddress: defaultListen}
+	parser.AddCommand(unlockUTXOsSubCmd, "Unlocks locked UTXOs",
+		"Unlocks UTXOs that were locked with 'lock-utxos', or by transactions that were created but won't be "+
+			"broadcast", unlockUTXOsConf)
+
 	startDaemonConf := &startDaemonConfig{
 		RPCServer: defaultRPCServer,
 		Listen:    defaultListen,
@@ -216,6 +303,13 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = sendConf
+	case bumpFeeSubCmd:
+		combineNetworkFlags(&bumpFeeConf.NetworkFlags, &cfg.NetworkFlags)
+		err := bumpFeeConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = bumpFeeConf
 	case sweepSubCmd:
 		combineNetworkFlags(&sweepConf.NetworkFlags, &cfg.NetworkFlags)
 		err := sweepConf.ResolveNetwork(parser)
@@ -272,12 +366,51 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = dumpUnencryptedDataConf
+	case historySubCmd:
+		combineNetworkFlags(&historyConf.NetworkFlags, &cfg.NetworkFlags)
+		err := historyConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = historyConf
+	case setLabelSubCmd:
+		combineNetworkFlags(&setLabelConf.NetworkFlags, &cfg.NetworkFlags)
+		err := setLabelConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = setLabelConf
+	case listUTXOsSubCmd:
+		combineNetworkFlags(&listUTXOsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := listUTXOsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = listUTXOsConf
+	case lockUTXOsSubCmd:
+		combineNetworkFlags(&lockUTXOsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := lockUTXOsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = lockUTXOsConf
+	case unlockUTXOsSubCmd:
+		combineNetworkFlags(&unlockUTXOsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := unlockUTXOsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = unlockUTXOsConf
 	case startDaemonSubCmd:
 		combineNetworkFlags(&startDaemonConf.NetworkFlags, &cfg.NetworkFlags)
 		err := startDaemonConf.ResolveNetwork(parser)
 		if err != nil {
 			printErrorAndExit(err)
 		}
+		err = startDaemonConf.ValidateRPCCredentials()
+		if err != nil {
+			printErrorAndExit(err)
+		}
 		config = startDaemonConf
 	}
 
@@ -7,10 +7,14 @@ import (
 
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/client"
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
-	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
 )
 
 func createUnsignedTransaction(conf *createUnsignedTransactionConfig) error {
+	toAddress, sendAmountSompi, outputs, err := paymentOutputs(conf.ToAddress, conf.SendAmount, conf.OutputsFile)
+	if err != nil {
+		return err
+	}
+
 	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
 	if err != nil {
 		return err
@@ -20,12 +24,15 @@ func createUnsignedTransaction(conf *createUnsignedTransactionConfig) error {
 	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
 	defer cancel()
 
-	sendAmountSompi := uint64(conf.SendAmount * constants.SompiPerKaspa)
 	response, err := daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 		From:                     conf.FromAddresses,
-		Address:                  conf.ToAddress,
+		IncludeOutpoints:         conf.IncludeOutpoints,
+		ExcludeOutpoints:         conf.ExcludeOutpoints,
+		Address:                  toAddress,
 		Amount:                   sendAmountSompi,
+		Outputs:                  outputs,
 		UseExistingChangeAddress: conf.UseExistingChangeAddress,
+		FeeRate:                  conf.FeeRate,
 	})
 	if err != nil {
 		return err
@@ -28,6 +28,13 @@ type KaspawalletdClient interface {
 	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
 	// Since SignRequest contains a password - this command should only be used on a trusted or secure connection
 	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	SetLabel(ctx context.Context, in *SetLabelRequest, opts ...grpc.CallOption) (*SetLabelResponse, error)
+	SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsRequest, opts ...grpc.CallOption) (Kaspawalletd_SubscribeWalletEventsClient, error)
+	ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error)
+	LockUtxos(ctx context.Context, in *LockUtxosRequest, opts ...grpc.CallOption) (*LockUtxosResponse, error)
+	UnlockUtxos(ctx context.Context, in *UnlockUtxosRequest, opts ...grpc.CallOption) (*UnlockUtxosResponse, error)
 }
 
 type kaspawalletdClient struct {
@@ -119,6 +126,92 @@ func (c *kaspawalletdClient) Sign(ctx context.Context, in *SignRequest, opts ...
 	return out, nil
 }
 
+func (c *kaspawalletdClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/kaspawalletd.kaspawalletd/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kaspawalletdClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/kaspawalletd.kaspawalletd/GetTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kaspawalletdClient) SetLabel(ctx context.Context, in *SetLabelRequest, opts ...grpc.CallOption) (*SetLabelResponse, error) {
+	out := new(SetLabelResponse)
+	err := c.cc.Invoke(ctx, "/kaspawalletd.kaspawalletd/SetLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kaspawalletdClient) SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsRequest, opts ...grpc.CallOption) (Kaspawalletd_SubscribeWalletEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Kaspawalletd_serviceDesc.Streams[0], "/kaspawalletd.kaspawalletd/SubscribeWalletEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kaspawalletdSubscribeWalletEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Kaspawalletd_SubscribeWalletEventsClient interface {
+	Recv() (*WalletEvent, error)
+	grpc.ClientStream
+}
+
+type kaspawalletdSubscribeWalletEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *kaspawalletdSubscribeWalletEventsClient) Recv() (*WalletEvent, error) {
+	m := new(WalletEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kaspawalletdClient) ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error) {
+	out := new(ListUtxosResponse)
+	err := c.cc.Invoke(ctx, "/kaspawalletd.kaspawalletd/ListUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kaspawalletdClient) LockUtxos(ctx context.Context, in *LockUtxosRequest, opts ...grpc.CallOption) (*LockUtxosResponse, error) {
+	out := new(LockUtxosResponse)
+	err := c.cc.Invoke(ctx, "/kaspawalletd.kaspawalletd/LockUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kaspawalletdClient) UnlockUtxos(ctx context.Context, in *UnlockUtxosRequest, opts ...grpc.CallOption) (*UnlockUtxosResponse, error) {
+	out := new(UnlockUtxosResponse)
+	err := c.cc.Invoke(ctx, "/kaspawalletd.kaspawalletd/UnlockUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KaspawalletdServer is the server API for Kaspawalletd service.
 // All implementations must embed UnimplementedKaspawalletdServer
 // for forward compatibility
@@ -134,6 +227,13 @@ type KaspawalletdServer interface {
 	Send(context.Context, *SendRequest) (*SendResponse, error)
 	// Since SignRequest contains a password - this command should only be used on a trusted or secure connection
 	Sign(context.Context, *SignRequest) (*SignResponse, error)
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	SetLabel(context.Context, *SetLabelRequest) (*SetLabelResponse, error)
+	SubscribeWalletEvents(*SubscribeWalletEventsRequest, Kaspawalletd_SubscribeWalletEventsServer) error
+	ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error)
+	LockUtxos(context.Context, *LockUtxosRequest) (*LockUtxosResponse, error)
+	UnlockUtxos(context.Context, *UnlockUtxosRequest) (*UnlockUtxosResponse, error)
 	mustEmbedUnimplementedKaspawalletdServer()
 }
 
@@ -168,6 +268,27 @@ func (*UnimplementedKaspawalletdServer) Send(context.Context, *SendRequest) (*Se
 func (*UnimplementedKaspawalletdServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
 }
+func (*UnimplementedKaspawalletdServer) BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
+func (*UnimplementedKaspawalletdServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (*UnimplementedKaspawalletdServer) SetLabel(context.Context, *SetLabelRequest) (*SetLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLabel not implemented")
+}
+func (*UnimplementedKaspawalletdServer) SubscribeWalletEvents(*SubscribeWalletEventsRequest, Kaspawalletd_SubscribeWalletEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeWalletEvents not implemented")
+}
+func (*UnimplementedKaspawalletdServer) ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUtxos not implemented")
+}
+func (*UnimplementedKaspawalletdServer) LockUtxos(context.Context, *LockUtxosRequest) (*LockUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockUtxos not implemented")
+}
+func (*UnimplementedKaspawalletdServer) UnlockUtxos(context.Context, *UnlockUtxosRequest) (*UnlockUtxosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUtxos not implemented")
+}
 func (*UnimplementedKaspawalletdServer) mustEmbedUnimplementedKaspawalletdServer() {}
 
 func RegisterKaspawalletdServer(s *grpc.Server, srv KaspawalletdServer) {
@@ -336,6 +457,135 @@ func _Kaspawalletd_Sign_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Kaspawalletd_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kaspawalletd.kaspawalletd/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kaspawalletd_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kaspawalletd.kaspawalletd/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kaspawalletd_SetLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).SetLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kaspawalletd.kaspawalletd/SetLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).SetLabel(ctx, req.(*SetLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kaspawalletd_SubscribeWalletEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeWalletEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KaspawalletdServer).SubscribeWalletEvents(m, &kaspawalletdSubscribeWalletEventsServer{stream})
+}
+
+type Kaspawalletd_SubscribeWalletEventsServer interface {
+	Send(*WalletEvent) error
+	grpc.ServerStream
+}
+
+type kaspawalletdSubscribeWalletEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *kaspawalletdSubscribeWalletEventsServer) Send(m *WalletEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Kaspawalletd_ListUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).ListUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kaspawalletd.kaspawalletd/ListUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).ListUtxos(ctx, req.(*ListUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kaspawalletd_LockUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).LockUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kaspawalletd.kaspawalletd/LockUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).LockUtxos(ctx, req.(*LockUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kaspawalletd_UnlockUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KaspawalletdServer).UnlockUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kaspawalletd.kaspawalletd/UnlockUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KaspawalletdServer).UnlockUtxos(ctx, req.(*UnlockUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Kaspawalletd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kaspawalletd.kaspawalletd",
 	HandlerType: (*KaspawalletdServer)(nil),
@@ -376,7 +626,37 @@ var _Kaspawalletd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Sign",
 			Handler:    _Kaspawalletd_Sign_Handler,
 		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _Kaspawalletd_BumpFee_Handler,
+		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _Kaspawalletd_GetTransactions_Handler,
+		},
+		{
+			MethodName: "SetLabel",
+			Handler:    _Kaspawalletd_SetLabel_Handler,
+		},
+		{
+			MethodName: "ListUtxos",
+			Handler:    _Kaspawalletd_ListUtxos_Handler,
+		},
+		{
+			MethodName: "LockUtxos",
+			Handler:    _Kaspawalletd_LockUtxos_Handler,
+		},
+		{
+			MethodName: "UnlockUtxos",
+			Handler:    _Kaspawalletd_UnlockUtxos_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeWalletEvents",
+			Handler:       _Kaspawalletd_SubscribeWalletEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "kaspawalletd.proto",
 }
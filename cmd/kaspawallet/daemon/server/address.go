@@ -36,6 +36,15 @@ func (s *server) changeAddress(useFirst bool) (util.Address, *walletAddress, err
 	if err != nil {
 		return nil, nil, err
 	}
+
+	// The address is watched right away, so that payments to it are recognized while they're pending
+	if _, ok := s.addressSet[address.String()]; !ok {
+		s.addressSet[address.String()] = walletAddr
+		err = s.syncAddresses([]string{address.String()})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 	return address, walletAddr, nil
 }
 
@@ -93,6 +102,11 @@ func (s *server) NewAddress(_ context.Context, request *pb.NewAddressRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	s.addressSet[address.String()] = walletAddr
+	err = s.syncAddresses([]string{address.String()})
+	if err != nil {
+		return nil, err
+	}
 
 	return &pb.NewAddressResponse{Address: address.String()}, nil
 }
@@ -18,11 +18,27 @@ func (s *server) GetBalance(_ context.Context, _ *pb.GetBalanceRequest) (*pb.Get
 	if err != nil {
 		return nil, err
 	}
-	daaScore := dagInfo.VirtualDAAScore
+
+	available, pending, addressBalances, err := s.calculateBalances(s.utxosSortedByAmount, dagInfo.VirtualDAAScore)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetBalanceResponse{
+		Available:       available,
+		Pending:         pending,
+		AddressBalances: addressBalances,
+	}, nil
+}
+
+// calculateBalances returns the total and per-address balances of the given UTXOs at the given virtual DAA score
+func (s *server) calculateBalances(utxos []*walletUTXO, daaScore uint64) (
+	available uint64, pending uint64, addressBalances []*pb.AddressBalances, err error) {
+
 	maturity := s.params.BlockCoinbaseMaturity
 
 	balancesMap := make(balancesMapType, 0)
-	for _, entry := range s.utxosSortedByAmount {
+	for _, entry := range utxos {
 		amount := entry.UTXOEntry.Amount()
 		address := entry.address
 		balances, ok := balancesMap[address]
@@ -37,13 +53,12 @@ func (s *server) GetBalance(_ context.Context, _ *pb.GetBalanceRequest) (*pb.Get
 		}
 	}
 
-	addressBalances := make([]*pb.AddressBalances, len(balancesMap))
+	addressBalances = make([]*pb.AddressBalances, len(balancesMap))
 	i := 0
-	var available, pending uint64
 	for walletAddress, balances := range balancesMap {
 		address, err := libkaspawallet.Address(s.params, s.keysFile.ExtendedPublicKeys, s.keysFile.MinimumSignatures, s.walletAddressPath(walletAddress), s.keysFile.ECDSA)
 		if err != nil {
-			return nil, err
+			return 0, 0, nil, err
 		}
 		addressBalances[i] = &pb.AddressBalances{
 			Address:   address.String(),
@@ -55,11 +70,7 @@ func (s *server) GetBalance(_ context.Context, _ *pb.GetBalanceRequest) (*pb.Get
 		pending += balances.pending
 	}
 
-	return &pb.GetBalanceResponse{
-		Available:       available,
-		Pending:         pending,
-		AddressBalances: addressBalances,
-	}, nil
+	return available, pending, addressBalances, nil
 }
 
 func isUTXOSpendable(entry *walletUTXO, virtualDAAScore uint64, coinbaseMaturity uint64) bool {
@@ -16,7 +16,7 @@ func (s *server) Broadcast(_ context.Context, request *pb.BroadcastRequest) (*pb
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	txIDs, err := s.broadcast(request.Transactions, request.IsDomain)
+	txIDs, err := s.broadcast(request.Transactions, request.IsDomain, request.IsReplacement)
 	if err != nil {
 		return nil, err
 	}
@@ -24,7 +24,7 @@ func (s *server) Broadcast(_ context.Context, request *pb.BroadcastRequest) (*pb
 	return &pb.BroadcastResponse{TxIDs: txIDs}, nil
 }
 
-func (s *server) broadcast(transactions [][]byte, isDomain bool) ([]string, error) {
+func (s *server) broadcast(transactions [][]byte, isDomain bool, isReplacement bool) ([]string, error) {
 
 	txIDs := make([]string, len(transactions))
 	var tx *externalapi.DomainTransaction
@@ -44,17 +44,36 @@ func (s *server) broadcast(transactions [][]byte, isDomain bool) ([]string, erro
 			}
 		}
 
-		txIDs[i], err = sendTransaction(s.rpcClient, tx)
+		if isReplacement {
+			txIDs[i], err = sendTransactionReplacement(s.rpcClient, tx)
+		} else {
+			txIDs[i], err = sendTransaction(s.rpcClient, tx)
+		}
 		if err != nil {
+			// The transaction was rejected, so its inputs are free to be spent by other transactions
+			s.unlockTransactionInputs(tx)
+			saveErr := s.utxoLocks.save()
+			if saveErr != nil {
+				return nil, saveErr
+			}
 			return nil, err
 		}
 
 		for _, input := range tx.Inputs {
 			s.usedOutpoints[input.PreviousOutpoint] = time.Now()
 		}
+		s.lockBroadcastTransactionInputs(tx)
+		s.broadcastTransactions = append(s.broadcastTransactions, tx)
+	}
+
+	err = s.utxoLocks.save()
+	if err != nil {
+		return nil, err
 	}
 
-	err = s.refreshUTXOs()
+	// Refresh the mempool entries right away, so that the broadcast transactions appear
+	// in the history without waiting for the notifications about them
+	err = s.refreshMempoolEntries()
 	if err != nil {
 		return nil, err
 	}
@@ -69,3 +88,12 @@ func sendTransaction(client *rpcclient.RPCClient, tx *externalapi.DomainTransact
 	}
 	return submitTransactionResponse.TransactionID, nil
 }
+
+func sendTransactionReplacement(client *rpcclient.RPCClient, tx *externalapi.DomainTransaction) (string, error) {
+	submitTransactionReplacementResponse, err := client.SubmitTransactionReplacement(
+		appmessage.DomainTransactionToRPCTransaction(tx))
+	if err != nil {
+		return "", errors.Wrapf(err, "error submitting transaction replacement")
+	}
+	return submitTransactionReplacementResponse.TransactionID, nil
+}
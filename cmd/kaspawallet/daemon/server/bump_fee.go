@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/pkg/errors"
+)
+
+// defaultFeeRateMultiplier is the minimal factor by which a fee bump raises the fee rate
+// of the replaced transaction, when no fee rate is given
+const defaultFeeRateMultiplier = 2
+
+func (s *server) BumpFee(_ context.Context, request *pb.BumpFeeRequest) (*pb.BumpFeeResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	unsignedTransaction, err := s.bumpFee(request.TxID, request.FeeRate, request.UseExistingChangeAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.lockTransactionsInputs([][]byte{unsignedTransaction})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BumpFeeResponse{UnsignedTransactions: [][]byte{unsignedTransaction}}, nil
+}
+
+// bumpFee creates an unsigned transaction that replaces the pending transaction with the given ID.
+// The replacement spends all the inputs of the original transaction, and more if needed, and pays
+// all of its outputs except for the change at the given fee rate.
+func (s *server) bumpFee(txID string, feeRate float64, useExistingChangeAddress bool) ([]byte, error) {
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	getMempoolEntryResponse, err := s.rpcClient.GetMempoolEntry(txID, false, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "transaction %s is not pending in the mempool", txID)
+	}
+	originalTransaction, err := appmessage.RPCTransactionToDomainTransaction(getMempoolEntryResponse.Entry.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	originalFee := getMempoolEntryResponse.Entry.Fee
+	originalFeeRate := float64(originalFee) / float64(s.txMassCalculator.CalculateTransactionMass(originalTransaction))
+
+	if feeRate == 0 {
+		feeRate, err = s.resolveFeeRate(0)
+		if err != nil {
+			return nil, err
+		}
+		if feeRate < originalFeeRate*defaultFeeRateMultiplier {
+			feeRate = originalFeeRate * defaultFeeRateMultiplier
+		}
+	}
+	if feeRate <= originalFeeRate {
+		return nil, errors.Errorf("The fee rate must be higher than the %f sompi/gram paid by transaction %s",
+			originalFeeRate, txID)
+	}
+
+	originalUTXOs, err := s.originalTransactionUTXOs(originalTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.paymentsWithoutChange(originalTransaction)
+	if err != nil {
+		return nil, err
+	}
+	paymentsAmount := uint64(0)
+	for _, payment := range payments {
+		paymentsAmount += payment.Amount
+	}
+
+	err = s.handlePendingNotifications()
+	if err != nil {
+		return nil, err
+	}
+	additionalUTXOs, err := s.spendableUTXOs()
+	if err != nil {
+		return nil, err
+	}
+
+	changeAddress, _, err := s.changeAddress(useExistingChangeAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// The replacement has to pay more than the original transaction, in addition to the fee rate
+	minimumFee := originalFee + 1
+	fee := minimumFee
+	selectedUTXOs := originalUTXOs
+	for {
+		totalValue := uint64(0)
+		for _, utxo := range selectedUTXOs {
+			totalValue += utxo.UTXOEntry.Amount()
+		}
+		if totalValue < paymentsAmount+fee {
+			if len(additionalUTXOs) == 0 {
+				return nil, errors.Errorf("Insufficient funds for fee bump: %f required, while only %f available",
+					float64(paymentsAmount+fee)/constants.SompiPerKaspa, float64(totalValue)/constants.SompiPerKaspa)
+			}
+			selectedUTXOs = append(selectedUTXOs, additionalUTXOs[0])
+			additionalUTXOs = additionalUTXOs[1:]
+			continue
+		}
+
+		replacementPayments := payments
+		if changeSompi := totalValue - paymentsAmount - fee; changeSompi > 0 {
+			replacementPayments = append(replacementPayments[:len(replacementPayments):len(replacementPayments)],
+				&libkaspawallet.Payment{
+					Address: changeAddress,
+					Amount:  changeSompi,
+				})
+		}
+		unsignedTransaction, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+			s.keysFile.MinimumSignatures, replacementPayments, selectedUTXOs)
+		if err != nil {
+			return nil, err
+		}
+
+		requiredFee, err := s.requiredFee(unsignedTransaction, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		if requiredFee < minimumFee {
+			requiredFee = minimumFee
+		}
+		if fee >= requiredFee {
+			return unsignedTransaction, nil
+		}
+		fee = requiredFee
+	}
+}
+
+// originalTransactionUTXOs returns the wallet UTXOs spent by the given transaction
+func (s *server) originalTransactionUTXOs(transaction *externalapi.DomainTransaction) ([]*libkaspawallet.UTXO, error) {
+	getUTXOsByAddressesResponse, err := s.rpcClient.GetUTXOsByAddresses(s.addressSet.strings())
+	if err != nil {
+		return nil, err
+	}
+	utxosByOutpoint := make(map[externalapi.DomainOutpoint]*libkaspawallet.UTXO, len(getUTXOsByAddressesResponse.Entries))
+	for _, entry := range getUTXOsByAddressesResponse.Entries {
+		outpoint, err := appmessage.RPCOutpointToDomainOutpoint(entry.Outpoint)
+		if err != nil {
+			return nil, err
+		}
+		utxoEntry, err := appmessage.RPCUTXOEntryToUTXOEntry(entry.UTXOEntry)
+		if err != nil {
+			return nil, err
+		}
+		address, ok := s.addressSet[entry.Address]
+		if !ok {
+			return nil, errors.Errorf("Got result from address %s even though it wasn't requested", entry.Address)
+		}
+		utxosByOutpoint[*outpoint] = &libkaspawallet.UTXO{
+			Outpoint:       outpoint,
+			UTXOEntry:      utxoEntry,
+			DerivationPath: s.walletAddressPath(address),
+		}
+	}
+
+	utxos := make([]*libkaspawallet.UTXO, len(transaction.Inputs))
+	for i, input := range transaction.Inputs {
+		utxo, ok := utxosByOutpoint[input.PreviousOutpoint]
+		if !ok {
+			return nil, errors.Errorf("Output %s spent by the transaction either doesn't belong to this "+
+				"wallet or isn't confirmed yet", input.PreviousOutpoint)
+		}
+		utxos[i] = utxo
+	}
+	return utxos, nil
+}
+
+// paymentsWithoutChange returns the outputs of the given transaction, except for those paying
+// to the change addresses of this wallet
+func (s *server) paymentsWithoutChange(transaction *externalapi.DomainTransaction) ([]*libkaspawallet.Payment, error) {
+	payments := make([]*libkaspawallet.Payment, 0, len(transaction.Outputs))
+	for _, output := range transaction.Outputs {
+		_, address, err := txscript.ExtractScriptPubKeyAddress(output.ScriptPublicKey, s.params)
+		if err != nil {
+			return nil, err
+		}
+		if walletAddress, ok := s.addressSet[address.String()]; ok && walletAddress.keyChain == libkaspawallet.InternalKeychain {
+			continue
+		}
+		payments = append(payments, &libkaspawallet.Payment{
+			Address: address,
+			Amount:  output.Value,
+		})
+	}
+	if len(payments) == 0 {
+		return nil, errors.New("The transaction only pays to change addresses of this wallet")
+	}
+	return payments, nil
+}
+
+// spendableUTXOs returns the wallet UTXOs that are available for new transactions,
+// from the largest to the smallest. Locked UTXOs aren't available
+func (s *server) spendableUTXOs() ([]*libkaspawallet.UTXO, error) {
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make([]*libkaspawallet.UTXO, 0, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		if !isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, s.params.BlockCoinbaseMaturity) {
+			continue
+		}
+		if _, ok := s.usedOutpoints[*utxo.Outpoint]; ok {
+			continue
+		}
+		if s.utxoLockOf(utxo.Outpoint) != nil {
+			continue
+		}
+		utxos = append(utxos, &libkaspawallet.UTXO{
+			Outpoint:       utxo.Outpoint,
+			UTXOEntry:      utxo.UTXOEntry,
+			DerivationPath: s.walletAddressPath(utxo.address),
+		})
+	}
+	return utxos, nil
+}
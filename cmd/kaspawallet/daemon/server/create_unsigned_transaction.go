@@ -3,26 +3,27 @@ package server
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
 	"github.com/kaspanet/kaspad/util"
 	"github.com/pkg/errors"
 	"golang.org/x/exp/slices"
 )
 
-// TODO: Implement a better fee estimation mechanism
-const feePerInput = 10000
-
 func (s *server) CreateUnsignedTransactions(_ context.Context, request *pb.CreateUnsignedTransactionsRequest) (
 	*pb.CreateUnsignedTransactionsResponse, error,
 ) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	unsignedTransactions, err := s.createUnsignedTransactions(request.Address, request.Amount, request.From, request.UseExistingChangeAddress)
+	unsignedTransactions, err := s.createUnsignedTransactions(request.Address, request.Amount, request.Outputs,
+		request.From, request.IncludeOutpoints, request.ExcludeOutpoints, request.UseExistingChangeAddress, request.FeeRate)
 	if err != nil {
 		return nil, err
 	}
@@ -30,19 +31,34 @@ func (s *server) CreateUnsignedTransactions(_ context.Context, request *pb.Creat
 	return &pb.CreateUnsignedTransactionsResponse{UnsignedTransactions: unsignedTransactions}, nil
 }
 
-func (s *server) createUnsignedTransactions(address string, amount uint64, fromAddressesString []string, useExistingChangeAddress bool) ([][]byte, error) {
+// coinControl constrains the UTXOs that selectUTXOs may select
+type coinControl struct {
+	// fromAddresses are the only addresses UTXOs are selected from, unless it's nil
+	fromAddresses []*walletAddress
+	// includeOutpoints are always selected, regardless of fromAddresses and of locks made by the user
+	includeOutpoints map[externalapi.DomainOutpoint]struct{}
+	excludeOutpoints map[externalapi.DomainOutpoint]struct{}
+}
+
+// createUnsignedTransactions creates the unsigned transactions that send amount to address, or that pay
+// the given outputs, and locks their inputs. feeRate is in sompi per gram of mass. If it's 0, the fee rate
+// is estimated by kaspad
+func (s *server) createUnsignedTransactions(address string, amount uint64, outputs []*pb.PaymentOutput,
+	fromAddressesString []string, includeOutpoints []string, excludeOutpoints []string, useExistingChangeAddress bool,
+	feeRate float64) ([][]byte, error) {
+
 	if !s.isSynced() {
 		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
 	}
 
-	// make sure address string is correct before proceeding to a
+	// make sure the addresses are correct before proceeding to a
 	// potentially long UTXO refreshment operation
-	toAddress, err := util.DecodeAddress(address, s.params.Prefix)
+	payments, err := s.payments(address, amount, outputs)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.refreshUTXOs()
+	err = s.handlePendingNotifications()
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +72,22 @@ func (s *server) createUnsignedTransactions(address string, amount uint64, fromA
 		fromAddresses = append(fromAddresses, fromAddress)
 	}
 
-	selectedUTXOs, changeSompi, err := s.selectUTXOs(amount, feePerInput, fromAddresses)
+	coinControl := &coinControl{fromAddresses: fromAddresses}
+	coinControl.includeOutpoints, err = parseOutpoints(includeOutpoints)
+	if err != nil {
+		return nil, err
+	}
+	coinControl.excludeOutpoints, err = parseOutpoints(excludeOutpoints)
+	if err != nil {
+		return nil, err
+	}
+	for outpoint := range coinControl.includeOutpoints {
+		if _, ok := coinControl.excludeOutpoints[outpoint]; ok {
+			return nil, errors.Errorf("Outpoint %s is both included and excluded", outpointString(&outpoint))
+		}
+	}
+
+	feeRate, err = s.resolveFeeRate(feeRate)
 	if err != nil {
 		return nil, err
 	}
@@ -66,31 +97,135 @@ func (s *server) createUnsignedTransactions(address string, amount uint64, fromA
 		return nil, err
 	}
 
-	payments := []*libkaspawallet.Payment{{
-		Address: toAddress,
-		Amount:  amount,
-	}}
-	if changeSompi > 0 {
-		payments = append(payments, &libkaspawallet.Payment{
-			Address: changeAddress,
-			Amount:  changeSompi,
-		})
+	// The required fee depends on the mass of the transaction, which in turn depends
+	// on the selected UTXOs, so we raise the fee until the transaction pays for itself
+	fee := uint64(0)
+	var unsignedTransaction []byte
+	for {
+		selectedUTXOs, changeSompi, err := s.selectUTXOs(totalPaymentsAmount(payments), fee, coinControl)
+		if err != nil {
+			return nil, err
+		}
+
+		transactionPayments := make([]*libkaspawallet.Payment, len(payments), len(payments)+1)
+		copy(transactionPayments, payments)
+		if changeSompi > 0 {
+			transactionPayments = append(transactionPayments, &libkaspawallet.Payment{
+				Address: changeAddress,
+				Amount:  changeSompi,
+			})
+		}
+		unsignedTransaction, err = libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+			s.keysFile.MinimumSignatures,
+			transactionPayments, selectedUTXOs)
+		if err != nil {
+			return nil, err
+		}
+
+		requiredFee, err := s.requiredFee(unsignedTransaction, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		if fee >= requiredFee {
+			break
+		}
+		fee = requiredFee
 	}
-	unsignedTransaction, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
-		s.keysFile.MinimumSignatures,
-		payments, selectedUTXOs)
+
+	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, payments, changeAddress, changeWalletAddress,
+		feeRate)
 	if err != nil {
 		return nil, err
 	}
 
-	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, toAddress, changeAddress, changeWalletAddress)
+	// Other clients of the daemon might create transactions before these are broadcast,
+	// so their inputs are locked to keep them from being selected again
+	err = s.lockTransactionsInputs(unsignedTransactions)
 	if err != nil {
 		return nil, err
 	}
 	return unsignedTransactions, nil
 }
 
-func (s *server) selectUTXOs(spendAmount uint64, feePerInput uint64, fromAddresses []*walletAddress) (
+// payments returns the payments of a transaction, which are given either as a single address and
+// amount, or as a list of outputs
+func (s *server) payments(address string, amount uint64, outputs []*pb.PaymentOutput) ([]*libkaspawallet.Payment, error) {
+	if len(outputs) == 0 {
+		toAddress, err := util.DecodeAddress(address, s.params.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		return []*libkaspawallet.Payment{{Address: toAddress, Amount: amount}}, nil
+	}
+
+	if address != "" || amount != 0 {
+		return nil, errors.Errorf("An address and an amount cannot be given together with a list of outputs")
+	}
+	payments := make([]*libkaspawallet.Payment, len(outputs))
+	totalAmount := uint64(0)
+	for i, output := range outputs {
+		toAddress, err := util.DecodeAddress(output.Address, s.params.Prefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid address in output #%d", i)
+		}
+		if output.Amount == 0 {
+			return nil, errors.Errorf("The amount of output #%d is 0", i)
+		}
+		if totalAmount+output.Amount < totalAmount {
+			return nil, errors.Errorf("The total amount of the outputs overflows")
+		}
+		totalAmount += output.Amount
+		payments[i] = &libkaspawallet.Payment{Address: toAddress, Amount: output.Amount}
+	}
+	return payments, nil
+}
+
+func totalPaymentsAmount(payments []*libkaspawallet.Payment) uint64 {
+	totalAmount := uint64(0)
+	for _, payment := range payments {
+		totalAmount += payment.Amount
+	}
+	return totalAmount
+}
+
+// resolveFeeRate returns the given fee rate, or the fee rate kaspad estimates for
+// inclusion within the next few seconds if it's 0
+func (s *server) resolveFeeRate(feeRate float64) (float64, error) {
+	if feeRate < 0 || math.IsNaN(feeRate) || math.IsInf(feeRate, 0) {
+		return 0, errors.Errorf("Invalid fee rate %f", feeRate)
+	}
+	if feeRate > 0 {
+		return feeRate, nil
+	}
+
+	getFeeEstimateResponse, err := s.rpcClient.GetFeeEstimate()
+	if err != nil {
+		return 0, err
+	}
+	estimate := getFeeEstimateResponse.Estimate
+	if len(estimate.NormalBuckets) > 0 {
+		return estimate.NormalBuckets[0].FeeRate, nil
+	}
+	return estimate.PriorityBucket.FeeRate, nil
+}
+
+// requiredFee returns the fee that the given unsigned transaction has to pay, once
+// signed, in order to pay the given fee rate
+func (s *server) requiredFee(unsignedTransaction []byte, feeRate float64) (uint64, error) {
+	transaction, err := serialization.DeserializePartiallySignedTransaction(unsignedTransaction)
+	if err != nil {
+		return 0, err
+	}
+	mass, err := s.estimateMassAfterSignatures(transaction)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(math.Ceil(float64(mass) * feeRate)), nil
+}
+
+// selectUTXOs selects the UTXOs that pay for spendAmount and fee: first the UTXOs coinControl includes,
+// and then unlocked UTXOs from the largest to the smallest
+func (s *server) selectUTXOs(spendAmount uint64, fee uint64, coinControl *coinControl) (
 	selectedUTXOs []*libkaspawallet.UTXO, changeSompi uint64, err error,
 ) {
 	selectedUTXOs = []*libkaspawallet.UTXO{}
@@ -101,11 +236,54 @@ func (s *server) selectUTXOs(spendAmount uint64, feePerInput uint64, fromAddress
 		return nil, 0, err
 	}
 
+	selectUTXO := func(utxo *walletUTXO) {
+		selectedUTXOs = append(selectedUTXOs, &libkaspawallet.UTXO{
+			Outpoint:       utxo.Outpoint,
+			UTXOEntry:      utxo.UTXOEntry,
+			DerivationPath: s.walletAddressPath(utxo.address),
+		})
+		totalValue += utxo.UTXOEntry.Amount()
+	}
+
+	includedOutpoints := make(map[externalapi.DomainOutpoint]struct{}, len(coinControl.includeOutpoints))
+	for _, utxo := range s.utxosSortedByAmount {
+		if _, ok := coinControl.includeOutpoints[*utxo.Outpoint]; !ok {
+			continue
+		}
+		if !isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, s.params.BlockCoinbaseMaturity) {
+			return nil, 0, errors.Errorf("Outpoint %s is an immature coinbase output", outpointString(utxo.Outpoint))
+		}
+		if lock := s.utxoLockOf(utxo.Outpoint); lock != nil && lock.TxID != "" {
+			return nil, 0, errors.Errorf("Outpoint %s is already spent by transaction %s",
+				outpointString(utxo.Outpoint), lock.TxID)
+		}
+		selectUTXO(utxo)
+		includedOutpoints[*utxo.Outpoint] = struct{}{}
+	}
+	for outpoint := range coinControl.includeOutpoints {
+		if _, ok := includedOutpoints[outpoint]; !ok {
+			return nil, 0, errors.Errorf("Outpoint %s is not an unspent output of this wallet", outpointString(&outpoint))
+		}
+	}
+
 	for _, utxo := range s.utxosSortedByAmount {
-		if (fromAddresses != nil && !slices.Contains(fromAddresses, utxo.address)) ||
+		if totalValue >= spendAmount+fee {
+			break
+		}
+
+		if (coinControl.fromAddresses != nil && !slices.Contains(coinControl.fromAddresses, utxo.address)) ||
 			!isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, s.params.BlockCoinbaseMaturity) {
 			continue
 		}
+		if _, ok := coinControl.includeOutpoints[*utxo.Outpoint]; ok {
+			continue
+		}
+		if _, ok := coinControl.excludeOutpoints[*utxo.Outpoint]; ok {
+			continue
+		}
+		if s.utxoLockOf(utxo.Outpoint) != nil {
+			continue
+		}
 
 		if broadcastTime, ok := s.usedOutpoints[*utxo.Outpoint]; ok {
 			if time.Since(broadcastTime) > time.Minute {
@@ -115,21 +293,9 @@ func (s *server) selectUTXOs(spendAmount uint64, feePerInput uint64, fromAddress
 			}
 		}
 
-		selectedUTXOs = append(selectedUTXOs, &libkaspawallet.UTXO{
-			Outpoint:       utxo.Outpoint,
-			UTXOEntry:      utxo.UTXOEntry,
-			DerivationPath: s.walletAddressPath(utxo.address),
-		})
-		totalValue += utxo.UTXOEntry.Amount()
-
-		fee := feePerInput * uint64(len(selectedUTXOs))
-		totalSpend := spendAmount + fee
-		if totalValue >= totalSpend {
-			break
-		}
+		selectUTXO(utxo)
 	}
 
-	fee := feePerInput * uint64(len(selectedUTXOs))
 	totalSpend := spendAmount + fee
 	if totalValue < totalSpend {
 		return nil, 0, errors.Errorf("Insufficient funds for send: %f required, while only %f available",
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/util"
+)
+
+func TestPayments(t *testing.T) {
+	params := &dagconfig.MainnetParams
+	serverInstance := &server{params: params}
+
+	newAddress := func(seed byte) string {
+		address, err := util.NewAddressPublicKey(append([]byte{seed}, make([]byte, 31)...), params.Prefix)
+		if err != nil {
+			t.Fatalf("NewAddressPublicKey: %+v", err)
+		}
+		return address.String()
+	}
+	address1, address2 := newAddress(1), newAddress(2)
+
+	payments, err := serverInstance.payments(address1, 100, nil)
+	if err != nil {
+		t.Fatalf("payments: %+v", err)
+	}
+	if len(payments) != 1 || payments[0].Address.String() != address1 || payments[0].Amount != 100 {
+		t.Fatalf("Unexpected payments: %+v", payments)
+	}
+
+	outputs := []*pb.PaymentOutput{{Address: address1, Amount: 100}, {Address: address2, Amount: 200}}
+	payments, err = serverInstance.payments("", 0, outputs)
+	if err != nil {
+		t.Fatalf("payments: %+v", err)
+	}
+	if len(payments) != len(outputs) {
+		t.Fatalf("Expected %d payments but got %d", len(outputs), len(payments))
+	}
+	for i, payment := range payments {
+		if payment.Address.String() != outputs[i].Address || payment.Amount != outputs[i].Amount {
+			t.Errorf("Unexpected payment #%d: %+v", i, payment)
+		}
+	}
+	if totalAmount := totalPaymentsAmount(payments); totalAmount != 300 {
+		t.Errorf("Unexpected total amount of the payments: %d", totalAmount)
+	}
+
+	tests := []struct {
+		name    string
+		address string
+		amount  uint64
+		outputs []*pb.PaymentOutput
+	}{
+		{name: "no address", address: "", amount: 100},
+		{name: "address together with outputs", address: address1, amount: 100, outputs: outputs},
+		{name: "invalid output address", outputs: []*pb.PaymentOutput{{Address: "kaspa:invalid", Amount: 100}}},
+		{name: "zero output amount", outputs: []*pb.PaymentOutput{{Address: address1, Amount: 0}}},
+		{name: "overflowing output amounts", outputs: []*pb.PaymentOutput{
+			{Address: address1, Amount: ^uint64(0)}, {Address: address2, Amount: 1}}},
+	}
+	for _, test := range tests {
+		_, err := serverInstance.payments(test.address, test.amount, test.outputs)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
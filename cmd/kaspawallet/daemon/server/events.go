@@ -0,0 +1,172 @@
+package server
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/pkg/errors"
+)
+
+// walletEventsSubscriberCapacity is the amount of events a subscriber may fall behind
+// on before it's disconnected
+const walletEventsSubscriberCapacity = 1000
+
+type walletEventsSubscriber struct {
+	events chan *pb.WalletEvent
+}
+
+func (s *server) SubscribeWalletEvents(_ *pb.SubscribeWalletEventsRequest,
+	stream pb.Kaspawalletd_SubscribeWalletEventsServer) error {
+
+	subscriber, err := s.addWalletEventsSubscriber()
+	if err != nil {
+		return err
+	}
+	defer s.removeWalletEventsSubscriber(subscriber)
+
+	for {
+		select {
+		case event, ok := <-subscriber.events:
+			if !ok {
+				return errors.Errorf("The subscriber fell behind by more than %d wallet events",
+					walletEventsSubscriberCapacity)
+			}
+			err := stream.Send(event)
+			if err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		case <-s.shutdown:
+			return nil
+		}
+	}
+}
+
+// addWalletEventsSubscriber adds a new subscriber, whose first events are the current balance and sync state
+func (s *server) addWalletEventsSubscriber() (*walletEventsSubscriber, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+	available, pending, addressBalances, err := s.calculateBalances(s.utxosSortedByAmount, dagInfo.VirtualDAAScore)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber := &walletEventsSubscriber{
+		events: make(chan *pb.WalletEvent, walletEventsSubscriberCapacity),
+	}
+	subscriber.events <- &pb.WalletEvent{Event: &pb.WalletEvent_BalanceChanged{BalanceChanged: &pb.BalanceChangedEvent{
+		Available:       available,
+		Pending:         pending,
+		AddressBalances: addressBalances,
+	}}}
+	subscriber.events <- &pb.WalletEvent{Event: &pb.WalletEvent_SyncStateChanged{SyncStateChanged: s.syncState()}}
+
+	s.walletEventsSubscribers[subscriber] = struct{}{}
+	return subscriber, nil
+}
+
+func (s *server) removeWalletEventsSubscriber(subscriber *walletEventsSubscriber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.walletEventsSubscribers, subscriber)
+}
+
+// publishWalletEvent sends the given event to all the subscribers. It never blocks: subscribers
+// that fell too far behind are disconnected instead
+func (s *server) publishWalletEvent(event *pb.WalletEvent) {
+	for subscriber := range s.walletEventsSubscribers {
+		select {
+		case subscriber.events <- event:
+		default:
+			log.Warnf("A wallet events subscriber fell too far behind, disconnecting it")
+			close(subscriber.events)
+			delete(s.walletEventsSubscribers, subscriber)
+		}
+	}
+}
+
+// publishBalanceChange publishes the wallet's balance if it's different from its balance with the given previous UTXOs
+func (s *server) publishBalanceChange(previousUTXOs []*walletUTXO) error {
+	if len(s.walletEventsSubscribers) == 0 {
+		return nil
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return err
+	}
+	previousAvailable, previousPending, _, err := s.calculateBalances(previousUTXOs, dagInfo.VirtualDAAScore)
+	if err != nil {
+		return err
+	}
+	available, pending, addressBalances, err := s.calculateBalances(s.utxosSortedByAmount, dagInfo.VirtualDAAScore)
+	if err != nil {
+		return err
+	}
+	if available == previousAvailable && pending == previousPending {
+		return nil
+	}
+
+	s.publishWalletEvent(&pb.WalletEvent{Event: &pb.WalletEvent_BalanceChanged{BalanceChanged: &pb.BalanceChangedEvent{
+		Available:       available,
+		Pending:         pending,
+		AddressBalances: addressBalances,
+	}}})
+	return nil
+}
+
+func (s *server) publishUTXOsReceived(entries []*appmessage.UTXOsByAddressesEntry) {
+	for _, entry := range entries {
+		s.publishWalletEvent(&pb.WalletEvent{Event: &pb.WalletEvent_UtxoReceived{UtxoReceived: &pb.UtxoReceivedEvent{
+			Address:       entry.Address,
+			TxID:          entry.Outpoint.TransactionID,
+			Index:         entry.Outpoint.Index,
+			Amount:        entry.UTXOEntry.Amount,
+			IsCoinbase:    entry.UTXOEntry.IsCoinbase,
+			BlockDAAScore: entry.UTXOEntry.BlockDAAScore,
+		}}})
+	}
+}
+
+func (s *server) publishTransactionConfirmed(transaction *historyTransaction) {
+	s.publishWalletEvent(&pb.WalletEvent{Event: &pb.WalletEvent_TransactionConfirmed{
+		TransactionConfirmed: &pb.TransactionConfirmedEvent{
+			TxID:                 transaction.TxID,
+			Sent:                 transaction.Sent,
+			Fee:                  transaction.Fee,
+			ConfirmationDAAScore: transaction.ConfirmationDAAScore,
+		}}})
+}
+
+func (s *server) syncState() *pb.SyncStateChangedEvent {
+	return &pb.SyncStateChangedEvent{
+		IsSynced: s.isSynced(),
+		Report:   s.formatSyncStateReport(),
+	}
+}
+
+// publishSyncStateChange publishes the sync state if the wallet became synced or unsynced since the
+// last time it was published. While the wallet isn't synced, progress in the sync is published as well
+func (s *server) publishSyncStateChange() {
+	syncState := s.syncState()
+	if s.lastSyncState != nil && syncState.IsSynced == s.lastSyncState.IsSynced &&
+		(syncState.IsSynced || syncState.Report == s.lastSyncState.Report) {
+		return
+	}
+
+	s.lastSyncState = syncState
+	s.publishWalletEvent(&pb.WalletEvent{Event: &pb.WalletEvent_SyncStateChanged{SyncStateChanged: syncState}})
+}
+
+func (s *server) publishSyncStateChangeWithLock() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.publishSyncStateChange()
+}
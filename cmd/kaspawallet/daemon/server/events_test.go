@@ -0,0 +1,61 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/keys"
+)
+
+func TestPublishSyncStateChange(t *testing.T) {
+	serverInstance := &server{
+		keysFile:                &keys.File{},
+		walletEventsSubscribers: make(map[*walletEventsSubscriber]struct{}),
+	}
+	subscriber := &walletEventsSubscriber{events: make(chan *pb.WalletEvent, 2)}
+	serverInstance.walletEventsSubscribers[subscriber] = struct{}{}
+
+	nextSyncState := func() *pb.SyncStateChangedEvent {
+		select {
+		case event := <-subscriber.events:
+			return event.GetSyncStateChanged()
+		default:
+			return nil
+		}
+	}
+
+	// The wallet isn't synced before any address was scanned
+	serverInstance.publishSyncStateChange()
+	syncState := nextSyncState()
+	if syncState == nil || syncState.IsSynced {
+		t.Fatalf("Unexpected sync state: %+v", syncState)
+	}
+	serverInstance.publishSyncStateChange()
+	if syncState := nextSyncState(); syncState != nil {
+		t.Fatalf("An unchanged sync state was published: %+v", syncState)
+	}
+
+	serverInstance.nextSyncStartIndex = 100
+	serverInstance.publishSyncStateChange()
+	syncState = nextSyncState()
+	if syncState == nil || !syncState.IsSynced {
+		t.Fatalf("Unexpected sync state: %+v", syncState)
+	}
+
+	// Once synced, the progress of the scan of far addresses isn't published
+	serverInstance.nextSyncStartIndex = 200
+	serverInstance.publishSyncStateChange()
+	if syncState := nextSyncState(); syncState != nil {
+		t.Fatalf("The progress of a synced wallet was published: %+v", syncState)
+	}
+
+	// A subscriber that doesn't read its events is disconnected once its channel is full
+	for i := 0; i < cap(subscriber.events)+1; i++ {
+		serverInstance.publishWalletEvent(&pb.WalletEvent{})
+	}
+	if _, ok := serverInstance.walletEventsSubscribers[subscriber]; ok {
+		t.Fatalf("A subscriber that fell behind wasn't removed")
+	}
+	for range subscriber.events {
+	}
+}
@@ -9,6 +9,10 @@ import (
 	"github.com/kaspanet/kaspad/util"
 )
 
+// feePerInput is roughly the fee of spending an input, under which external coinbase
+// UTXOs aren't worth spending
+const feePerInput = 10000
+
 func (s *server) GetExternalSpendableUTXOs(_ context.Context, request *pb.GetExternalSpendableUTXOsRequest) (*pb.GetExternalSpendableUTXOsResponse, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
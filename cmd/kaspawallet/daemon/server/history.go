@@ -0,0 +1,357 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+// transactionHistory is the local record of the transactions that sent funds to or from the wallet,
+// along with the labels the user gave to addresses and transactions. It's kept in a JSON file next to
+// the keys file.
+type transactionHistory struct {
+	path string
+
+	Transactions      map[string]*historyTransaction `json:"transactions"`
+	AddressLabels     map[string]string              `json:"addressLabels"`
+	TransactionLabels map[string]string              `json:"transactionLabels"`
+}
+
+// historyTransaction is a transaction in the history of the wallet. All amounts are in sompi.
+type historyTransaction struct {
+	TxID                 string   `json:"txID"`
+	IsOutgoing           bool     `json:"isOutgoing"`
+	IsCoinbase           bool     `json:"isCoinbase"`
+	Received             uint64   `json:"received"`
+	Sent                 uint64   `json:"sent"`
+	Change               uint64   `json:"change"`
+	Fee                  uint64   `json:"fee"`
+	ConfirmationDAAScore uint64   `json:"confirmationDAAScore"`
+	Timestamp            int64    `json:"timestamp"`
+	Addresses            []string `json:"addresses"`
+
+	// Inputs are the wallet outpoints spent by a pending outgoing transaction. They're used to
+	// detect its confirmation when it has no outputs to the wallet, and its replacement.
+	Inputs []string `json:"inputs,omitempty"`
+}
+
+func (tx *historyTransaction) isPending() bool {
+	return tx.ConfirmationDAAScore == 0
+}
+
+func historyFilePath(keysFilePath string) string {
+	return strings.TrimSuffix(keysFilePath, filepath.Ext(keysFilePath)) + "-history.json"
+}
+
+func loadTransactionHistory(path string) (*transactionHistory, error) {
+	history := &transactionHistory{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.WithStack(err)
+	}
+	if err == nil {
+		err = json.Unmarshal(data, history)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing the transaction history file %s", path)
+		}
+	}
+
+	if history.Transactions == nil {
+		history.Transactions = make(map[string]*historyTransaction)
+	}
+	if history.AddressLabels == nil {
+		history.AddressLabels = make(map[string]string)
+	}
+	if history.TransactionLabels == nil {
+		history.TransactionLabels = make(map[string]string)
+	}
+	return history, nil
+}
+
+// save writes the history to a temporary file first, so that a crash while saving doesn't
+// corrupt the existing history
+func (h *transactionHistory) save() error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	temporaryPath := h.path + ".tmp"
+	err = os.WriteFile(temporaryPath, data, 0600)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(temporaryPath, h.path))
+}
+
+// sortedTransactions returns the transactions in the history from the oldest to the newest.
+// Confirmed transactions are ordered by their confirmation DAA score, and are followed by
+// the pending ones
+func (h *transactionHistory) sortedTransactions() []*historyTransaction {
+	transactions := make([]*historyTransaction, 0, len(h.Transactions))
+	for _, transaction := range h.Transactions {
+		transactions = append(transactions, transaction)
+	}
+	sort.Slice(transactions, func(i, j int) bool {
+		if transactions[i].isPending() != transactions[j].isPending() {
+			return !transactions[i].isPending()
+		}
+		if transactions[i].ConfirmationDAAScore != transactions[j].ConfirmationDAAScore {
+			return transactions[i].ConfirmationDAAScore < transactions[j].ConfirmationDAAScore
+		}
+		if transactions[i].Timestamp != transactions[j].Timestamp {
+			return transactions[i].Timestamp < transactions[j].Timestamp
+		}
+		return transactions[i].TxID < transactions[j].TxID
+	})
+	return transactions
+}
+
+func outpointString(outpoint *externalapi.DomainOutpoint) string {
+	return fmt.Sprintf("%s:%d", outpoint.TransactionID, outpoint.Index)
+}
+
+// updateHistory records the transactions that are new to the wallet, and the confirmation of
+// pending ones, given the result of a refresh of the UTXO set. previousUTXOs is the UTXO set of
+// the wallet before the refresh.
+func (s *server) updateHistory(previousUTXOs []*walletUTXO, entries []*appmessage.UTXOsByAddressesEntry,
+	mempoolEntries []*appmessage.MempoolEntryByAddress) error {
+
+	knownUTXOAmounts := make(map[externalapi.DomainOutpoint]uint64, len(previousUTXOs)+len(entries))
+	for _, utxo := range previousUTXOs {
+		knownUTXOAmounts[*utxo.Outpoint] = utxo.UTXOEntry.Amount()
+	}
+	confirmedOutpoints := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		outpoint, err := appmessage.RPCOutpointToDomainOutpoint(entry.Outpoint)
+		if err != nil {
+			return err
+		}
+		knownUTXOAmounts[*outpoint] = entry.UTXOEntry.Amount
+		confirmedOutpoints[outpointString(outpoint)] = struct{}{}
+	}
+
+	isHistoryChanged := false
+
+	// Outgoing transactions are recorded first, so that a transaction that both spends from and
+	// pays to the wallet isn't mistaken for an incoming one
+	outgoingTransactions := s.broadcastTransactions
+	s.broadcastTransactions = nil
+	var incomingTransactions []*externalapi.DomainTransaction
+	for _, entriesByAddress := range mempoolEntries {
+		for _, entry := range entriesByAddress.Sending {
+			transaction, err := appmessage.RPCTransactionToDomainTransaction(entry.Transaction)
+			if err != nil {
+				return err
+			}
+			outgoingTransactions = append(outgoingTransactions, transaction)
+		}
+		for _, entry := range entriesByAddress.Receiving {
+			transaction, err := appmessage.RPCTransactionToDomainTransaction(entry.Transaction)
+			if err != nil {
+				return err
+			}
+			incomingTransactions = append(incomingTransactions, transaction)
+		}
+	}
+
+	mempoolTransactionIDs := make(map[string]struct{})
+	for _, transaction := range append(outgoingTransactions, incomingTransactions...) {
+		txID := consensushashing.TransactionID(transaction).String()
+		mempoolTransactionIDs[txID] = struct{}{}
+		if _, ok := s.history.Transactions[txID]; ok {
+			continue
+		}
+		historyTransaction, err := s.newHistoryTransaction(txID, transaction, knownUTXOAmounts)
+		if err != nil {
+			return err
+		}
+		if historyTransaction == nil {
+			continue
+		}
+		s.recordTransaction(historyTransaction)
+		isHistoryChanged = true
+	}
+
+	entriesByTransactionID := make(map[string][]*appmessage.UTXOsByAddressesEntry)
+	for _, entry := range entries {
+		entriesByTransactionID[entry.Outpoint.TransactionID] = append(entriesByTransactionID[entry.Outpoint.TransactionID], entry)
+	}
+	for txID, transactionEntries := range entriesByTransactionID {
+		historyTransaction, ok := s.history.Transactions[txID]
+		if !ok {
+			historyTransaction = s.historyTransactionFromUTXOs(txID, transactionEntries)
+			s.recordTransaction(historyTransaction)
+			isHistoryChanged = true
+			continue
+		}
+		if historyTransaction.isPending() {
+			historyTransaction.ConfirmationDAAScore = transactionEntries[0].UTXOEntry.BlockDAAScore
+			historyTransaction.Inputs = nil
+			isHistoryChanged = true
+			if historyTransaction.IsOutgoing {
+				s.publishTransactionConfirmed(historyTransaction)
+			}
+		}
+	}
+
+	// A pending outgoing transaction that has no outputs to the wallet is considered confirmed once it
+	// left the mempool and its inputs were spent. Its exact confirmation DAA score isn't known, so the
+	// current virtual DAA score is used instead.
+	var virtualDAAScore uint64
+	for txID, historyTransaction := range s.history.Transactions {
+		if !historyTransaction.isPending() || len(historyTransaction.Inputs) == 0 {
+			continue
+		}
+		if _, ok := mempoolTransactionIDs[txID]; ok {
+			continue
+		}
+		isSpent := true
+		for _, input := range historyTransaction.Inputs {
+			if _, ok := confirmedOutpoints[input]; ok {
+				isSpent = false
+				break
+			}
+		}
+		if !isSpent {
+			continue
+		}
+		if virtualDAAScore == 0 {
+			dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+			if err != nil {
+				return err
+			}
+			virtualDAAScore = dagInfo.VirtualDAAScore
+		}
+		historyTransaction.ConfirmationDAAScore = virtualDAAScore
+		historyTransaction.Inputs = nil
+		isHistoryChanged = true
+		s.publishTransactionConfirmed(historyTransaction)
+	}
+
+	if !isHistoryChanged {
+		return nil
+	}
+	return s.history.save()
+}
+
+// recordTransaction adds the given transaction to the history. Pending outgoing transactions that
+// spend the same inputs were replaced by it, so they're removed, and their labels are moved to it.
+func (s *server) recordTransaction(transaction *historyTransaction) {
+	transactionInputs := make(map[string]struct{}, len(transaction.Inputs))
+	for _, input := range transaction.Inputs {
+		transactionInputs[input] = struct{}{}
+	}
+	for txID, historyTransaction := range s.history.Transactions {
+		if !historyTransaction.isPending() {
+			continue
+		}
+		for _, input := range historyTransaction.Inputs {
+			if _, ok := transactionInputs[input]; !ok {
+				continue
+			}
+			log.Infof("Transaction %s was replaced by transaction %s", txID, transaction.TxID)
+			delete(s.history.Transactions, txID)
+			if label, ok := s.history.TransactionLabels[txID]; ok {
+				if _, ok := s.history.TransactionLabels[transaction.TxID]; !ok {
+					s.history.TransactionLabels[transaction.TxID] = label
+				}
+				delete(s.history.TransactionLabels, txID)
+			}
+			break
+		}
+	}
+
+	s.history.Transactions[transaction.TxID] = transaction
+}
+
+// newHistoryTransaction returns the history record of the given pending transaction, or nil
+// if it neither spends from nor pays to the wallet
+func (s *server) newHistoryTransaction(txID string, transaction *externalapi.DomainTransaction,
+	knownUTXOAmounts map[externalapi.DomainOutpoint]uint64) (*historyTransaction, error) {
+
+	historyTransaction := &historyTransaction{
+		TxID:      txID,
+		Timestamp: mstime.Now().UnixMilliseconds(),
+	}
+
+	inputsAmount := uint64(0)
+	areAllInputsKnown := true
+	for _, input := range transaction.Inputs {
+		amount, ok := knownUTXOAmounts[input.PreviousOutpoint]
+		if !ok {
+			areAllInputsKnown = false
+			continue
+		}
+		inputsAmount += amount
+		historyTransaction.Inputs = append(historyTransaction.Inputs, outpointString(&input.PreviousOutpoint))
+	}
+	historyTransaction.IsOutgoing = len(historyTransaction.Inputs) > 0
+
+	outputsAmount := uint64(0)
+	for _, output := range transaction.Outputs {
+		outputsAmount += output.Value
+		_, address, err := txscript.ExtractScriptPubKeyAddress(output.ScriptPublicKey, s.params)
+		if err != nil {
+			return nil, err
+		}
+		walletAddress, isWalletAddress := s.addressSet[address.String()]
+		switch {
+		case isWalletAddress && walletAddress.keyChain == libkaspawallet.InternalKeychain:
+			historyTransaction.Change += output.Value
+		case isWalletAddress:
+			historyTransaction.Received += output.Value
+			if !historyTransaction.IsOutgoing {
+				historyTransaction.Addresses = append(historyTransaction.Addresses, address.String())
+			}
+		default:
+			historyTransaction.Sent += output.Value
+			if historyTransaction.IsOutgoing {
+				historyTransaction.Addresses = append(historyTransaction.Addresses, address.String())
+			}
+		}
+	}
+
+	if !historyTransaction.IsOutgoing {
+		if historyTransaction.Received == 0 && historyTransaction.Change == 0 {
+			return nil, nil
+		}
+		// Payments between other wallets in the same transaction aren't part of this wallet's history
+		historyTransaction.Sent = 0
+	}
+	if historyTransaction.IsOutgoing && areAllInputsKnown && inputsAmount >= outputsAmount {
+		historyTransaction.Fee = inputsAmount - outputsAmount
+	}
+	return historyTransaction, nil
+}
+
+// historyTransactionFromUTXOs returns the history record of a transaction that was accepted before
+// the wallet saw it pending, so all that's known about it are its outputs to the wallet
+func (s *server) historyTransactionFromUTXOs(txID string, entries []*appmessage.UTXOsByAddressesEntry) *historyTransaction {
+	historyTransaction := &historyTransaction{
+		TxID:                 txID,
+		IsCoinbase:           entries[0].UTXOEntry.IsCoinbase,
+		ConfirmationDAAScore: entries[0].UTXOEntry.BlockDAAScore,
+		Timestamp:            mstime.Now().UnixMilliseconds(),
+	}
+	for _, entry := range entries {
+		walletAddress := s.addressSet[entry.Address]
+		if walletAddress != nil && walletAddress.keyChain == libkaspawallet.InternalKeychain {
+			historyTransaction.Change += entry.UTXOEntry.Amount
+			continue
+		}
+		historyTransaction.Received += entry.UTXOEntry.Amount
+		historyTransaction.Addresses = append(historyTransaction.Addresses, entry.Address)
+	}
+	return historyTransaction
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/util"
+)
+
+func TestUpdateHistory(t *testing.T) {
+	params := &dagconfig.MainnetParams
+	history, err := loadTransactionHistory(filepath.Join(t.TempDir(), "keys-history.json"))
+	if err != nil {
+		t.Fatalf("loadTransactionHistory: %+v", err)
+	}
+	serverInstance := &server{
+		params:     params,
+		addressSet: make(walletAddressSet),
+		history:    history,
+	}
+
+	newTestAddress := func(seed byte) (util.Address, *externalapi.ScriptPublicKey) {
+		publicKey := make([]byte, 32)
+		publicKey[0] = seed
+		address, err := util.NewAddressPublicKey(publicKey, params.Prefix)
+		if err != nil {
+			t.Fatalf("NewAddressPublicKey: %+v", err)
+		}
+		scriptPublicKey, err := txscript.PayToAddrScript(address)
+		if err != nil {
+			t.Fatalf("PayToAddrScript: %+v", err)
+		}
+		return address, scriptPublicKey
+	}
+	receivingAddress, receivingScriptPublicKey := newTestAddress(1)
+	changeAddress, changeScriptPublicKey := newTestAddress(2)
+	foreignAddress, foreignScriptPublicKey := newTestAddress(3)
+	serverInstance.addressSet[receivingAddress.String()] = &walletAddress{keyChain: libkaspawallet.ExternalKeychain}
+	serverInstance.addressSet[changeAddress.String()] = &walletAddress{keyChain: libkaspawallet.InternalKeychain}
+
+	newTransaction := func(inputs []*externalapi.DomainOutpoint, outputs ...*externalapi.DomainTransactionOutput) *externalapi.DomainTransaction {
+		transaction := &externalapi.DomainTransaction{
+			Outputs:      outputs,
+			SubnetworkID: subnetworks.SubnetworkIDNative,
+		}
+		for _, outpoint := range inputs {
+			transaction.Inputs = append(transaction.Inputs, &externalapi.DomainTransactionInput{PreviousOutpoint: *outpoint})
+		}
+		return transaction
+	}
+	mempoolEntries := func(address util.Address, receiving []*externalapi.DomainTransaction,
+		sending []*externalapi.DomainTransaction) []*appmessage.MempoolEntryByAddress {
+
+		entry := &appmessage.MempoolEntryByAddress{Address: address.String()}
+		for _, transaction := range receiving {
+			entry.Receiving = append(entry.Receiving,
+				&appmessage.MempoolEntry{Transaction: appmessage.DomainTransactionToRPCTransaction(transaction)})
+		}
+		for _, transaction := range sending {
+			entry.Sending = append(entry.Sending,
+				&appmessage.MempoolEntry{Transaction: appmessage.DomainTransactionToRPCTransaction(transaction)})
+		}
+		return []*appmessage.MempoolEntryByAddress{entry}
+	}
+
+	// A pending payment to the wallet
+	foreignOutpoint := &externalapi.DomainOutpoint{
+		TransactionID: *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		Index:         0,
+	}
+	incomingTransaction := newTransaction([]*externalapi.DomainOutpoint{foreignOutpoint},
+		&externalapi.DomainTransactionOutput{Value: 100, ScriptPublicKey: receivingScriptPublicKey},
+		&externalapi.DomainTransactionOutput{Value: 500, ScriptPublicKey: foreignScriptPublicKey})
+	incomingTxID := consensushashing.TransactionID(incomingTransaction)
+	err = serverInstance.updateHistory(nil, nil, mempoolEntries(receivingAddress, []*externalapi.DomainTransaction{incomingTransaction}, nil))
+	if err != nil {
+		t.Fatalf("updateHistory: %+v", err)
+	}
+	recordedIncomingTransaction, ok := history.Transactions[incomingTxID.String()]
+	if !ok {
+		t.Fatalf("The incoming transaction wasn't recorded")
+	}
+	if recordedIncomingTransaction.IsOutgoing || recordedIncomingTransaction.Received != 100 ||
+		recordedIncomingTransaction.Sent != 0 || !recordedIncomingTransaction.isPending() ||
+		!reflect.DeepEqual(recordedIncomingTransaction.Addresses, []string{receivingAddress.String()}) {
+		t.Fatalf("Unexpected incoming transaction: %+v", recordedIncomingTransaction)
+	}
+
+	// The payment is accepted
+	incomingOutpoint := &externalapi.DomainOutpoint{TransactionID: *incomingTxID, Index: 0}
+	utxoEntries := []*appmessage.UTXOsByAddressesEntry{{
+		Address:  receivingAddress.String(),
+		Outpoint: &appmessage.RPCOutpoint{TransactionID: incomingTxID.String(), Index: 0},
+		UTXOEntry: &appmessage.RPCUTXOEntry{
+			Amount:        100,
+			BlockDAAScore: 10,
+		},
+	}}
+	err = serverInstance.updateHistory(nil, utxoEntries, nil)
+	if err != nil {
+		t.Fatalf("updateHistory: %+v", err)
+	}
+	if recordedIncomingTransaction.ConfirmationDAAScore != 10 {
+		t.Fatalf("Unexpected confirmation DAA score. Want: 10, got: %d", recordedIncomingTransaction.ConfirmationDAAScore)
+	}
+
+	// The wallet sends part of the payment, and pays the rest as change and fee
+	outgoingTransaction := newTransaction([]*externalapi.DomainOutpoint{incomingOutpoint},
+		&externalapi.DomainTransactionOutput{Value: 60, ScriptPublicKey: foreignScriptPublicKey},
+		&externalapi.DomainTransactionOutput{Value: 30, ScriptPublicKey: changeScriptPublicKey})
+	outgoingTxID := consensushashing.TransactionID(outgoingTransaction).String()
+	serverInstance.broadcastTransactions = []*externalapi.DomainTransaction{outgoingTransaction}
+	err = serverInstance.updateHistory(nil, utxoEntries, nil)
+	if err != nil {
+		t.Fatalf("updateHistory: %+v", err)
+	}
+	recordedOutgoingTransaction, ok := history.Transactions[outgoingTxID]
+	if !ok {
+		t.Fatalf("The outgoing transaction wasn't recorded")
+	}
+	if !recordedOutgoingTransaction.IsOutgoing || recordedOutgoingTransaction.Sent != 60 ||
+		recordedOutgoingTransaction.Change != 30 || recordedOutgoingTransaction.Fee != 10 ||
+		!reflect.DeepEqual(recordedOutgoingTransaction.Addresses, []string{foreignAddress.String()}) {
+		t.Fatalf("Unexpected outgoing transaction: %+v", recordedOutgoingTransaction)
+	}
+
+	err = serverInstance.setLabel("", outgoingTxID, "rent")
+	if err != nil {
+		t.Fatalf("setLabel: %+v", err)
+	}
+	err = serverInstance.setLabel(foreignAddress.String(), "", "landlord")
+	if err != nil {
+		t.Fatalf("setLabel: %+v", err)
+	}
+
+	// The outgoing transaction is replaced by one that pays a higher fee
+	replacementTransaction := newTransaction([]*externalapi.DomainOutpoint{incomingOutpoint},
+		&externalapi.DomainTransactionOutput{Value: 60, ScriptPublicKey: foreignScriptPublicKey},
+		&externalapi.DomainTransactionOutput{Value: 20, ScriptPublicKey: changeScriptPublicKey})
+	replacementTxID := consensushashing.TransactionID(replacementTransaction).String()
+	err = serverInstance.updateHistory(nil, utxoEntries,
+		mempoolEntries(receivingAddress, nil, []*externalapi.DomainTransaction{replacementTransaction}))
+	if err != nil {
+		t.Fatalf("updateHistory: %+v", err)
+	}
+	if _, ok := history.Transactions[outgoingTxID]; ok {
+		t.Fatalf("The replaced transaction is still in the history")
+	}
+	recordedReplacementTransaction, ok := history.Transactions[replacementTxID]
+	if !ok {
+		t.Fatalf("The replacement transaction wasn't recorded")
+	}
+	if recordedReplacementTransaction.Fee != 20 {
+		t.Fatalf("Unexpected fee of the replacement transaction. Want: 20, got: %d", recordedReplacementTransaction.Fee)
+	}
+	if history.TransactionLabels[replacementTxID] != "rent" {
+		t.Fatalf("The label of the replaced transaction wasn't moved to the replacement")
+	}
+
+	// The history is kept across restarts
+	loadedHistory, err := loadTransactionHistory(history.path)
+	if err != nil {
+		t.Fatalf("loadTransactionHistory: %+v", err)
+	}
+	if !reflect.DeepEqual(history, loadedHistory) {
+		t.Fatalf("The loaded history is different from the saved one.\nWant: %+v\nGot: %+v", history, loadedHistory)
+	}
+	if loadedHistory.AddressLabels[foreignAddress.String()] != "landlord" {
+		t.Fatalf("The address label wasn't saved")
+	}
+}
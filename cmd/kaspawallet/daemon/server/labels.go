@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/util"
+	"github.com/pkg/errors"
+)
+
+func (s *server) SetLabel(_ context.Context, request *pb.SetLabelRequest) (*pb.SetLabelResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.setLabel(request.Address, request.TxID, request.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetLabelResponse{}, nil
+}
+
+// setLabel labels either the given address or the given transaction. Any address may be labeled,
+// so that the counterparties of the wallet can be named, but only transactions in the history of
+// the wallet may be labeled.
+func (s *server) setLabel(address string, txID string, label string) error {
+	if (address == "") == (txID == "") {
+		return errors.New("Exactly one of an address or a transaction ID must be given")
+	}
+
+	labels := s.history.AddressLabels
+	key := address
+	if address != "" {
+		decodedAddress, err := util.DecodeAddress(address, s.params.Prefix)
+		if err != nil {
+			return errors.Wrapf(err, "invalid address %s", address)
+		}
+		key = decodedAddress.String()
+	} else {
+		if _, ok := s.history.Transactions[txID]; !ok {
+			return errors.Errorf("Transaction %s is not in the history of the wallet", txID)
+		}
+		labels = s.history.TransactionLabels
+		key = txID
+	}
+
+	if label == "" {
+		delete(labels, key)
+	} else {
+		labels[key] = label
+	}
+	return s.history.save()
+}
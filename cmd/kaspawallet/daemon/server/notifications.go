@@ -0,0 +1,139 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+)
+
+// pendingNotifications are the notifications received from kaspad that weren't applied to the
+// wallet yet. They're queued rather than applied by the notification handlers themselves, so that
+// the handlers never block the RPC client while the wallet is locked
+type pendingNotifications struct {
+	sync.Mutex
+	utxosChangedNotifications []*appmessage.UTXOsChangedNotificationMessage
+	isMempoolChanged          bool
+	isReconnected             bool
+
+	// received is signalled whenever a notification is queued
+	received chan struct{}
+}
+
+func newPendingNotifications() *pendingNotifications {
+	return &pendingNotifications{
+		received: make(chan struct{}, 1),
+	}
+}
+
+func (pn *pendingNotifications) signal() {
+	select {
+	case pn.received <- struct{}{}:
+	default:
+	}
+}
+
+// take returns the pending notifications and clears them
+func (pn *pendingNotifications) take() (utxosChangedNotifications []*appmessage.UTXOsChangedNotificationMessage,
+	isMempoolChanged bool, isReconnected bool) {
+
+	pn.Lock()
+	defer pn.Unlock()
+
+	utxosChangedNotifications, isMempoolChanged, isReconnected =
+		pn.utxosChangedNotifications, pn.isMempoolChanged, pn.isReconnected
+	pn.utxosChangedNotifications = nil
+	pn.isMempoolChanged = false
+	pn.isReconnected = false
+	return utxosChangedNotifications, isMempoolChanged, isReconnected
+}
+
+func (s *server) onUTXOsChanged(notification *appmessage.UTXOsChangedNotificationMessage) {
+	s.pendingNotifications.Lock()
+	s.pendingNotifications.utxosChangedNotifications = append(s.pendingNotifications.utxosChangedNotifications, notification)
+	s.pendingNotifications.Unlock()
+
+	s.pendingNotifications.signal()
+}
+
+func (s *server) onMempoolChanged(_ *appmessage.MempoolChangedNotificationMessage) {
+	s.pendingNotifications.Lock()
+	s.pendingNotifications.isMempoolChanged = true
+	s.pendingNotifications.Unlock()
+
+	s.pendingNotifications.signal()
+}
+
+func (s *server) onReconnected() {
+	log.Infof("Reconnected to kaspad, refreshing the wallet")
+
+	s.pendingNotifications.Lock()
+	s.pendingNotifications.isReconnected = true
+	s.pendingNotifications.Unlock()
+
+	s.pendingNotifications.signal()
+}
+
+// subscribe makes kaspad notify the wallet about changes in the UTXOs and in the mempool
+// transactions of the given addresses. The first subscription is for the whole address set
+func (s *server) subscribe(addresses []string) ([]string, error) {
+	if s.isSubscribed {
+		err := s.rpcClient.AddUTXOsChangedNotificationAddresses(addresses)
+		if err != nil {
+			return nil, err
+		}
+		err = s.rpcClient.AddMempoolChangedNotificationFilters(addresses, nil)
+		if err != nil {
+			return nil, err
+		}
+		return addresses, nil
+	}
+
+	addresses = s.addressSet.strings()
+	err := s.rpcClient.RegisterForUTXOsChangedNotifications(addresses, s.onUTXOsChanged)
+	if err != nil {
+		return nil, err
+	}
+	err = s.rpcClient.RegisterForMempoolChangedNotifications(addresses, nil, s.onMempoolChanged)
+	if err != nil {
+		return nil, err
+	}
+	s.isSubscribed = true
+	return addresses, nil
+}
+
+func (s *server) handlePendingNotificationsWithLock() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.handlePendingNotifications()
+}
+
+// handlePendingNotifications applies the notifications received since the last call
+func (s *server) handlePendingNotifications() error {
+	utxosChangedNotifications, isMempoolChanged, isReconnected := s.pendingNotifications.take()
+
+	// The subscriptions don't survive reconnection, and changes might have been missed
+	// while disconnected, so everything is fetched anew
+	if isReconnected {
+		return s.refreshUTXOs()
+	}
+
+	if len(utxosChangedNotifications) == 0 && !isMempoolChanged {
+		return nil
+	}
+
+	for _, notification := range utxosChangedNotifications {
+		for _, entry := range notification.Removed {
+			delete(s.utxoEntries, *entry.Outpoint)
+		}
+		for _, entry := range notification.Added {
+			s.utxoEntries[*entry.Outpoint] = entry
+		}
+		s.publishUTXOsReceived(notification.Added)
+	}
+
+	if isMempoolChanged {
+		return s.refreshMempoolEntries()
+	}
+	return s.updateUTXOSetAndHistory()
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/txscript"
+	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/util"
+)
+
+func TestHandlePendingNotifications(t *testing.T) {
+	params := &dagconfig.MainnetParams
+	history, err := loadTransactionHistory(filepath.Join(t.TempDir(), "keys-history.json"))
+	if err != nil {
+		t.Fatalf("loadTransactionHistory: %+v", err)
+	}
+	serverInstance := &server{
+		params:               params,
+		addressSet:           make(walletAddressSet),
+		history:              history,
+		utxoLocks:            &utxoLocks{Locks: make(map[string]*utxoLock)},
+		utxoEntries:          make(map[appmessage.RPCOutpoint]*appmessage.UTXOsByAddressesEntry),
+		pendingNotifications: newPendingNotifications(),
+		isSubscribed:         true,
+	}
+
+	address, err := util.NewAddressPublicKey(make([]byte, 32), params.Prefix)
+	if err != nil {
+		t.Fatalf("NewAddressPublicKey: %+v", err)
+	}
+	serverInstance.addressSet[address.String()] = &walletAddress{keyChain: libkaspawallet.ExternalKeychain}
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %+v", err)
+	}
+
+	newEntry := func(seed byte, amount uint64) *appmessage.UTXOsByAddressesEntry {
+		transactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{seed})
+		return &appmessage.UTXOsByAddressesEntry{
+			Address:  address.String(),
+			Outpoint: &appmessage.RPCOutpoint{TransactionID: transactionID.String(), Index: 0},
+			UTXOEntry: &appmessage.RPCUTXOEntry{
+				Amount: amount,
+				ScriptPublicKey: &appmessage.RPCScriptPublicKey{
+					Version: scriptPublicKey.Version,
+					Script:  hex.EncodeToString(scriptPublicKey.Script),
+				},
+				BlockDAAScore: 10,
+			},
+		}
+	}
+	firstEntry := newEntry(1, 100)
+	secondEntry := newEntry(2, 200)
+
+	serverInstance.onUTXOsChanged(&appmessage.UTXOsChangedNotificationMessage{
+		Added: []*appmessage.UTXOsByAddressesEntry{firstEntry},
+	})
+	serverInstance.onUTXOsChanged(&appmessage.UTXOsChangedNotificationMessage{
+		Added:   []*appmessage.UTXOsByAddressesEntry{secondEntry},
+		Removed: []*appmessage.UTXOsByAddressesEntry{firstEntry},
+	})
+
+	select {
+	case <-serverInstance.pendingNotifications.received:
+	default:
+		t.Fatalf("Queueing a notification didn't signal it")
+	}
+
+	err = serverInstance.handlePendingNotifications()
+	if err != nil {
+		t.Fatalf("handlePendingNotifications: %+v", err)
+	}
+	if len(serverInstance.utxosSortedByAmount) != 1 || serverInstance.utxosSortedByAmount[0].UTXOEntry.Amount() != 200 {
+		t.Fatalf("Unexpected UTXO set after the notifications: %+v", serverInstance.utxosSortedByAmount)
+	}
+	// The first UTXO was created and spent between two refreshes, so only the second one is in the history
+	if _, ok := history.Transactions[secondEntry.Outpoint.TransactionID]; !ok || len(history.Transactions) != 1 {
+		t.Fatalf("Unexpected transactions in the history: %+v", history.Transactions)
+	}
+
+	utxosChangedNotifications, _, _ := serverInstance.pendingNotifications.take()
+	if len(utxosChangedNotifications) != 0 {
+		t.Fatalf("The handled notifications are still pending")
+	}
+}
@@ -10,17 +10,22 @@ func (s *server) Send(_ context.Context, request *pb.SendRequest) (*pb.SendRespo
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	unsignedTransactions, err := s.createUnsignedTransactions(request.ToAddress, request.Amount, request.From, request.UseExistingChangeAddress)
+	unsignedTransactions, err := s.createUnsignedTransactions(request.ToAddress, request.Amount, request.Outputs,
+		request.From, request.IncludeOutpoints, request.ExcludeOutpoints, request.UseExistingChangeAddress, request.FeeRate)
 	if err != nil {
 		return nil, err
 	}
 
 	signedTransactions, err := s.signTransactions(unsignedTransactions, request.Password)
 	if err != nil {
+		unlockErr := s.unlockTransactionsInputs(unsignedTransactions)
+		if unlockErr != nil {
+			return nil, unlockErr
+		}
 		return nil, err
 	}
 
-	txIDs, err := s.broadcast(signedTransactions, false)
+	txIDs, err := s.broadcast(signedTransactions, false, false)
 	if err != nil {
 		return nil, err
 	}
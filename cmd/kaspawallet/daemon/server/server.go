@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 
 	"github.com/kaspanet/kaspad/util/txmass"
@@ -38,6 +39,22 @@ type server struct {
 	addressSet          walletAddressSet
 	txMassCalculator    *txmass.Calculator
 	usedOutpoints       map[externalapi.DomainOutpoint]time.Time
+	history             *transactionHistory
+	utxoLocks           *utxoLocks
+
+	// utxoEntries and mempoolEntries are the wallet's UTXOs and mempool transactions as kaspad
+	// had last reported them. They're kept up to date by the notifications of kaspad
+	utxoEntries          map[appmessage.RPCOutpoint]*appmessage.UTXOsByAddressesEntry
+	mempoolEntries       []*appmessage.MempoolEntryByAddress
+	isSubscribed         bool
+	pendingNotifications *pendingNotifications
+
+	walletEventsSubscribers map[*walletEventsSubscriber]struct{}
+	lastSyncState           *pb.SyncStateChangedEvent
+
+	// broadcastTransactions are the transactions broadcast since the last refresh of the UTXO set,
+	// which are recorded in the history by the next refresh
+	broadcastTransactions []*externalapi.DomainTransaction
 
 	isLogFinalProgressLineShown bool
 	maxUsedAddressesForLog      uint32
@@ -45,7 +62,9 @@ type server struct {
 }
 
 // Start starts the kaspawalletd server
-func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath string, profile string, timeout uint32) error {
+func Start(params *dagconfig.Params, listen, rpcServer string, rpcAuthorization string, keysFilePath string, profile string,
+	timeout uint32) error {
+
 	initLog(defaultLogFile, defaultErrLogFile)
 
 	defer panics.HandlePanic(log, "MAIN", nil)
@@ -62,7 +81,7 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 	log.Infof("Listening to TCP on %s", listen)
 
 	log.Infof("Connecting to a node at %s...", rpcServer)
-	rpcClient, err := connectToRPC(params, rpcServer, timeout)
+	rpcClient, err := connectToRPC(params, rpcServer, rpcAuthorization, timeout)
 	if err != nil {
 		return (errors.Wrapf(err, "Error connecting to RPC server %s", rpcServer))
 	}
@@ -73,6 +92,16 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 		return (errors.Wrapf(err, "Error reading keys file %s", keysFilePath))
 	}
 
+	history, err := loadTransactionHistory(historyFilePath(keysFile.Path()))
+	if err != nil {
+		return err
+	}
+
+	utxoLocks, err := loadUTXOLocks(utxoLocksFilePath(keysFile.Path()))
+	if err != nil {
+		return err
+	}
+
 	serverInstance := &server{
 		rpcClient:                   rpcClient,
 		params:                      params,
@@ -83,11 +112,18 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 		addressSet:                  make(walletAddressSet),
 		txMassCalculator:            txmass.NewCalculator(params.MassPerTxByte, params.MassPerScriptPubKeyByte, params.MassPerSigOp),
 		usedOutpoints:               map[externalapi.DomainOutpoint]time.Time{},
+		history:                     history,
+		utxoLocks:                   utxoLocks,
+		utxoEntries:                 make(map[appmessage.RPCOutpoint]*appmessage.UTXOsByAddressesEntry),
+		pendingNotifications:        newPendingNotifications(),
+		walletEventsSubscribers:     make(map[*walletEventsSubscriber]struct{}),
 		isLogFinalProgressLineShown: false,
 		maxUsedAddressesForLog:      0,
 		maxProcessedAddressesForLog: 0,
 	}
 
+	rpcClient.SetOnReconnectedHandler(serverInstance.onReconnected)
+
 	log.Infof("Read, syncing the wallet...")
 	spawn("serverInstance.sync", func() {
 		err := serverInstance.sync()
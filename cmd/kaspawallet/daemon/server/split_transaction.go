@@ -18,16 +18,17 @@ import (
 // transaction.
 // If it is - the transaction is split into multiple transactions, each with a portion of the inputs and a single output
 // into a change address.
-// An additional `mergeTransaction` is generated - which merges the outputs of the above splits into a single output
-// paying to the original transaction's payee.
-func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, toAddress util.Address,
-	changeAddress util.Address, changeWalletAddress *walletAddress) ([][]byte, error) {
+// An additional `mergeTransaction` is generated - which merges the outputs of the above splits into the outputs
+// paying to the original transaction's payees.
+// The split and merge transactions pay feeRate, in sompi per gram of mass, like the original transaction.
+func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, payments []*libkaspawallet.Payment,
+	changeAddress util.Address, changeWalletAddress *walletAddress, feeRate float64) ([][]byte, error) {
 	transaction, err := serialization.DeserializePartiallySignedTransaction(transactionBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	splitTransactions, err := s.maybeSplitAndMergeTransaction(transaction, toAddress, changeAddress, changeWalletAddress)
+	splitTransactions, err := s.maybeSplitAndMergeTransaction(transaction, payments, changeAddress, changeWalletAddress, feeRate)
 	if err != nil {
 		return nil, err
 	}
@@ -44,25 +45,26 @@ func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, toAddress
 func (s *server) mergeTransaction(
 	splitTransactions []*serialization.PartiallySignedTransaction,
 	originalTransaction *serialization.PartiallySignedTransaction,
-	toAddress util.Address,
+	payments []*libkaspawallet.Payment,
 	changeAddress util.Address,
 	changeWalletAddress *walletAddress,
+	feeRate float64,
 ) (*serialization.PartiallySignedTransaction, error) {
 	numOutputs := len(originalTransaction.Tx.Outputs)
-	if numOutputs > 2 || numOutputs == 0 {
-		// This is a sanity check to make sure originalTransaction has either 1 or 2 outputs:
-		// 1. For the payment itself
-		// 2. (optional) for change
-		return nil, errors.Errorf("original transaction has %d outputs, while 1 or 2 are expected",
-			len(originalTransaction.Tx.Outputs))
+	if numOutputs != len(payments) && numOutputs != len(payments)+1 {
+		// This is a sanity check to make sure originalTransaction has:
+		// 1. An output for each payment
+		// 2. (optional) an output for change
+		return nil, errors.Errorf("original transaction has %d outputs, while %d or %d are expected",
+			len(originalTransaction.Tx.Outputs), len(payments), len(payments)+1)
 	}
 
-	totalValue := uint64(0)
-	sentValue := originalTransaction.Tx.Outputs[0].Value
-	utxos := make([]*libkaspawallet.UTXO, len(splitTransactions))
+	splitValue := uint64(0)
+	sentValue := totalPaymentsAmount(payments)
+	splitUTXOs := make([]*libkaspawallet.UTXO, len(splitTransactions))
 	for i, splitTransaction := range splitTransactions {
 		output := splitTransaction.Tx.Outputs[0]
-		utxos[i] = &libkaspawallet.UTXO{
+		splitUTXOs[i] = &libkaspawallet.UTXO{
 			Outpoint: &externalapi.DomainOutpoint{
 				TransactionID: *consensushashing.TransactionID(splitTransaction.Tx),
 				Index:         0,
@@ -70,43 +72,55 @@ func (s *server) mergeTransaction(
 			UTXOEntry:      utxo.NewUTXOEntry(output.Value, output.ScriptPublicKey, false, constants.UnacceptedDAAScore),
 			DerivationPath: s.walletAddressPath(changeWalletAddress),
 		}
-		totalValue += output.Value
-		totalValue -= feePerInput
+		splitValue += output.Value
 	}
 
-	if totalValue < sentValue {
-		// sometimes the fees from compound transactions make the total output higher than what's available from selected
-		// utxos, in such cases - find one more UTXO and use it.
-		additionalUTXOs, totalValueAdded, err := s.moreUTXOsForMergeTransaction(utxos, sentValue-totalValue)
+	// The required fee depends on the mass of the merge transaction, which in turn depends
+	// on whether more UTXOs are needed, so we raise the fee until the transaction pays for itself
+	fee := uint64(0)
+	for {
+		utxos := splitUTXOs
+		totalValue := splitValue
+		if totalValue < sentValue+fee {
+			// sometimes the fees from compound transactions make the total output higher than what's available from selected
+			// utxos, in such cases - find one more UTXO and use it.
+			additionalUTXOs, totalValueAdded, err := s.moreUTXOsForMergeTransaction(utxos, sentValue+fee-totalValue)
+			if err != nil {
+				return nil, err
+			}
+			utxos = append(append([]*libkaspawallet.UTXO{}, utxos...), additionalUTXOs...)
+			totalValue += totalValueAdded
+		}
+
+		mergePayments := make([]*libkaspawallet.Payment, len(payments), len(payments)+1)
+		copy(mergePayments, payments)
+		if totalValue > sentValue+fee {
+			mergePayments = append(mergePayments, &libkaspawallet.Payment{
+				Address: changeAddress,
+				Amount:  totalValue - sentValue - fee,
+			})
+		}
+
+		mergeTransactionBytes, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+			s.keysFile.MinimumSignatures, mergePayments, utxos)
 		if err != nil {
 			return nil, err
 		}
-		utxos = append(utxos, additionalUTXOs...)
-		totalValue += totalValueAdded
-	}
 
-	payments := []*libkaspawallet.Payment{{
-		Address: toAddress,
-		Amount:  sentValue,
-	}}
-	if totalValue > sentValue {
-		payments = append(payments, &libkaspawallet.Payment{
-			Address: changeAddress,
-			Amount:  totalValue - sentValue,
-		})
-	}
-
-	mergeTransactionBytes, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
-		s.keysFile.MinimumSignatures, payments, utxos)
-	if err != nil {
-		return nil, err
+		requiredFee, err := s.requiredFee(mergeTransactionBytes, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		if fee >= requiredFee {
+			return serialization.DeserializePartiallySignedTransaction(mergeTransactionBytes)
+		}
+		fee = requiredFee
 	}
-
-	return serialization.DeserializePartiallySignedTransaction(mergeTransactionBytes)
 }
 
-func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.PartiallySignedTransaction, toAddress util.Address,
-	changeAddress util.Address, changeWalletAddress *walletAddress) ([]*serialization.PartiallySignedTransaction, error) {
+func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.PartiallySignedTransaction,
+	payments []*libkaspawallet.Payment, changeAddress util.Address, changeWalletAddress *walletAddress,
+	feeRate float64) ([]*serialization.PartiallySignedTransaction, error) {
 
 	transactionMass, err := s.estimateMassAfterSignatures(transaction)
 	if err != nil {
@@ -121,25 +135,31 @@ func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.Partia
 	if err != nil {
 		return nil, err
 	}
+	if splitCount == 1 {
+		// All the inputs fit in a single split transaction, so it's the outputs that make the
+		// transaction too large, and neither splitting nor merging it would help
+		return nil, errors.Errorf("The outputs of the transaction are too large for a standard transaction. " +
+			"Try paying fewer addresses at once")
+	}
 
 	splitTransactions := make([]*serialization.PartiallySignedTransaction, splitCount)
 	for i := 0; i < splitCount; i++ {
 		startIndex := i * inputCountPerSplit
 		endIndex := startIndex + inputCountPerSplit
 		var err error
-		splitTransactions[i], err = s.createSplitTransaction(transaction, changeAddress, startIndex, endIndex)
+		splitTransactions[i], err = s.createSplitTransaction(transaction, changeAddress, startIndex, endIndex, feeRate)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if len(splitTransactions) > 1 {
-		mergeTransaction, err := s.mergeTransaction(splitTransactions, transaction, toAddress, changeAddress, changeWalletAddress)
+		mergeTransaction, err := s.mergeTransaction(splitTransactions, transaction, payments, changeAddress, changeWalletAddress, feeRate)
 		if err != nil {
 			return nil, err
 		}
 		// Recursion will be 2-3 iterations deep even in the rarest` cases, so considered safe..
-		splitMergeTransaction, err := s.maybeSplitAndMergeTransaction(mergeTransaction, toAddress, changeAddress, changeWalletAddress)
+		splitMergeTransaction, err := s.maybeSplitAndMergeTransaction(mergeTransaction, payments, changeAddress, changeWalletAddress, feeRate)
 		if err != nil {
 			return nil, err
 		}
@@ -171,8 +191,9 @@ func (s *server) splitAndInputPerSplitCounts(transaction *serialization.Partiall
 	}
 
 	// Create another dummy transaction, this time one similar to the split transactions we wish to generate,
-	// but with 0 inputs, to calculate how much mass for inputs do we have available in the split transactions
-	splitTransactionWithoutInputs, err := s.createSplitTransaction(transaction, changeAddress, 0, 0)
+	// but with 0 inputs, to calculate how much mass for inputs do we have available in the split transactions.
+	// It pays no fee, since it has nothing to pay it with
+	splitTransactionWithoutInputs, err := s.createSplitTransaction(transaction, changeAddress, 0, 0, 0)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -190,7 +211,7 @@ func (s *server) splitAndInputPerSplitCounts(transaction *serialization.Partiall
 }
 
 func (s *server) createSplitTransaction(transaction *serialization.PartiallySignedTransaction,
-	changeAddress util.Address, startIndex int, endIndex int) (*serialization.PartiallySignedTransaction, error) {
+	changeAddress util.Address, startIndex int, endIndex int, feeRate float64) (*serialization.PartiallySignedTransaction, error) {
 
 	selectedUTXOs := make([]*libkaspawallet.UTXO, 0, endIndex-startIndex)
 	totalSompi := uint64(0)
@@ -206,19 +227,34 @@ func (s *server) createSplitTransaction(transaction *serialization.PartiallySign
 		})
 
 		totalSompi += selectedUTXOs[i-startIndex].UTXOEntry.Amount()
-		totalSompi -= feePerInput
-	}
-	unsignedTransactionBytes, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
-		s.keysFile.MinimumSignatures,
-		[]*libkaspawallet.Payment{{
-			Address: changeAddress,
-			Amount:  totalSompi,
-		}}, selectedUTXOs)
-	if err != nil {
-		return nil, err
 	}
 
-	return serialization.DeserializePartiallySignedTransaction(unsignedTransactionBytes)
+	// The required fee depends on the mass of the split transaction, so we raise
+	// the fee until the transaction pays for itself
+	fee := uint64(0)
+	for {
+		if fee > totalSompi {
+			return nil, errors.Errorf("The inputs of a split transaction are too small to pay its fee of %d sompi", fee)
+		}
+		unsignedTransactionBytes, err := libkaspawallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+			s.keysFile.MinimumSignatures,
+			[]*libkaspawallet.Payment{{
+				Address: changeAddress,
+				Amount:  totalSompi - fee,
+			}}, selectedUTXOs)
+		if err != nil {
+			return nil, err
+		}
+
+		requiredFee, err := s.requiredFee(unsignedTransactionBytes, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		if fee >= requiredFee {
+			return serialization.DeserializePartiallySignedTransaction(unsignedTransactionBytes)
+		}
+		fee = requiredFee
+	}
 }
 
 func (s *server) estimateMassAfterSignatures(transaction *serialization.PartiallySignedTransaction) (uint64, error) {
@@ -271,7 +307,7 @@ func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libkaspawa
 			Outpoint:       utxo.Outpoint,
 			UTXOEntry:      utxo.UTXOEntry,
 			DerivationPath: s.walletAddressPath(utxo.address)})
-		totalValueAdded += utxo.UTXOEntry.Amount() - feePerInput
+		totalValueAdded += utxo.UTXOEntry.Amount()
 		if totalValueAdded >= requiredAmount {
 			break
 		}
@@ -150,3 +150,73 @@ func testEstimateMassIncreaseForSignaturesSetUp(t *testing.T, consensusConfig *c
 
 	return unsignedTransaction, mnemonics, params, teardown
 }
+
+func TestCreateSplitTransactionFee(t *testing.T) {
+	params := &dagconfig.MainnetParams
+	mnemonic, err := libkaspawallet.CreateMnemonic()
+	if err != nil {
+		t.Fatalf("CreateMnemonic: %+v", err)
+	}
+	publicKey, err := libkaspawallet.MasterPublicKeyFromMnemonic(params, mnemonic, false)
+	if err != nil {
+		t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+	}
+	publicKeys := []string{publicKey}
+
+	const path = "m/0/0"
+	address, err := libkaspawallet.Address(params, publicKeys, 1, path, false)
+	if err != nil {
+		t.Fatalf("Address: %+v", err)
+	}
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %+v", err)
+	}
+
+	const inputCount = 10
+	const inputAmount = 100_000_000
+	utxos := make([]*libkaspawallet.UTXO, inputCount)
+	for i := range utxos {
+		utxos[i] = &libkaspawallet.UTXO{
+			Outpoint: &externalapi.DomainOutpoint{
+				TransactionID: *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{byte(i)}),
+				Index:         0,
+			},
+			UTXOEntry:      utxo.NewUTXOEntry(inputAmount, scriptPublicKey, false, 0),
+			DerivationPath: path,
+		}
+	}
+	transactionBytes, err := libkaspawallet.CreateUnsignedTransaction(publicKeys, 1,
+		[]*libkaspawallet.Payment{{Address: address, Amount: inputCount * inputAmount / 2}}, utxos)
+	if err != nil {
+		t.Fatalf("CreateUnsignedTransaction: %+v", err)
+	}
+	transaction, err := serialization.DeserializePartiallySignedTransaction(transactionBytes)
+	if err != nil {
+		t.Fatalf("DeserializePartiallySignedTransaction: %+v", err)
+	}
+
+	serverInstance := &server{
+		params:           params,
+		keysFile:         &keys.File{ExtendedPublicKeys: publicKeys, MinimumSignatures: 1},
+		txMassCalculator: txmass.NewCalculator(params.MassPerTxByte, params.MassPerScriptPubKeyByte, params.MassPerSigOp),
+	}
+
+	// Split transactions should pay the given fee rate rather than a fixed fee per input
+	for _, feeRate := range []float64{1, 10} {
+		splitTransaction, err := serverInstance.createSplitTransaction(transaction, address, 0, inputCount, feeRate)
+		if err != nil {
+			t.Fatalf("createSplitTransaction: %+v", err)
+		}
+		mass, err := serverInstance.estimateMassAfterSignatures(splitTransaction)
+		if err != nil {
+			t.Fatalf("estimateMassAfterSignatures: %+v", err)
+		}
+		expectedFee := uint64(float64(mass) * feeRate)
+		fee := inputCount*inputAmount - splitTransaction.Tx.Outputs[0].Value
+		if fee != expectedFee {
+			t.Errorf("Expected a split transaction with a mass of %d to pay %d sompi at a fee rate of %f, "+
+				"but it paid %d", mass, expectedFee, feeRate, fee)
+		}
+	}
+}
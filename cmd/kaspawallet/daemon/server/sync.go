@@ -23,6 +23,8 @@ func (was walletAddressSet) strings() []string {
 	return addresses
 }
 
+// sync keeps the wallet up to date. UTXO and mempool changes of known addresses are pushed by
+// kaspad, while addresses that weren't used before are discovered by polling their balances
 func (s *server) sync() error {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
@@ -31,30 +33,28 @@ func (s *server) sync() error {
 	if err != nil {
 		return err
 	}
+	s.publishSyncStateChangeWithLock()
+
+	for {
+		select {
+		case <-ticker.C:
+			err = s.collectFarAddresses()
+			if err != nil {
+				return err
+			}
 
-	err = s.refreshExistingUTXOsWithLock()
-	if err != nil {
-		return err
-	}
-
-	for range ticker.C {
-		err = s.collectFarAddresses()
-		if err != nil {
-			return err
-		}
-
-		err = s.collectRecentAddresses()
-		if err != nil {
-			return err
-		}
-
-		err = s.refreshExistingUTXOsWithLock()
-		if err != nil {
-			return err
+			err = s.collectRecentAddresses()
+			if err != nil {
+				return err
+			}
+			s.publishSyncStateChangeWithLock()
+		case <-s.pendingNotifications.received:
+			err = s.handlePendingNotificationsWithLock()
+			if err != nil {
+				return err
+			}
 		}
 	}
-
-	return nil
 }
 
 const (
@@ -176,6 +176,7 @@ func (s *server) updateAddressesAndLastUsedIndexes(requestedAddressSet walletAdd
 	lastUsedExternalIndex := s.keysFile.LastUsedExternalIndex()
 	lastUsedInternalIndex := s.keysFile.LastUsedInternalIndex()
 
+	var newAddresses []string
 	for _, entry := range getBalancesByAddressesResponse.Entries {
 		walletAddress, ok := requestedAddressSet[entry.Address]
 		if !ok {
@@ -186,6 +187,9 @@ func (s *server) updateAddressesAndLastUsedIndexes(requestedAddressSet walletAdd
 			continue
 		}
 
+		if _, ok := s.addressSet[entry.Address]; !ok {
+			newAddresses = append(newAddresses, entry.Address)
+		}
 		s.addressSet[entry.Address] = walletAddress
 
 		if walletAddress.keyChain == libkaspawallet.ExternalKeychain {
@@ -205,14 +209,12 @@ func (s *server) updateAddressesAndLastUsedIndexes(requestedAddressSet walletAdd
 		return err
 	}
 
-	return s.keysFile.SetLastUsedInternalIndex(lastUsedInternalIndex)
-}
-
-func (s *server) refreshExistingUTXOsWithLock() error {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	err = s.keysFile.SetLastUsedInternalIndex(lastUsedInternalIndex)
+	if err != nil {
+		return err
+	}
 
-	return s.refreshUTXOs()
+	return s.syncAddresses(newAddresses)
 }
 
 // updateUTXOSet clears the current UTXO set, and re-fills it with the given entries
@@ -261,7 +263,35 @@ func (s *server) updateUTXOSet(entries []*appmessage.UTXOsByAddressesEntry, memp
 	return nil
 }
 
+// refreshUTXOs fetches the UTXOs and the mempool entries of all the wallet addresses anew,
+// and subscribes to their changes
 func (s *server) refreshUTXOs() error {
+	s.isSubscribed = false
+	s.utxoEntries = make(map[appmessage.RPCOutpoint]*appmessage.UTXOsByAddressesEntry)
+	s.mempoolEntries = nil
+
+	return s.syncAddresses(nil)
+}
+
+// syncAddresses subscribes to the changes of the given addresses, which were just added to the
+// address set, and adds their UTXOs to the wallet
+func (s *server) syncAddresses(addresses []string) error {
+	if !s.isSubscribed && len(s.addressSet) == 0 {
+		// An empty address set would subscribe to the changes of all addresses
+		return nil
+	}
+	if s.isSubscribed && len(addresses) == 0 {
+		return nil
+	}
+
+	// The subscription comes first, so that no change is missed between fetching the UTXOs and
+	// subscribing. Changes that were already fetched are applied again later, which is harmless
+	// since they're applied in order
+	addresses, err := s.subscribe(addresses)
+	if err != nil {
+		return err
+	}
+
 	// It's important to check the mempool before calling `GetUTXOsByAddresses`:
 	// If we would do it the other way around an output can be spent in the mempool
 	// and not in consensus, and between the calls its spending transaction will be
@@ -272,12 +302,55 @@ func (s *server) refreshUTXOs() error {
 		return err
 	}
 
-	getUTXOsByAddressesResponse, err := s.rpcClient.GetUTXOsByAddresses(s.addressSet.strings())
+	getUTXOsByAddressesResponse, err := s.rpcClient.GetUTXOsByAddresses(addresses)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range getUTXOsByAddressesResponse.Entries {
+		s.utxoEntries[*entry.Outpoint] = entry
+	}
+	s.mempoolEntries = mempoolEntriesByAddresses.Entries
+
+	return s.updateUTXOSetAndHistory()
+}
+
+// refreshMempoolEntries fetches the mempool entries of all the wallet addresses anew
+func (s *server) refreshMempoolEntries() error {
+	mempoolEntriesByAddresses, err := s.rpcClient.GetMempoolEntriesByAddresses(s.addressSet.strings(), true, true)
+	if err != nil {
+		return err
+	}
+	s.mempoolEntries = mempoolEntriesByAddresses.Entries
+
+	return s.updateUTXOSetAndHistory()
+}
+
+// updateUTXOSetAndHistory updates the UTXO set and the transaction history
+// from the UTXO and mempool entries kaspad had last reported
+func (s *server) updateUTXOSetAndHistory() error {
+	entries := make([]*appmessage.UTXOsByAddressesEntry, 0, len(s.utxoEntries))
+	for _, entry := range s.utxoEntries {
+		entries = append(entries, entry)
+	}
+
+	previousUTXOs := s.utxosSortedByAmount
+	err := s.updateUTXOSet(entries, s.mempoolEntries)
+	if err != nil {
+		return err
+	}
+
+	err = s.updateHistory(previousUTXOs, entries, s.mempoolEntries)
+	if err != nil {
+		return err
+	}
+
+	err = s.updateUTXOLocks()
 	if err != nil {
 		return err
 	}
 
-	return s.updateUTXOSet(getUTXOsByAddressesResponse.Entries, mempoolEntriesByAddresses.Entries)
+	return s.publishBalanceChange(previousUTXOs)
 }
 
 func (s *server) isSynced() bool {
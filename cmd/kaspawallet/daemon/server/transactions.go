@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+)
+
+func (s *server) GetTransactions(_ context.Context, _ *pb.GetTransactionsRequest) (*pb.GetTransactionsResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	historyTransactions := s.history.sortedTransactions()
+	transactions := make([]*pb.WalletTransaction, len(historyTransactions))
+	for i, historyTransaction := range historyTransactions {
+		transactions[i] = &pb.WalletTransaction{
+			TxID:                 historyTransaction.TxID,
+			IsOutgoing:           historyTransaction.IsOutgoing,
+			IsCoinbase:           historyTransaction.IsCoinbase,
+			Received:             historyTransaction.Received,
+			Sent:                 historyTransaction.Sent,
+			Change:               historyTransaction.Change,
+			Fee:                  historyTransaction.Fee,
+			ConfirmationDAAScore: historyTransaction.ConfirmationDAAScore,
+			Timestamp:            historyTransaction.Timestamp,
+			Addresses:            historyTransaction.Addresses,
+			Label:                s.history.TransactionLabels[historyTransaction.TxID],
+		}
+	}
+
+	addressLabels := make(map[string]string, len(s.history.AddressLabels))
+	for address, label := range s.history.AddressLabels {
+		addressLabels[address] = label
+	}
+
+	return &pb.GetTransactionsResponse{
+		Transactions:  transactions,
+		AddressLabels: addressLabels,
+	}, nil
+}
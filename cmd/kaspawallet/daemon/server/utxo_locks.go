@@ -0,0 +1,232 @@
+package server
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+// unbroadcastUTXOLockDuration is how long the inputs of a transaction created by the wallet stay
+// locked if the transaction is never broadcast
+const unbroadcastUTXOLockDuration = 24 * time.Hour
+
+// utxoLocks are the outpoints of the wallet that new transactions don't spend unless told to
+// explicitly, either because transactions that spend them were already created, or because the
+// user locked them. They're kept in a JSON file next to the keys file, so that they survive restarts.
+type utxoLocks struct {
+	path string
+
+	// Locks are keyed by outpoints in the format <txID>:<index>
+	Locks map[string]*utxoLock `json:"locks"`
+}
+
+type utxoLock struct {
+	// TxID is the transaction that spends the outpoint. It's empty for outpoints the user locked
+	TxID string `json:"txID,omitempty"`
+	// IsBroadcast is set once the transaction is seen in the mempool. From then on, the lock is
+	// released when the transaction is either confirmed or rejected
+	IsBroadcast bool `json:"isBroadcast,omitempty"`
+	// ExpiresAt is when the lock of a transaction that was never broadcast is released,
+	// in milliseconds since the epoch. Locks made by the user never expire
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+func utxoLocksFilePath(keysFilePath string) string {
+	return strings.TrimSuffix(keysFilePath, filepath.Ext(keysFilePath)) + "-locks.json"
+}
+
+func loadUTXOLocks(path string) (*utxoLocks, error) {
+	locks := &utxoLocks{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.WithStack(err)
+	}
+	if err == nil {
+		err = json.Unmarshal(data, locks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing the UTXO locks file %s", path)
+		}
+	}
+
+	if locks.Locks == nil {
+		locks.Locks = make(map[string]*utxoLock)
+	}
+	return locks, nil
+}
+
+// save writes the locks to a temporary file first, so that a crash while saving doesn't
+// corrupt the existing locks
+func (l *utxoLocks) save() error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	temporaryPath := l.path + ".tmp"
+	err = os.WriteFile(temporaryPath, data, 0600)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(temporaryPath, l.path))
+}
+
+// parseOutpoint parses an outpoint in the format <txID>:<index>
+func parseOutpoint(outpointString string) (*externalapi.DomainOutpoint, error) {
+	separatorIndex := strings.LastIndex(outpointString, ":")
+	if separatorIndex == -1 {
+		return nil, errors.Errorf("Invalid outpoint %s: expected the format <txID>:<index>", outpointString)
+	}
+	transactionID, err := externalapi.NewDomainTransactionIDFromString(outpointString[:separatorIndex])
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid transaction ID in outpoint %s", outpointString)
+	}
+	index, err := strconv.ParseUint(outpointString[separatorIndex+1:], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid index in outpoint %s", outpointString)
+	}
+	return &externalapi.DomainOutpoint{TransactionID: *transactionID, Index: uint32(index)}, nil
+}
+
+// parseOutpoints parses the given outpoints into a set
+func parseOutpoints(outpointStrings []string) (map[externalapi.DomainOutpoint]struct{}, error) {
+	outpoints := make(map[externalapi.DomainOutpoint]struct{}, len(outpointStrings))
+	for _, outpointString := range outpointStrings {
+		outpoint, err := parseOutpoint(outpointString)
+		if err != nil {
+			return nil, err
+		}
+		outpoints[*outpoint] = struct{}{}
+	}
+	return outpoints, nil
+}
+
+// isUnspentWalletOutpoint returns whether the given outpoint is in the UTXO set of the wallet
+// in consensus. It may still be spent by a mempool transaction
+func (s *server) isUnspentWalletOutpoint(outpoint *externalapi.DomainOutpoint) bool {
+	_, ok := s.utxoEntries[appmessage.RPCOutpoint{TransactionID: outpoint.TransactionID.String(), Index: outpoint.Index}]
+	return ok
+}
+
+// lockTransactionsInputs locks the wallet inputs of the given unsigned transactions. Inputs that are
+// already spent by a broadcast transaction, such as the inputs of a fee bump, stay locked by it.
+func (s *server) lockTransactionsInputs(unsignedTransactions [][]byte) error {
+	expiresAt := mstime.Now().Add(unbroadcastUTXOLockDuration).UnixMilliseconds()
+	for _, unsignedTransaction := range unsignedTransactions {
+		partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(unsignedTransaction)
+		if err != nil {
+			return err
+		}
+		txID := consensushashing.TransactionID(partiallySignedTransaction.Tx).String()
+		for _, input := range partiallySignedTransaction.Tx.Inputs {
+			if !s.isUnspentWalletOutpoint(&input.PreviousOutpoint) {
+				continue
+			}
+			outpoint := outpointString(&input.PreviousOutpoint)
+			if lock, ok := s.utxoLocks.Locks[outpoint]; ok && lock.IsBroadcast {
+				continue
+			}
+			s.utxoLocks.Locks[outpoint] = &utxoLock{TxID: txID, ExpiresAt: expiresAt}
+		}
+	}
+	return s.utxoLocks.save()
+}
+
+// unlockTransactionsInputs releases the locks the given unsigned transactions hold on their inputs
+func (s *server) unlockTransactionsInputs(unsignedTransactions [][]byte) error {
+	for _, unsignedTransaction := range unsignedTransactions {
+		partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(unsignedTransaction)
+		if err != nil {
+			return err
+		}
+		s.unlockTransactionInputs(partiallySignedTransaction.Tx)
+	}
+	return s.utxoLocks.save()
+}
+
+func (s *server) unlockTransactionInputs(transaction *externalapi.DomainTransaction) {
+	txID := consensushashing.TransactionID(transaction).String()
+	for _, input := range transaction.Inputs {
+		outpoint := outpointString(&input.PreviousOutpoint)
+		if lock, ok := s.utxoLocks.Locks[outpoint]; ok && lock.TxID == txID {
+			delete(s.utxoLocks.Locks, outpoint)
+		}
+	}
+}
+
+// lockBroadcastTransactionInputs locks the wallet inputs of the given transaction, which was just broadcast,
+// until it's either confirmed or rejected
+func (s *server) lockBroadcastTransactionInputs(transaction *externalapi.DomainTransaction) {
+	txID := consensushashing.TransactionID(transaction).String()
+	for _, input := range transaction.Inputs {
+		if !s.isUnspentWalletOutpoint(&input.PreviousOutpoint) {
+			continue
+		}
+		s.utxoLocks.Locks[outpointString(&input.PreviousOutpoint)] = &utxoLock{TxID: txID, IsBroadcast: true}
+	}
+}
+
+// updateUTXOLocks releases the locks of outpoints that were spent, as well as the locks of transactions
+// that were rejected or that were never broadcast in time
+func (s *server) updateUTXOLocks() error {
+	// Before the wallet is synced, the UTXOs of some of its addresses aren't known yet
+	if len(s.utxoLocks.Locks) == 0 || !s.isSynced() {
+		return nil
+	}
+
+	unspentOutpoints := make(map[string]struct{}, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		unspentOutpoints[outpointString(utxo.Outpoint)] = struct{}{}
+	}
+
+	now := mstime.Now().UnixMilliseconds()
+	isChanged := false
+	for outpointKey, lock := range s.utxoLocks.Locks {
+		outpoint, err := parseOutpoint(outpointKey)
+		if err != nil {
+			return err
+		}
+		if !s.isUnspentWalletOutpoint(outpoint) {
+			delete(s.utxoLocks.Locks, outpointKey)
+			isChanged = true
+			continue
+		}
+		if lock.TxID == "" {
+			continue
+		}
+
+		_, isUnspentInMempool := unspentOutpoints[outpointKey]
+		switch {
+		case !isUnspentInMempool && !lock.IsBroadcast:
+			lock.IsBroadcast = true
+			lock.ExpiresAt = 0
+			isChanged = true
+		case isUnspentInMempool && lock.IsBroadcast:
+			log.Infof("Transaction %s is no longer in the mempool, unlocking its input %s", lock.TxID, outpointKey)
+			delete(s.utxoLocks.Locks, outpointKey)
+			isChanged = true
+		case isUnspentInMempool && lock.ExpiresAt != 0 && now >= lock.ExpiresAt:
+			log.Infof("Transaction %s was never broadcast, unlocking its input %s", lock.TxID, outpointKey)
+			delete(s.utxoLocks.Locks, outpointKey)
+			isChanged = true
+		}
+	}
+
+	if !isChanged {
+		return nil
+	}
+	return s.utxoLocks.save()
+}
+
+// utxoLockOf returns the lock of the given outpoint, or nil if it isn't locked
+func (s *server) utxoLockOf(outpoint *externalapi.DomainOutpoint) *utxoLock {
+	return s.utxoLocks.Locks[outpointString(outpoint)]
+}
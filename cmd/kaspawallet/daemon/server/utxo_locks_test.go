@@ -0,0 +1,105 @@
+package server
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/keys"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/util/mstime"
+)
+
+func TestParseOutpoint(t *testing.T) {
+	transactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1})
+	outpoint := &externalapi.DomainOutpoint{TransactionID: *transactionID, Index: 3}
+	parsedOutpoint, err := parseOutpoint(outpointString(outpoint))
+	if err != nil {
+		t.Fatalf("parseOutpoint: %+v", err)
+	}
+	if *parsedOutpoint != *outpoint {
+		t.Fatalf("Unexpected outpoint. Want: %s, got: %s", outpoint, parsedOutpoint)
+	}
+
+	for _, invalidOutpoint := range []string{"", transactionID.String(), transactionID.String() + ":", "abc:1",
+		transactionID.String() + ":-1", transactionID.String() + ":4294967296"} {
+		_, err := parseOutpoint(invalidOutpoint)
+		if err == nil {
+			t.Errorf("parseOutpoint(%s): expected an error", invalidOutpoint)
+		}
+	}
+}
+
+func TestUpdateUTXOLocks(t *testing.T) {
+	locks, err := loadUTXOLocks(filepath.Join(t.TempDir(), "keys-locks.json"))
+	if err != nil {
+		t.Fatalf("loadUTXOLocks: %+v", err)
+	}
+	serverInstance := &server{
+		keysFile:           &keys.File{},
+		nextSyncStartIndex: 1,
+		utxoEntries:        make(map[appmessage.RPCOutpoint]*appmessage.UTXOsByAddressesEntry),
+		utxoLocks:          locks,
+	}
+
+	newOutpoint := func(seed byte) *externalapi.DomainOutpoint {
+		transactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{seed})
+		return &externalapi.DomainOutpoint{TransactionID: *transactionID, Index: 0}
+	}
+	addUTXO := func(outpoint *externalapi.DomainOutpoint, isSpentInMempool bool) {
+		rpcOutpoint := appmessage.RPCOutpoint{TransactionID: outpoint.TransactionID.String(), Index: outpoint.Index}
+		serverInstance.utxoEntries[rpcOutpoint] = &appmessage.UTXOsByAddressesEntry{Outpoint: &rpcOutpoint}
+		if !isSpentInMempool {
+			serverInstance.utxosSortedByAmount = append(serverInstance.utxosSortedByAmount, &walletUTXO{Outpoint: outpoint})
+		}
+	}
+
+	userLockedOutpoint := newOutpoint(1)
+	addUTXO(userLockedOutpoint, false)
+	locks.Locks[outpointString(userLockedOutpoint)] = &utxoLock{}
+
+	spentOutpoint := newOutpoint(2)
+	locks.Locks[outpointString(spentOutpoint)] = &utxoLock{}
+
+	newlyBroadcastOutpoint := newOutpoint(3)
+	addUTXO(newlyBroadcastOutpoint, true)
+	expiresAt := mstime.Now().Add(time.Hour).UnixMilliseconds()
+	locks.Locks[outpointString(newlyBroadcastOutpoint)] = &utxoLock{TxID: "broadcast", ExpiresAt: expiresAt}
+
+	rejectedOutpoint := newOutpoint(4)
+	addUTXO(rejectedOutpoint, false)
+	locks.Locks[outpointString(rejectedOutpoint)] = &utxoLock{TxID: "rejected", IsBroadcast: true}
+
+	unbroadcastOutpoint := newOutpoint(5)
+	addUTXO(unbroadcastOutpoint, false)
+	locks.Locks[outpointString(unbroadcastOutpoint)] = &utxoLock{TxID: "unbroadcast", ExpiresAt: expiresAt}
+
+	expiredOutpoint := newOutpoint(6)
+	addUTXO(expiredOutpoint, false)
+	locks.Locks[outpointString(expiredOutpoint)] = &utxoLock{TxID: "expired", ExpiresAt: mstime.Now().UnixMilliseconds() - 1}
+
+	err = serverInstance.updateUTXOLocks()
+	if err != nil {
+		t.Fatalf("updateUTXOLocks: %+v", err)
+	}
+
+	expectedLocks := map[string]*utxoLock{
+		outpointString(userLockedOutpoint):     {},
+		outpointString(newlyBroadcastOutpoint): {TxID: "broadcast", IsBroadcast: true},
+		outpointString(unbroadcastOutpoint):    {TxID: "unbroadcast", ExpiresAt: expiresAt},
+	}
+	if !reflect.DeepEqual(locks.Locks, expectedLocks) {
+		t.Fatalf("Unexpected locks.\nWant: %+v\nGot: %+v", expectedLocks, locks.Locks)
+	}
+
+	// The locks are kept across restarts
+	loadedLocks, err := loadUTXOLocks(locks.path)
+	if err != nil {
+		t.Fatalf("loadUTXOLocks: %+v", err)
+	}
+	if !reflect.DeepEqual(locks, loadedLocks) {
+		t.Fatalf("The loaded locks are different from the saved ones.\nWant: %+v\nGot: %+v", locks, loadedLocks)
+	}
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+)
+
+func (s *server) ListUtxos(_ context.Context, request *pb.ListUtxosRequest) (*pb.ListUtxosResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	var addresses []*walletAddress
+	for _, addressString := range request.Addresses {
+		address, ok := s.addressSet[addressString]
+		if !ok {
+			return nil, errors.Errorf("Address %s is not an address of this wallet", addressString)
+		}
+		addresses = append(addresses, address)
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make([]*pb.WalletUtxo, 0, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		if addresses != nil && !slices.Contains(addresses, utxo.address) {
+			continue
+		}
+		address, err := libkaspawallet.Address(s.params, s.keysFile.ExtendedPublicKeys, s.keysFile.MinimumSignatures,
+			s.walletAddressPath(utxo.address), s.keysFile.ECDSA)
+		if err != nil {
+			return nil, err
+		}
+		walletUTXO := &pb.WalletUtxo{
+			Outpoint:      outpointString(utxo.Outpoint),
+			Address:       address.String(),
+			Amount:        utxo.UTXOEntry.Amount(),
+			IsCoinbase:    utxo.UTXOEntry.IsCoinbase(),
+			BlockDAAScore: utxo.UTXOEntry.BlockDAAScore(),
+			IsSpendable:   isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, s.params.BlockCoinbaseMaturity),
+		}
+		if lock := s.utxoLockOf(utxo.Outpoint); lock != nil {
+			walletUTXO.IsLocked = true
+			walletUTXO.LockingTxID = lock.TxID
+		}
+		utxos = append(utxos, walletUTXO)
+	}
+
+	return &pb.ListUtxosResponse{Utxos: utxos}, nil
+}
+
+func (s *server) LockUtxos(_ context.Context, request *pb.LockUtxosRequest) (*pb.LockUtxosResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, requestedOutpoint := range request.Outpoints {
+		outpoint, err := parseOutpoint(requestedOutpoint)
+		if err != nil {
+			return nil, err
+		}
+		if !s.isUnspentWalletOutpoint(outpoint) {
+			return nil, errors.Errorf("Outpoint %s is not an unspent output of this wallet", requestedOutpoint)
+		}
+		if lock := s.utxoLockOf(outpoint); lock != nil && lock.TxID != "" {
+			return nil, errors.Errorf("Outpoint %s is already spent by transaction %s", requestedOutpoint, lock.TxID)
+		}
+	}
+
+	for _, requestedOutpoint := range request.Outpoints {
+		outpoint, err := parseOutpoint(requestedOutpoint)
+		if err != nil {
+			return nil, err
+		}
+		s.utxoLocks.Locks[outpointString(outpoint)] = &utxoLock{}
+	}
+	err := s.utxoLocks.save()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LockUtxosResponse{}, nil
+}
+
+func (s *server) UnlockUtxos(_ context.Context, request *pb.UnlockUtxosRequest) (*pb.UnlockUtxosResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, requestedOutpoint := range request.Outpoints {
+		outpoint, err := parseOutpoint(requestedOutpoint)
+		if err != nil {
+			return nil, err
+		}
+		if s.utxoLockOf(outpoint) == nil {
+			return nil, errors.Errorf("Outpoint %s is not locked", requestedOutpoint)
+		}
+	}
+
+	for _, requestedOutpoint := range request.Outpoints {
+		outpoint, err := parseOutpoint(requestedOutpoint)
+		if err != nil {
+			return nil, err
+		}
+		delete(s.utxoLocks.Locks, outpointString(outpoint))
+	}
+	err := s.utxoLocks.save()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnlockUtxosResponse{}, nil
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/client"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/utils"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+	"github.com/pkg/errors"
+)
+
+func history(conf *historyConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.GetTransactions(ctx, &pb.GetTransactionsRequest{})
+	if err != nil {
+		return err
+	}
+
+	if conf.CSV {
+		return printHistoryCSV(response)
+	}
+
+	if len(response.Transactions) == 0 {
+		fmt.Println("No transactions")
+		return nil
+	}
+	header := fmt.Sprintf("%-19s %-64s %-8s %20s %19s  %s", "Time (UTC)", "Transaction ID", "Type", "Amount, KAS",
+		"Fee, KAS", "Status")
+	fmt.Println(header)
+	fmt.Println(strings.Repeat("-", len(header)+20))
+	for _, transaction := range response.Transactions {
+		amount, sign := transactionAmount(transaction)
+		fmt.Printf("%s %s %-8s %s%s %s  %s\n", formatTimestamp(transaction.Timestamp), transaction.TxID,
+			transactionType(transaction), sign, utils.FormatKas(amount), utils.FormatKas(transaction.Fee),
+			transactionStatus(transaction))
+
+		labels := transactionLabels(transaction, response.AddressLabels)
+		if len(labels) > 0 {
+			fmt.Printf("    Label: %s\n", strings.Join(labels, "; "))
+		}
+	}
+	return nil
+}
+
+func printHistoryCSV(response *pb.GetTransactionsResponse) error {
+	writer := csv.NewWriter(os.Stdout)
+	err := writer.Write([]string{"Time (UTC)", "Transaction ID", "Type", "Received (KAS)", "Sent (KAS)", "Change (KAS)",
+		"Fee (KAS)", "Confirmation DAA score", "Addresses", "Label"})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, transaction := range response.Transactions {
+		confirmationDAAScore := ""
+		if transaction.ConfirmationDAAScore != 0 {
+			confirmationDAAScore = strconv.FormatUint(transaction.ConfirmationDAAScore, 10)
+		}
+		err := writer.Write([]string{
+			formatTimestamp(transaction.Timestamp),
+			transaction.TxID,
+			transactionType(transaction),
+			formatKasForCSV(transaction.Received),
+			formatKasForCSV(transaction.Sent),
+			formatKasForCSV(transaction.Change),
+			formatKasForCSV(transaction.Fee),
+			confirmationDAAScore,
+			strings.Join(transaction.Addresses, " "),
+			strings.Join(transactionLabels(transaction, response.AddressLabels), "; "),
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	writer.Flush()
+	return errors.WithStack(writer.Error())
+}
+
+// transactionAmount returns the amount by which the transaction changed the balance of the wallet,
+// along with its sign
+func transactionAmount(transaction *pb.WalletTransaction) (amount uint64, sign string) {
+	if transaction.IsOutgoing {
+		return transaction.Sent + transaction.Fee, "-"
+	}
+	return transaction.Received + transaction.Change, "+"
+}
+
+func transactionType(transaction *pb.WalletTransaction) string {
+	switch {
+	case transaction.IsCoinbase:
+		return "coinbase"
+	case transaction.IsOutgoing:
+		return "outgoing"
+	default:
+		return "incoming"
+	}
+}
+
+func transactionStatus(transaction *pb.WalletTransaction) string {
+	if transaction.ConfirmationDAAScore == 0 {
+		return "pending"
+	}
+	return fmt.Sprintf("confirmed at DAA score %d", transaction.ConfirmationDAAScore)
+}
+
+// transactionLabels returns the label of the transaction followed by the labels of its addresses
+func transactionLabels(transaction *pb.WalletTransaction, addressLabels map[string]string) []string {
+	labels := make([]string, 0)
+	if transaction.Label != "" {
+		labels = append(labels, transaction.Label)
+	}
+	for _, address := range transaction.Addresses {
+		if label, ok := addressLabels[address]; ok {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+func formatTimestamp(timestamp int64) string {
+	return time.UnixMilli(timestamp).UTC().Format("2006-01-02 15:04:05")
+}
+
+func formatKasForCSV(amount uint64) string {
+	return strconv.FormatFloat(float64(amount)/constants.SompiPerKaspa, 'f', 8, 64)
+}
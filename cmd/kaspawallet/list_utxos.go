@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/client"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/utils"
+)
+
+func listUTXOs(conf *listUTXOsConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.ListUtxos(ctx, &pb.ListUtxosRequest{Addresses: conf.Addresses})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Utxos) == 0 {
+		fmt.Println("No UTXOs")
+		return nil
+	}
+	header := fmt.Sprintf("%-67s %-70s %19s  %s", "Outpoint", "Address", "Amount, KAS", "Status")
+	fmt.Println(header)
+	fmt.Println(strings.Repeat("-", len(header)+20))
+	for _, utxo := range response.Utxos {
+		fmt.Printf("%-67s %-70s %s  %s\n", utxo.Outpoint, utxo.Address, utils.FormatKas(utxo.Amount), utxoStatus(utxo))
+	}
+	return nil
+}
+
+func utxoStatus(utxo *pb.WalletUtxo) string {
+	var statuses []string
+	if !utxo.IsSpendable {
+		statuses = append(statuses, "Immature")
+	}
+	if utxo.IsLocked {
+		if utxo.LockingTxID != "" {
+			statuses = append(statuses, fmt.Sprintf("Spent by %s", utxo.LockingTxID))
+		} else {
+			statuses = append(statuses, "Locked")
+		}
+	}
+	if len(statuses) == 0 {
+		return "Spendable"
+	}
+	return strings.Join(statuses, ", ")
+}
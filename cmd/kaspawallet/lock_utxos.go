@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/client"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+)
+
+func lockUTXOs(conf *lockUTXOsConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.LockUtxos(ctx, &pb.LockUtxosRequest{Outpoints: conf.Outpoints})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Locked %d UTXO(s)\n", len(conf.Outpoints))
+	return nil
+}
+
+func unlockUTXOs(conf *unlockUTXOsConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.UnlockUtxos(ctx, &pb.UnlockUtxosRequest{Outpoints: conf.Outpoints})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Unlocked %d UTXO(s)\n", len(conf.Outpoints))
+	return nil
+}
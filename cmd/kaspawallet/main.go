@@ -13,6 +13,8 @@ func main() {
 		err = balance(config.(*balanceConfig))
 	case sendSubCmd:
 		err = send(config.(*sendConfig))
+	case bumpFeeSubCmd:
+		err = bumpFee(config.(*bumpFeeConfig))
 	case createUnsignedTransactionSubCmd:
 		err = createUnsignedTransaction(config.(*createUnsignedTransactionConfig))
 	case signSubCmd:
@@ -31,6 +33,16 @@ func main() {
 		err = startDaemon(config.(*startDaemonConfig))
 	case sweepSubCmd:
 		err = sweep(config.(*sweepConfig))
+	case historySubCmd:
+		err = history(config.(*historyConfig))
+	case setLabelSubCmd:
+		err = setLabel(config.(*setLabelConfig))
+	case listUTXOsSubCmd:
+		err = listUTXOs(config.(*listUTXOsConfig))
+	case lockUTXOsSubCmd:
+		err = lockUTXOs(config.(*lockUTXOsConfig))
+	case unlockUTXOsSubCmd:
+		err = unlockUTXOs(config.(*unlockUTXOsConfig))
 	default:
 		err = errors.Errorf("Unknown sub-command '%s'\n", subCmd)
 	}
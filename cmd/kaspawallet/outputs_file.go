@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
+	"github.com/pkg/errors"
+)
+
+// paymentOutputs returns either the address and the amount in sompi to send, or the outputs to
+// pay, according to --to-address, --send-amount and --outputs-file
+func paymentOutputs(toAddress string, sendAmount float64, outputsFile string) (
+	address string, amountSompi uint64, outputs []*pb.PaymentOutput, err error) {
+
+	if outputsFile == "" {
+		if toAddress == "" || sendAmount == 0 {
+			return "", 0, nil, errors.Errorf("Either --to-address and --send-amount, or --outputs-file, are required")
+		}
+		return toAddress, uint64(sendAmount * constants.SompiPerKaspa), nil, nil
+	}
+
+	if toAddress != "" || sendAmount != 0 {
+		return "", 0, nil, errors.Errorf("--to-address and --send-amount cannot be passed together with --outputs-file")
+	}
+	outputs, err = readOutputsFile(outputsFile)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	return "", 0, outputs, nil
+}
+
+// readOutputsFile reads the outputs of a batch payment from a JSON file if its extension is .json,
+// or from a CSV file otherwise. Amounts in both formats are in Kaspa
+func readOutputsFile(path string) ([]*pb.PaymentOutput, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	var outputs []*pb.PaymentOutput
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		outputs, err = readJSONOutputs(file)
+	} else {
+		outputs, err = readCSVOutputs(file)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not read the outputs from %s", path)
+	}
+	if len(outputs) == 0 {
+		return nil, errors.Errorf("No outputs in %s", path)
+	}
+	return outputs, nil
+}
+
+// readJSONOutputs reads an array of {"address": ..., "amount": ...} objects
+func readJSONOutputs(reader io.Reader) ([]*pb.PaymentOutput, error) {
+	var jsonOutputs []struct {
+		Address string      `json:"address"`
+		Amount  json.Number `json:"amount"`
+	}
+	err := json.NewDecoder(reader).Decode(&jsonOutputs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	outputs := make([]*pb.PaymentOutput, len(jsonOutputs))
+	for i, jsonOutput := range jsonOutputs {
+		amount, err := parseKasAmount(jsonOutput.Amount.String())
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid amount in output #%d", i)
+		}
+		outputs[i] = &pb.PaymentOutput{Address: jsonOutput.Address, Amount: amount}
+	}
+	return outputs, nil
+}
+
+// readCSVOutputs reads a line per output in the format <address>,<amount>, optionally preceded
+// by an address,amount header line
+func readCSVOutputs(reader io.Reader) ([]*pb.PaymentOutput, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 2
+	csvReader.TrimLeadingSpace = true
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	outputs := make([]*pb.PaymentOutput, 0, len(records))
+	for i, record := range records {
+		if i == 0 && isCSVOutputsHeader(record) {
+			continue
+		}
+		amount, err := parseKasAmount(record[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid amount in line %d", i+1)
+		}
+		outputs = append(outputs, &pb.PaymentOutput{Address: strings.TrimSpace(record[0]), Amount: amount})
+	}
+	return outputs, nil
+}
+
+func isCSVOutputsHeader(record []string) bool {
+	return strings.EqualFold(strings.TrimSpace(record[0]), "address") &&
+		strings.EqualFold(strings.TrimSpace(record[1]), "amount")
+}
+
+// parseKasAmount parses an amount in Kaspa (e.g. 1234.12345678) into sompi. Unlike parsing it as a
+// float, it's exact
+func parseKasAmount(amountString string) (uint64, error) {
+	amountString = strings.TrimSpace(amountString)
+	integerPart, fractionalPart := amountString, ""
+	if separatorIndex := strings.Index(amountString, "."); separatorIndex != -1 {
+		integerPart, fractionalPart = amountString[:separatorIndex], amountString[separatorIndex+1:]
+	}
+	const maxDecimalPlaces = 8
+	if integerPart == "" || strings.HasPrefix(integerPart, "+") || strings.HasPrefix(fractionalPart, "+") {
+		return 0, errors.Errorf("Invalid amount %s", amountString)
+	}
+	if len(fractionalPart) > maxDecimalPlaces {
+		return 0, errors.Errorf("Invalid amount %s: expected up to %d decimal places", amountString, maxDecimalPlaces)
+	}
+
+	kas, err := strconv.ParseUint(integerPart, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Invalid amount %s", amountString)
+	}
+	sompi := uint64(0)
+	if fractionalPart != "" {
+		sompi, err = strconv.ParseUint(fractionalPart+strings.Repeat("0", maxDecimalPlaces-len(fractionalPart)), 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "Invalid amount %s", amountString)
+		}
+	}
+	if kas > (^uint64(0)-sompi)/constants.SompiPerKaspa {
+		return 0, errors.Errorf("Amount %s is too large", amountString)
+	}
+	return kas*constants.SompiPerKaspa + sompi, nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+)
+
+func TestParseKasAmount(t *testing.T) {
+	tests := []struct {
+		amount        string
+		expectedSompi uint64
+		isValid       bool
+	}{
+		{amount: "1", expectedSompi: 100_000_000, isValid: true},
+		{amount: " 1.5 ", expectedSompi: 150_000_000, isValid: true},
+		{amount: "0.00000001", expectedSompi: 1, isValid: true},
+		{amount: "1234.12345678", expectedSompi: 123_412_345_678, isValid: true},
+		{amount: "184467440737.09551615", expectedSompi: ^uint64(0), isValid: true},
+		{amount: "1.123456789", isValid: false},
+		{amount: "184467440737.09551616", isValid: false},
+		{amount: "", isValid: false},
+		{amount: ".5", isValid: false},
+		{amount: "-1", isValid: false},
+		{amount: "+1", isValid: false},
+		{amount: "1.+5", isValid: false},
+		{amount: "1e3", isValid: false},
+		{amount: "abc", isValid: false},
+	}
+	for _, test := range tests {
+		sompi, err := parseKasAmount(test.amount)
+		if !test.isValid {
+			if err == nil {
+				t.Errorf("parseKasAmount(%q): expected an error, but got %d", test.amount, sompi)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseKasAmount(%q): %+v", test.amount, err)
+			continue
+		}
+		if sompi != test.expectedSompi {
+			t.Errorf("parseKasAmount(%q): expected %d but got %d", test.amount, test.expectedSompi, sompi)
+		}
+	}
+}
+
+func TestReadOutputsFile(t *testing.T) {
+	expectedOutputs := []*pb.PaymentOutput{
+		{Address: "kaspa:address1", Amount: 150_000_000},
+		{Address: "kaspa:address2", Amount: 10_000_000},
+	}
+
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+		isValid  bool
+	}{
+		{
+			name:     "CSV",
+			fileName: "outputs.csv",
+			content:  "kaspa:address1,1.5\nkaspa:address2, 0.1\n",
+			isValid:  true,
+		},
+		{
+			name:     "CSV with a header",
+			fileName: "outputs.csv",
+			content:  "Address,Amount\nkaspa:address1,1.5\nkaspa:address2,0.1\n",
+			isValid:  true,
+		},
+		{
+			name:     "JSON",
+			fileName: "outputs.JSON",
+			content:  `[{"address": "kaspa:address1", "amount": 1.5}, {"address": "kaspa:address2", "amount": "0.1"}]`,
+			isValid:  true,
+		},
+		{
+			// A typo in the first line must not be mistaken for a header
+			name:     "CSV with a bad amount in the first line",
+			fileName: "outputs.csv",
+			content:  "kaspa:address1,1.5x\nkaspa:address2,0.1\n",
+			isValid:  false,
+		},
+		{
+			name:     "CSV with a bad amount",
+			fileName: "outputs.csv",
+			content:  "address,amount\nkaspa:address1,1.5\nkaspa:address2,abc\n",
+			isValid:  false,
+		},
+		{
+			name:     "CSV with too many decimal places",
+			fileName: "outputs.csv",
+			content:  "kaspa:address1,1.5\nkaspa:address2,0.123456789\n",
+			isValid:  false,
+		},
+		{
+			name:     "CSV with a missing column",
+			fileName: "outputs.csv",
+			content:  "kaspa:address1,1.5\nkaspa:address2\n",
+			isValid:  false,
+		},
+		{
+			name:     "CSV with only a header",
+			fileName: "outputs.csv",
+			content:  "address,amount\n",
+			isValid:  false,
+		},
+		{
+			name:     "JSON with too many decimal places",
+			fileName: "outputs.json",
+			content:  `[{"address": "kaspa:address1", "amount": 1.123456789}]`,
+			isValid:  false,
+		},
+		{
+			name:     "JSON with a bad amount",
+			fileName: "outputs.json",
+			content:  `[{"address": "kaspa:address1", "amount": -1}]`,
+			isValid:  false,
+		},
+	}
+	for _, test := range tests {
+		path := filepath.Join(t.TempDir(), test.fileName)
+		err := os.WriteFile(path, []byte(test.content), 0600)
+		if err != nil {
+			t.Fatalf("WriteFile: %+v", err)
+		}
+
+		outputs, err := readOutputsFile(path)
+		if !test.isValid {
+			if err == nil {
+				t.Errorf("%s: expected an error, but got %v", test.name, outputs)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: readOutputsFile: %+v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(outputs, expectedOutputs) {
+			t.Errorf("%s: expected %v but got %v", test.name, expectedOutputs, outputs)
+		}
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/keys"
 	"github.com/kaspanet/kaspad/cmd/kaspawallet/libkaspawallet"
-	"github.com/kaspanet/kaspad/domain/consensus/utils/constants"
 	"github.com/pkg/errors"
 )
 
@@ -22,6 +21,11 @@ func send(conf *sendConfig) error {
 		return errors.Errorf("Cannot use 'send' command for multisig wallet without all of the keys")
 	}
 
+	toAddress, sendAmountSompi, outputs, err := paymentOutputs(conf.ToAddress, conf.SendAmount, conf.OutputsFile)
+	if err != nil {
+		return err
+	}
+
 	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
 	if err != nil {
 		return err
@@ -31,14 +35,16 @@ func send(conf *sendConfig) error {
 	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
 	defer cancel()
 
-	sendAmountSompi := uint64(conf.SendAmount * constants.SompiPerKaspa)
-
 	createUnsignedTransactionsResponse, err :=
 		daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 			From:                     conf.FromAddresses,
-			Address:                  conf.ToAddress,
+			IncludeOutpoints:         conf.IncludeOutpoints,
+			ExcludeOutpoints:         conf.ExcludeOutpoints,
+			Address:                  toAddress,
 			Amount:                   sendAmountSompi,
+			Outputs:                  outputs,
 			UseExistingChangeAddress: conf.UseExistingChangeAddress,
+			FeeRate:                  conf.FeeRate,
 		})
 	if err != nil {
 		return err
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/client"
+	"github.com/kaspanet/kaspad/cmd/kaspawallet/daemon/pb"
+)
+
+func setLabel(conf *setLabelConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.SetLabel(ctx, &pb.SetLabelRequest{
+		Address: conf.Address,
+		TxID:    conf.TxID,
+		Label:   conf.Label,
+	})
+	if err != nil {
+		return err
+	}
+
+	if conf.Label == "" {
+		fmt.Println("Label removed")
+	} else {
+		fmt.Println("Label set")
+	}
+	return nil
+}
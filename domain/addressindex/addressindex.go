@@ -0,0 +1,209 @@
+package addressindex
+
+import (
+	"sync"
+
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+// AddressIndex maintains the history of every script public key: all the
+// transactions accepted by the virtual's selected parent chain that created
+// or spent its outputs
+type AddressIndex struct {
+	domain domain.Domain
+	store  *addressIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new address index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*AddressIndex, error) {
+	addressIndex := &AddressIndex{
+		domain: domain,
+		store:  newAddressIndexStore(database),
+	}
+	isSynced, err := addressIndex.isSynced()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSynced {
+		err := addressIndex.Reset()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addressIndex, nil
+}
+
+// Reset deletes the whole address index and resyncs it from consensus.
+func (ai *AddressIndex) Reset() error {
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	err := ai.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualInfo, err := ai.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := ai.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	// Acceptance data is only guaranteed to exist for chain blocks above the
+	// pruning point, so that's where the index starts from.
+	chainPath, err := ai.domain.Consensus().GetVirtualSelectedParentChainFromBlock(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	const step = 1000
+	for position := 0; position < len(chainPath.Added); position += step {
+		end := position + step
+		if end > len(chainPath.Added) {
+			end = len(chainPath.Added)
+		}
+
+		err = ai.forEachAddressTransaction(chainPath.Added[position:end], ai.store.add)
+		if err != nil {
+			return err
+		}
+
+		err = ai.store.commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	return ai.store.updateAndCommitVirtualParentsWithoutTransaction(virtualInfo.ParentHashes)
+}
+
+func (ai *AddressIndex) isSynced() (bool, error) {
+	addressIndexVirtualParents, err := ai.store.getVirtualParents()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	virtualInfo, err := ai.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return externalapi.HashesEqual(virtualInfo.ParentHashes, addressIndexVirtualParents), nil
+}
+
+// Update updates the address index with the given DAG selected parent chain changes.
+// The history added by chain blocks that were removed from the selected parent chain
+// is rolled back before the history of the newly added chain blocks is added.
+func (ai *AddressIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AddressIndex.Update")
+	defer onEnd()
+
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	chainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	if chainChanges != nil {
+		log.Tracef("Updating address index with %d removed and %d added chain blocks",
+			len(chainChanges.Removed), len(chainChanges.Added))
+
+		err := ai.forEachAddressTransaction(chainChanges.Removed, ai.store.remove)
+		if err != nil {
+			return err
+		}
+
+		err = ai.forEachAddressTransaction(chainChanges.Added, ai.store.add)
+		if err != nil {
+			return err
+		}
+	}
+
+	ai.store.updateVirtualParents(virtualChangeSet.VirtualParents)
+
+	return ai.store.commit()
+}
+
+// forEachAddressTransaction calls `apply` for every script public key whose outputs were
+// created or spent by transactions accepted by the given chain blocks
+func (ai *AddressIndex) forEachAddressTransaction(chainBlockHashes []*externalapi.DomainHash,
+	apply func(scriptPublicKey *externalapi.ScriptPublicKey, addressTransaction *AddressTransaction)) error {
+
+	if len(chainBlockHashes) == 0 {
+		return nil
+	}
+
+	chainBlocksAcceptanceData, err := ai.domain.Consensus().GetBlocksAcceptanceData(chainBlockHashes)
+	if err != nil {
+		return err
+	}
+
+	for i, acceptingBlockHash := range chainBlockHashes {
+		acceptingBlockHeader, err := ai.domain.Consensus().GetBlockHeader(acceptingBlockHash)
+		if err != nil {
+			return err
+		}
+		acceptingBlockDAAScore := acceptingBlockHeader.DAAScore()
+
+		for _, blockAcceptanceData := range chainBlocksAcceptanceData[i] {
+			for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+				if !transactionAcceptanceData.IsAccepted {
+					continue
+				}
+				transaction := transactionAcceptanceData.Transaction
+				transactionID := consensushashing.TransactionID(transaction)
+
+				for _, output := range transaction.Outputs {
+					apply(output.ScriptPublicKey, &AddressTransaction{
+						TransactionID:          transactionID,
+						AcceptingBlockHash:     acceptingBlockHash,
+						AcceptingBlockDAAScore: acceptingBlockDAAScore,
+						CreatedOutputs:         true,
+					})
+				}
+				for _, utxoEntry := range transactionAcceptanceData.TransactionInputUTXOEntries {
+					apply(utxoEntry.ScriptPublicKey(), &AddressTransaction{
+						TransactionID:          transactionID,
+						AcceptingBlockHash:     acceptingBlockHash,
+						AcceptingBlockDAAScore: acceptingBlockDAAScore,
+						SpentOutputs:           true,
+					})
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// AddressTransactions returns up to `limit` transactions that created or spent outputs of
+// the given script public key and were accepted at a DAA score of at least `startDAAScore`,
+// ordered by their accepting DAA score. All the transactions accepted at the DAA score of the
+// last returned transaction are always returned together, so the result might exceed `limit`
+// and the next page starts at that DAA score + 1. A limit of 0 means no limit.
+func (ai *AddressIndex) AddressTransactions(scriptPublicKey *externalapi.ScriptPublicKey,
+	startDAAScore uint64, limit int) ([]*AddressTransaction, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AddressIndex.AddressTransactions")
+	defer onEnd()
+
+	ai.mutex.Lock()
+	defer ai.mutex.Unlock()
+
+	return ai.store.getAddressTransactions(scriptPublicKey, startDAAScore, limit)
+}
@@ -0,0 +1,7 @@
+package addressindex
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("ADIX")
@@ -0,0 +1,39 @@
+package addressindex
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// ScriptPublicKeyString is a ScriptPublicKey represented as a string
+type ScriptPublicKeyString string
+
+// AddressTransaction is the data the address index holds for every
+// transaction accepted by the virtual's selected parent chain that
+// created or spent an output of some address
+type AddressTransaction struct {
+	TransactionID          *externalapi.DomainTransactionID
+	AcceptingBlockHash     *externalapi.DomainHash
+	AcceptingBlockDAAScore uint64
+
+	// CreatedOutputs is set if the transaction created outputs to the address
+	CreatedOutputs bool
+	// SpentOutputs is set if the transaction spent outputs of the address
+	SpentOutputs bool
+}
+
+// addressTransactionKey uniquely identifies an AddressTransaction within
+// the history of a single address
+type addressTransactionKey struct {
+	acceptingBlockDAAScore uint64
+	transactionID          externalapi.DomainTransactionID
+}
+
+func (at *AddressTransaction) key() addressTransactionKey {
+	return addressTransactionKey{
+		acceptingBlockDAAScore: at.AcceptingBlockDAAScore,
+		transactionID:          *at.TransactionID,
+	}
+}
+
+// addressTransactions is a collection of AddressTransactions of a single address
+type addressTransactions map[addressTransactionKey]*AddressTransaction
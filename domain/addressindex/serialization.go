@@ -0,0 +1,86 @@
+package addressindex
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/kaspanet/kaspad/domain/consensus/database/binaryserialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+const (
+	addressTransactionKeySize   = 8 + externalapi.DomainHashSize // DAA score + transaction ID
+	addressTransactionValueSize = externalapi.DomainHashSize + 1 // accepting block hash + flags
+
+	createdOutputsFlag = 1 << 0
+	spentOutputsFlag   = 1 << 1
+)
+
+// serializeAddressTransactionKey serializes the given key so that keys are sorted
+// by their DAA score in the database. This is what allows paginating an address'
+// history by DAA score
+func serializeAddressTransactionKey(key addressTransactionKey) []byte {
+	serializedKey := make([]byte, addressTransactionKeySize)
+	binary.BigEndian.PutUint64(serializedKey[:8], key.acceptingBlockDAAScore)
+	copy(serializedKey[8:], key.transactionID.ByteSlice())
+	return serializedKey
+}
+
+func deserializeAddressTransactionKey(serializedKey []byte) (addressTransactionKey, error) {
+	if len(serializedKey) != addressTransactionKeySize {
+		return addressTransactionKey{}, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while "+
+			"deserializing address transaction key", len(serializedKey))
+	}
+
+	transactionID, err := externalapi.NewDomainTransactionIDFromByteSlice(serializedKey[8:])
+	if err != nil {
+		return addressTransactionKey{}, err
+	}
+
+	return addressTransactionKey{
+		acceptingBlockDAAScore: binary.BigEndian.Uint64(serializedKey[:8]),
+		transactionID:          *transactionID,
+	}, nil
+}
+
+func serializeAddressTransactionValue(addressTransaction *AddressTransaction) []byte {
+	var flags byte
+	if addressTransaction.CreatedOutputs {
+		flags |= createdOutputsFlag
+	}
+	if addressTransaction.SpentOutputs {
+		flags |= spentOutputsFlag
+	}
+
+	serializedValue := make([]byte, 0, addressTransactionValueSize)
+	serializedValue = append(serializedValue, binaryserialization.SerializeHash(addressTransaction.AcceptingBlockHash)...)
+	serializedValue = append(serializedValue, flags)
+	return serializedValue
+}
+
+func deserializeAddressTransaction(serializedKey []byte, serializedValue []byte) (*AddressTransaction, error) {
+	key, err := deserializeAddressTransactionKey(serializedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(serializedValue) != addressTransactionValueSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while "+
+			"deserializing address transaction", len(serializedValue))
+	}
+
+	acceptingBlockHash, err := binaryserialization.DeserializeHash(serializedValue[:externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+	flags := serializedValue[externalapi.DomainHashSize]
+
+	return &AddressTransaction{
+		TransactionID:          &key.transactionID,
+		AcceptingBlockHash:     acceptingBlockHash,
+		AcceptingBlockDAAScore: key.acceptingBlockDAAScore,
+		CreatedOutputs:         flags&createdOutputsFlag != 0,
+		SpentOutputs:           flags&spentOutputsFlag != 0,
+	}, nil
+}
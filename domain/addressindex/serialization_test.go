@@ -0,0 +1,78 @@
+package addressindex
+
+import (
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func Test_serializeAddressTransaction(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 32; i++ {
+		var transactionIDBytes, acceptingBlockHashBytes [externalapi.DomainHashSize]byte
+		r.Read(transactionIDBytes[:])
+		r.Read(acceptingBlockHashBytes[:])
+		addressTransaction := &AddressTransaction{
+			TransactionID:          externalapi.NewDomainTransactionIDFromByteArray(&transactionIDBytes),
+			AcceptingBlockHash:     externalapi.NewDomainHashFromByteArray(&acceptingBlockHashBytes),
+			AcceptingBlockDAAScore: r.Uint64(),
+			CreatedOutputs:         r.Intn(2) == 0,
+			SpentOutputs:           r.Intn(2) == 0,
+		}
+
+		result, err := deserializeAddressTransaction(serializeAddressTransactionKey(addressTransaction.key()),
+			serializeAddressTransactionValue(addressTransaction))
+		if err != nil {
+			t.Fatalf("Failed deserializing address transaction: %v", err)
+		}
+		if !result.TransactionID.Equal(addressTransaction.TransactionID) {
+			t.Fatalf("Expected transaction ID %s but got %s",
+				addressTransaction.TransactionID, result.TransactionID)
+		}
+		if !result.AcceptingBlockHash.Equal(addressTransaction.AcceptingBlockHash) {
+			t.Fatalf("Expected accepting block hash %s but got %s",
+				addressTransaction.AcceptingBlockHash, result.AcceptingBlockHash)
+		}
+		if result.AcceptingBlockDAAScore != addressTransaction.AcceptingBlockDAAScore {
+			t.Fatalf("Expected accepting block DAA score %d but got %d",
+				addressTransaction.AcceptingBlockDAAScore, result.AcceptingBlockDAAScore)
+		}
+		if result.CreatedOutputs != addressTransaction.CreatedOutputs ||
+			result.SpentOutputs != addressTransaction.SpentOutputs {
+			t.Fatalf("Expected flags (created: %t, spent: %t) but got (created: %t, spent: %t)",
+				addressTransaction.CreatedOutputs, addressTransaction.SpentOutputs,
+				result.CreatedOutputs, result.SpentOutputs)
+		}
+	}
+}
+
+func Test_serializeAddressTransactionKeyOrdering(t *testing.T) {
+	transactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{0xff})
+	low := serializeAddressTransactionKey(addressTransactionKey{acceptingBlockDAAScore: 0xff, transactionID: *transactionID})
+	high := serializeAddressTransactionKey(addressTransactionKey{acceptingBlockDAAScore: 0x100})
+	if string(low) >= string(high) {
+		t.Fatalf("Expected keys to be ordered by DAA score")
+	}
+}
+
+func Test_deserializeAddressTransactionFailure(t *testing.T) {
+	addressTransaction := &AddressTransaction{
+		TransactionID:      externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+	}
+	serializedKey := serializeAddressTransactionKey(addressTransaction.key())
+	serializedValue := serializeAddressTransactionValue(addressTransaction)
+
+	_, err := deserializeAddressTransaction(serializedKey[:len(serializedKey)-1], serializedValue)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+	_, err = deserializeAddressTransaction(serializedKey, serializedValue[:len(serializedValue)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
@@ -0,0 +1,258 @@
+package addressindex
+
+import (
+	"encoding/binary"
+
+	"github.com/kaspanet/kaspad/domain/consensus/database/binaryserialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+var addressIndexBucket = database.MakeBucket([]byte("address-index"))
+var virtualParentsKey = database.MakeBucket([]byte("")).Key([]byte("address-index-virtual-parents"))
+
+type addressIndexStore struct {
+	database database.Database
+	toAdd    map[ScriptPublicKeyString]addressTransactions
+	toRemove map[ScriptPublicKeyString]map[addressTransactionKey]struct{}
+
+	virtualParents []*externalapi.DomainHash
+}
+
+func newAddressIndexStore(database database.Database) *addressIndexStore {
+	return &addressIndexStore{
+		database: database,
+		toAdd:    make(map[ScriptPublicKeyString]addressTransactions),
+		toRemove: make(map[ScriptPublicKeyString]map[addressTransactionKey]struct{}),
+	}
+}
+
+func (ais *addressIndexStore) add(scriptPublicKey *externalapi.ScriptPublicKey, addressTransaction *AddressTransaction) {
+	scriptPublicKeyString := ScriptPublicKeyString(scriptPublicKey.String())
+	key := addressTransaction.key()
+	log.Tracef("Adding transaction %s accepted at DAA score %d to the history of script public key %s",
+		addressTransaction.TransactionID, addressTransaction.AcceptingBlockDAAScore, scriptPublicKey)
+
+	// An addition overrides any removal of the same entry staged earlier, since
+	// chain blocks are removed before new chain blocks are added
+	if toRemoveOfScriptPublicKey, ok := ais.toRemove[scriptPublicKeyString]; ok {
+		delete(toRemoveOfScriptPublicKey, key)
+		if len(toRemoveOfScriptPublicKey) == 0 {
+			delete(ais.toRemove, scriptPublicKeyString)
+		}
+	}
+
+	toAddOfScriptPublicKey, ok := ais.toAdd[scriptPublicKeyString]
+	if !ok {
+		toAddOfScriptPublicKey = make(addressTransactions)
+		ais.toAdd[scriptPublicKeyString] = toAddOfScriptPublicKey
+	}
+
+	// A single transaction may both create and spend outputs of the same address
+	if existing, ok := toAddOfScriptPublicKey[key]; ok {
+		existing.CreatedOutputs = existing.CreatedOutputs || addressTransaction.CreatedOutputs
+		existing.SpentOutputs = existing.SpentOutputs || addressTransaction.SpentOutputs
+		return
+	}
+	toAddOfScriptPublicKey[key] = addressTransaction
+}
+
+func (ais *addressIndexStore) remove(scriptPublicKey *externalapi.ScriptPublicKey, addressTransaction *AddressTransaction) {
+	scriptPublicKeyString := ScriptPublicKeyString(scriptPublicKey.String())
+	key := addressTransaction.key()
+	log.Tracef("Removing transaction %s accepted at DAA score %d from the history of script public key %s",
+		addressTransaction.TransactionID, addressTransaction.AcceptingBlockDAAScore, scriptPublicKey)
+
+	if toAddOfScriptPublicKey, ok := ais.toAdd[scriptPublicKeyString]; ok {
+		delete(toAddOfScriptPublicKey, key)
+		if len(toAddOfScriptPublicKey) == 0 {
+			delete(ais.toAdd, scriptPublicKeyString)
+		}
+	}
+
+	toRemoveOfScriptPublicKey, ok := ais.toRemove[scriptPublicKeyString]
+	if !ok {
+		toRemoveOfScriptPublicKey = make(map[addressTransactionKey]struct{})
+		ais.toRemove[scriptPublicKeyString] = toRemoveOfScriptPublicKey
+	}
+	toRemoveOfScriptPublicKey[key] = struct{}{}
+}
+
+func (ais *addressIndexStore) updateVirtualParents(virtualParents []*externalapi.DomainHash) {
+	ais.virtualParents = virtualParents
+}
+
+func (ais *addressIndexStore) discard() {
+	ais.toAdd = make(map[ScriptPublicKeyString]addressTransactions)
+	ais.toRemove = make(map[ScriptPublicKeyString]map[addressTransactionKey]struct{})
+	ais.virtualParents = nil
+}
+
+func (ais *addressIndexStore) commit() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "addressIndexStore.commit")
+	defer onEnd()
+
+	dbTransaction, err := ais.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	for scriptPublicKeyString, toRemoveOfScriptPublicKey := range ais.toRemove {
+		scriptPublicKey := externalapi.NewScriptPublicKeyFromString(string(scriptPublicKeyString))
+		bucket := ais.bucketForScriptPublicKey(scriptPublicKey)
+		for key := range toRemoveOfScriptPublicKey {
+			err := dbTransaction.Delete(bucket.Key(serializeAddressTransactionKey(key)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for scriptPublicKeyString, toAddOfScriptPublicKey := range ais.toAdd {
+		scriptPublicKey := externalapi.NewScriptPublicKeyFromString(string(scriptPublicKeyString))
+		bucket := ais.bucketForScriptPublicKey(scriptPublicKey)
+		for key, addressTransaction := range toAddOfScriptPublicKey {
+			err := dbTransaction.Put(bucket.Key(serializeAddressTransactionKey(key)),
+				serializeAddressTransactionValue(addressTransaction))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if ais.virtualParents != nil {
+		err = dbTransaction.Put(virtualParentsKey, binaryserialization.SerializeHashes(ais.virtualParents))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	ais.discard()
+	return nil
+}
+
+func (ais *addressIndexStore) updateAndCommitVirtualParentsWithoutTransaction(virtualParents []*externalapi.DomainHash) error {
+	return ais.database.Put(virtualParentsKey, binaryserialization.SerializeHashes(virtualParents))
+}
+
+func (ais *addressIndexStore) bucketForScriptPublicKey(scriptPublicKey *externalapi.ScriptPublicKey) *database.Bucket {
+	var scriptPublicKeyBytes = make([]byte, 2+len(scriptPublicKey.Script)) // uint16
+	binary.LittleEndian.PutUint16(scriptPublicKeyBytes[:2], scriptPublicKey.Version)
+	copy(scriptPublicKeyBytes[2:], scriptPublicKey.Script)
+	return addressIndexBucket.Bucket(scriptPublicKeyBytes)
+}
+
+func (ais *addressIndexStore) isAnythingStaged() bool {
+	return len(ais.toAdd) > 0 || len(ais.toRemove) > 0
+}
+
+// getAddressTransactions returns up to `limit` transactions of the given script public key
+// accepted at a DAA score of at least `startDAAScore`, ordered by their DAA score.
+// In order for a subsequent call to be able to continue from where this one stopped, the
+// result is never cut in the middle of a DAA score, so it might exceed `limit`.
+// A limit of 0 means no limit.
+func (ais *addressIndexStore) getAddressTransactions(scriptPublicKey *externalapi.ScriptPublicKey,
+	startDAAScore uint64, limit int) ([]*AddressTransaction, error) {
+
+	if ais.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get address transactions while staging isn't empty")
+	}
+
+	bucket := ais.bucketForScriptPublicKey(scriptPublicKey)
+	cursor, err := ais.database.Cursor(bucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	// Keys begin with the big-endian DAA score, so seeking to startDAAScore skips the whole
+	// history before it. Seek returns ErrNotFound unless it lands exactly on the given key,
+	// but it still positions the cursor at the first key that follows it
+	startKey := bucket.Key(serializeAddressTransactionKey(addressTransactionKey{acceptingBlockDAAScore: startDAAScore}))
+	err = cursor.Seek(startKey)
+	if err != nil && !database.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	addressTransactions := make([]*AddressTransaction, 0)
+	for hasCurrent := true; hasCurrent; hasCurrent = cursor.Next() {
+		key, err := cursor.Key()
+		if database.IsNotFoundError(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Script public keys that begin with this script public key share its bucket prefix,
+		// but their keys within it are always longer than a single address transaction key
+		if len(key.Suffix()) != addressTransactionKeySize {
+			continue
+		}
+		serializedValue, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		addressTransaction, err := deserializeAddressTransaction(key.Suffix(), serializedValue)
+		if err != nil {
+			return nil, err
+		}
+
+		if limit > 0 && len(addressTransactions) >= limit {
+			lastDAAScore := addressTransactions[len(addressTransactions)-1].AcceptingBlockDAAScore
+			if addressTransaction.AcceptingBlockDAAScore != lastDAAScore {
+				break
+			}
+		}
+		addressTransactions = append(addressTransactions, addressTransaction)
+	}
+	return addressTransactions, nil
+}
+
+func (ais *addressIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
+	if ais.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get the virtual parents while staging isn't empty")
+	}
+
+	serializedHashes, err := ais.database.Get(virtualParentsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return binaryserialization.DeserializeHashes(serializedHashes)
+}
+
+func (ais *addressIndexStore) deleteAll() error {
+	// First we delete the virtual parents, so if anything goes wrong, the address index will be marked as "not synced"
+	// and will be reset.
+	err := ais.database.Delete(virtualParentsKey)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := ais.database.Cursor(addressIndexBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = ais.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
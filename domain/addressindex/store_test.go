@@ -0,0 +1,79 @@
+package addressindex
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+)
+
+func TestAddressIndexStoreGetAddressTransactions(t *testing.T) {
+	db, err := ldb.NewInMemoryLevelDB(8)
+	if err != nil {
+		t.Fatalf("NewInMemoryLevelDB: %s", err)
+	}
+	defer db.Close()
+
+	store := newAddressIndexStore(db)
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0}
+	// The bucket of this script public key begins with the bucket of scriptPublicKey
+	prefixedScriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3, '/', 4}, Version: 0}
+
+	// Add two transactions at each of the DAA scores 1 through 4
+	var addedTransactions []*AddressTransaction
+	for i := byte(0); i < 8; i++ {
+		addressTransaction := &AddressTransaction{
+			TransactionID:          externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{i}),
+			AcceptingBlockHash:     externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{i / 2}),
+			AcceptingBlockDAAScore: uint64(i/2) + 1,
+			CreatedOutputs:         true,
+		}
+		addedTransactions = append(addedTransactions, addressTransaction)
+		store.add(scriptPublicKey, addressTransaction)
+		store.add(prefixedScriptPublicKey, addressTransaction)
+	}
+	err = store.commit()
+	if err != nil {
+		t.Fatalf("commit: %+v", err)
+	}
+
+	expectAddressTransactions := func(startDAAScore uint64, limit int, expected []*AddressTransaction) {
+		addressTransactions, err := store.getAddressTransactions(scriptPublicKey, startDAAScore, limit)
+		if err != nil {
+			t.Fatalf("getAddressTransactions: %+v", err)
+		}
+		if len(addressTransactions) != len(expected) {
+			t.Fatalf("getAddressTransactions(%d, %d): expected %d transactions but got %d",
+				startDAAScore, limit, len(expected), len(addressTransactions))
+		}
+		for i, addressTransaction := range addressTransactions {
+			if !addressTransaction.TransactionID.Equal(expected[i].TransactionID) ||
+				addressTransaction.AcceptingBlockDAAScore != expected[i].AcceptingBlockDAAScore {
+				t.Fatalf("getAddressTransactions(%d, %d): expected transaction %s at DAA score %d "+
+					"at index %d but got %s at DAA score %d", startDAAScore, limit, expected[i].TransactionID,
+					expected[i].AcceptingBlockDAAScore, i, addressTransaction.TransactionID,
+					addressTransaction.AcceptingBlockDAAScore)
+			}
+		}
+	}
+
+	expectAddressTransactions(0, 0, addedTransactions)
+	expectAddressTransactions(3, 0, addedTransactions[4:])
+	expectAddressTransactions(4, 1, addedTransactions[6:])
+	expectAddressTransactions(5, 0, nil)
+
+	// A page is never cut in the middle of a DAA score
+	expectAddressTransactions(2, 3, addedTransactions[2:6])
+
+	// Removing the transactions accepted by a block that left the selected
+	// parent chain should remove them from the history
+	store.remove(scriptPublicKey, addedTransactions[4])
+	store.remove(scriptPublicKey, addedTransactions[5])
+	err = store.commit()
+	if err != nil {
+		t.Fatalf("commit: %+v", err)
+	}
+	remainingTransactions := append(append([]*AddressTransaction{}, addedTransactions[:4]...), addedTransactions[6:]...)
+	expectAddressTransactions(0, 0, remainingTransactions)
+	expectAddressTransactions(3, 0, addedTransactions[6:])
+}
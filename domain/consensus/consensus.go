@@ -10,6 +10,8 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/multiset"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/util/staging"
 	"github.com/pkg/errors"
@@ -623,6 +625,100 @@ func (s *consensus) PruningPoint() (*externalapi.DomainHash, error) {
 	return s.pruningStore.PruningPoint(s.databaseContext, stagingArea)
 }
 
+func (s *consensus) VerifyUTXOCommitments() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	pruningPoint, err := s.pruningStore.PruningPoint(s.databaseContext, stagingArea)
+	if err != nil {
+		return err
+	}
+
+	// The genesis commits to the UTXO set the network was started from, which isn't
+	// stored as its UTXO set, so the pruning point is only verified once it moves
+	if !pruningPoint.Equal(s.genesisHash) {
+		err = s.verifyPruningPointUTXOCommitment(stagingArea, pruningPoint)
+		if err != nil {
+			return err
+		}
+	}
+
+	virtualUTXOSetIterator, err := s.consensusStateStore.VirtualUTXOSetIterator(s.databaseContext, stagingArea)
+	if err != nil {
+		return err
+	}
+	virtualUTXOSetMultiset, err := utxoSetMultiset(virtualUTXOSetIterator)
+	if err != nil {
+		return err
+	}
+	virtualMultiset, err := s.multisetStore.Get(s.databaseContext, stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		return err
+	}
+	if !virtualMultiset.Hash().Equal(virtualUTXOSetMultiset.Hash()) {
+		return errors.Errorf("the stored multiset of the virtual is %s, but the virtual UTXO set hashes to %s",
+			virtualMultiset.Hash(), virtualUTXOSetMultiset.Hash())
+	}
+
+	return nil
+}
+
+func (s *consensus) verifyPruningPointUTXOCommitment(stagingArea *model.StagingArea,
+	pruningPoint *externalapi.DomainHash) error {
+
+	pruningPointHeader, err := s.blockHeaderStore.BlockHeader(s.databaseContext, stagingArea, pruningPoint)
+	if err != nil {
+		return err
+	}
+	pruningPointUTXOSetIterator, err := s.pruningStore.PruningPointUTXOIterator(s.databaseContext)
+	if err != nil {
+		return err
+	}
+	pruningPointUTXOSetMultiset, err := utxoSetMultiset(pruningPointUTXOSetIterator)
+	if err != nil {
+		return err
+	}
+	if !pruningPointHeader.UTXOCommitment().Equal(pruningPointUTXOSetMultiset.Hash()) {
+		return errors.Wrapf(ruleerrors.ErrBadPruningPointUTXOSet, "the UTXO commitment of the pruning point %s "+
+			"is %s, but its UTXO set hashes to %s",
+			pruningPoint, pruningPointHeader.UTXOCommitment(), pruningPointUTXOSetMultiset.Hash())
+	}
+
+	// The multiset of the pruning point itself is kept unless it was pruned
+	pruningPointMultiset, err := s.multisetStore.Get(s.databaseContext, stagingArea, pruningPoint)
+	if err != nil && !database.IsNotFoundError(err) {
+		return err
+	}
+	if err == nil && !pruningPointHeader.UTXOCommitment().Equal(pruningPointMultiset.Hash()) {
+		return errors.Errorf("the UTXO commitment of the pruning point %s is %s, but its stored multiset is %s",
+			pruningPoint, pruningPointHeader.UTXOCommitment(), pruningPointMultiset.Hash())
+	}
+
+	return nil
+}
+
+// utxoSetMultiset calculates the multiset of the UTXO set the given iterator goes over,
+// and closes the iterator
+func utxoSetMultiset(utxoSetIterator externalapi.ReadOnlyUTXOSetIterator) (model.Multiset, error) {
+	defer utxoSetIterator.Close()
+
+	utxoSetMultiset := multiset.New()
+	for ok := utxoSetIterator.First(); ok; ok = utxoSetIterator.Next() {
+		outpoint, entry, err := utxoSetIterator.Get()
+		if err != nil {
+			return nil, err
+		}
+		serializedUTXO, err := utxo.SerializeUTXO(entry, outpoint)
+		if err != nil {
+			return nil, err
+		}
+		utxoSetMultiset.Add(serializedUTXO)
+	}
+	return utxoSetMultiset, nil
+}
+
 func (s *consensus) PruningPointHeaders() ([]externalapi.BlockHeader, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -4,10 +4,13 @@ import (
 	"testing"
 
 	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/ruleerrors"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/multiset"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+	"github.com/kaspanet/kaspad/util/staging"
 	"github.com/pkg/errors"
 )
 
@@ -74,3 +77,51 @@ func TestConsensus_GetBlockInfo(t *testing.T) {
 
 	})
 }
+
+func TestConsensus_VerifyUTXOCommitments(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		// Set a short pruning depth, so that the pruning point moves away from the genesis
+		consensusConfig.FinalityDuration = 5 * consensusConfig.TargetTimePerBlock
+		consensusConfig.MergeSetSizeLimit = 1
+
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestConsensus_VerifyUTXOCommitments")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		tipHash := consensusConfig.GenesisHash
+		for i := uint64(0); i < consensusConfig.PruningDepth()+2*consensusConfig.FinalityDepth(); i++ {
+			tipHash, _, err = tc.AddBlock([]*externalapi.DomainHash{tipHash}, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+		}
+		pruningPoint, err := tc.PruningPoint()
+		if err != nil {
+			t.Fatalf("PruningPoint: %+v", err)
+		}
+		if pruningPoint.Equal(consensusConfig.GenesisHash) {
+			t.Fatalf("The pruning point unexpectedly didn't move")
+		}
+
+		err = tc.VerifyUTXOCommitments()
+		if err != nil {
+			t.Fatalf("VerifyUTXOCommitments: %+v", err)
+		}
+
+		// Replace the multiset of the virtual, so that it no longer matches the virtual UTXO set
+		stagingArea := model.NewStagingArea()
+		tc.MultisetStore().Stage(stagingArea, model.VirtualBlockHash, multiset.New())
+		err = staging.CommitAllChanges(tc.DatabaseContext(), stagingArea)
+		if err != nil {
+			t.Fatalf("CommitAllChanges: %+v", err)
+		}
+
+		err = tc.VerifyUTXOCommitments()
+		if err == nil {
+			t.Fatalf("VerifyUTXOCommitments unexpectedly succeeded with a wrong virtual multiset")
+		}
+	})
+}
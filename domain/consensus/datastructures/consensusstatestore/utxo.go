@@ -4,6 +4,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
 	"github.com/pkg/errors"
 )
 
@@ -99,8 +100,10 @@ func (css *consensusStateStore) utxoByOutpointFromStagedVirtualUTXODiff(dbContex
 	}
 
 	if entry, ok := css.virtualUTXOSetCache.Get(outpoint); ok {
+		metrics.UTXOCacheHits.Inc()
 		return entry, nil
 	}
+	metrics.UTXOCacheMisses.Inc()
 
 	key, err := css.utxoKey(outpoint)
 	if err != nil {
@@ -55,7 +55,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/processes/transactionvalidator"
 	"github.com/kaspanet/kaspad/domain/dagconfig"
 	infrastructuredatabase "github.com/kaspanet/kaspad/infrastructure/db/database"
-	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
 )
 
 const (
@@ -84,6 +84,7 @@ type Factory interface {
 		tc testapi.TestConsensus, teardown func(keepDataDir bool), err error)
 
 	SetTestDataDir(dataDir string)
+	SetTestDatabaseType(dbType string)
 	SetTestGHOSTDAGManager(ghostdagConstructor GHOSTDAGManagerConstructor)
 	SetTestLevelDBCacheSize(cacheSizeMiB int)
 	SetTestPreAllocateCache(preallocateCaches bool)
@@ -93,6 +94,7 @@ type Factory interface {
 
 type factory struct {
 	dataDir                  string
+	dbType                   string
 	ghostdagConstructor      GHOSTDAGManagerConstructor
 	pastMedianTimeConsructor PastMedianTimeManagerConstructor
 	difficultyConstructor    DifficultyManagerConstructor
@@ -563,8 +565,18 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 
 func (f *factory) NewTestConsensus(config *Config, testName string) (
 	tc testapi.TestConsensus, teardown func(keepDataDir bool), err error) {
+	dbType := f.dbType
+	if dbType == "" {
+		// Unless the test asked for a specific data directory, there's no
+		// reason for its database to outlive the test consensus
+		if f.dataDir != "" {
+			dbType = dbfactory.TypeLevelDB
+		} else {
+			dbType = dbfactory.TypeMemory
+		}
+	}
 	datadir := f.dataDir
-	if datadir == "" {
+	if datadir == "" && dbfactory.IsPersistent(dbType) {
 		datadir, err = ioutil.TempDir("", testName)
 		if err != nil {
 			return nil, nil, err
@@ -579,7 +591,7 @@ func (f *factory) NewTestConsensus(config *Config, testName string) (
 	if f.preallocateCaches == nil {
 		f.SetTestPreAllocateCache(defaultTestPreallocateCaches)
 	}
-	db, err := ldb.NewLevelDB(datadir, cacheSizeMiB)
+	db, err := dbfactory.Open(dbType, datadir, cacheSizeMiB)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -610,8 +622,8 @@ func (f *factory) NewTestConsensus(config *Config, testName string) (
 	tstConsensus.testBlockBuilder = blockbuilder.NewTestBlockBuilder(consensusAsImplementation.blockBuilder, tstConsensus)
 	teardown = func(keepDataDir bool) {
 		db.Close()
-		if !keepDataDir {
-			err := os.RemoveAll(f.dataDir)
+		if !keepDataDir && datadir != "" {
+			err := os.RemoveAll(datadir)
 			if err != nil {
 				log.Errorf("Error removing data directory for test consensus: %s", err)
 			}
@@ -624,6 +636,12 @@ func (f *factory) SetTestDataDir(dataDir string) {
 	f.dataDir = dataDir
 }
 
+// SetTestDatabaseType sets the database backend of test consensuses. By default, test
+// consensuses are kept in memory, unless a data directory is set with SetTestDataDir.
+func (f *factory) SetTestDatabaseType(dbType string) {
+	f.dbType = dbType
+}
+
 func (f *factory) SetTestGHOSTDAGManager(ghostdagConstructor GHOSTDAGManagerConstructor) {
 	f.ghostdagConstructor = ghostdagConstructor
 }
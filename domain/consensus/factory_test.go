@@ -6,7 +6,9 @@ import (
 
 	"github.com/kaspanet/kaspad/domain/prefixmanager/prefix"
 
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
 	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
 )
 
@@ -34,3 +36,30 @@ func TestNewConsensus(t *testing.T) {
 		t.Fatalf("A fresh consensus should never return shouldMigrate=true")
 	}
 }
+
+func TestNewTestConsensusDatabaseTypes(t *testing.T) {
+	for _, dbType := range []string{dbfactory.TypeMemory, dbfactory.TypeLevelDB} {
+		f := NewFactory()
+		f.SetTestDatabaseType(dbType)
+
+		config := &Config{Params: dagconfig.SimnetParams}
+		tc, teardown, err := f.NewTestConsensus(config, "TestNewTestConsensusDatabaseTypes")
+		if err != nil {
+			t.Fatalf("%s: error in NewTestConsensus: %+v", dbType, err)
+		}
+
+		blockHash, _, err := tc.AddBlock([]*externalapi.DomainHash{config.GenesisHash}, nil, nil)
+		if err != nil {
+			t.Fatalf("%s: error in AddBlock: %+v", dbType, err)
+		}
+		blockInfo, err := tc.GetBlockInfo(blockHash)
+		if err != nil {
+			t.Fatalf("%s: error in GetBlockInfo: %+v", dbType, err)
+		}
+		if !blockInfo.Exists {
+			t.Fatalf("%s: block %s unexpectedly doesn't exist", dbType, blockHash)
+		}
+
+		teardown(false)
+	}
+}
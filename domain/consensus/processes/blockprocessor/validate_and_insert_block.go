@@ -12,9 +12,11 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/kaspanet/kaspad/infrastructure/metrics"
 	"github.com/kaspanet/kaspad/util/difficulty"
 	"github.com/kaspanet/kaspad/util/staging"
 	"github.com/pkg/errors"
+	"time"
 )
 
 func (bp *blockProcessor) setBlockStatusAfterBlockValidation(
@@ -80,10 +82,13 @@ func (bp *blockProcessor) validateAndInsertBlock(stagingArea *model.StagingArea,
 	isPruningPoint bool, shouldValidateAgainstUTXO bool, isBlockWithTrustedData bool) (*externalapi.VirtualChangeSet, externalapi.BlockStatus, error) {
 
 	blockHash := consensushashing.HeaderHash(block.Header)
+	processingStart := time.Now()
+	stageStart := processingStart
 	err := bp.validateBlock(stagingArea, block, isBlockWithTrustedData)
 	if err != nil {
 		return nil, externalapi.StatusInvalid, err
 	}
+	observeBlockProcessingStage(metrics.BlockProcessingStageValidate, &stageStart)
 
 	status, err := bp.setBlockStatusAfterBlockValidation(stagingArea, block, isPruningPoint)
 	if err != nil {
@@ -136,43 +141,53 @@ func (bp *blockProcessor) validateAndInsertBlock(stagingArea *model.StagingArea,
 	isHeaderOnlyBlock := isHeaderOnlyBlock(block)
 	if !isHeaderOnlyBlock {
 		// Attempt to add the block to the virtual
+		stageStart = time.Now()
 		selectedParentChainChanges, virtualUTXODiff, reversalData, err = bp.consensusStateManager.AddBlock(stagingArea, blockHash, shouldValidateAgainstUTXO)
 		if err != nil {
 			return nil, externalapi.StatusInvalid, err
 		}
+		observeBlockProcessingStage(metrics.BlockProcessingStageAddToVirtual, &stageStart)
 	}
 
 	if hasHeaderSelectedTip {
+		stageStart = time.Now()
 		err := bp.updateReachabilityReindexRoot(stagingArea, oldHeadersSelectedTip)
 		if err != nil {
 			return nil, externalapi.StatusInvalid, err
 		}
+		observeBlockProcessingStage(metrics.BlockProcessingStageReachability, &stageStart)
 	}
 
 	if !isHeaderOnlyBlock && shouldValidateAgainstUTXO {
 		// Trigger pruning, which will check if the pruning point changed and delete the data if it did.
+		stageStart = time.Now()
 		err = bp.pruningManager.UpdatePruningPointByVirtual(stagingArea)
 		if err != nil {
 			return nil, externalapi.StatusInvalid, err
 		}
+		observeBlockProcessingStage(metrics.BlockProcessingStagePruning, &stageStart)
 	}
 
+	stageStart = time.Now()
 	err = staging.CommitAllChanges(bp.databaseContext, stagingArea)
 	if err != nil {
 		return nil, externalapi.StatusInvalid, err
 	}
+	observeBlockProcessingStage(metrics.BlockProcessingStageCommit, &stageStart)
 
 	if reversalData != nil {
 		err = bp.consensusStateManager.ReverseUTXODiffs(blockHash, reversalData)
 		if err != nil {
 			return nil, externalapi.StatusInvalid, err
 		}
+		observeBlockProcessingStage(metrics.BlockProcessingStageReverseUTXODiffs, &stageStart)
 	}
 
 	err = bp.pruningManager.UpdatePruningPointIfRequired()
 	if err != nil {
 		return nil, externalapi.StatusInvalid, err
 	}
+	metrics.BlockProcessingDuration.WithLabelValues(metrics.BlockProcessingStageTotal).ObserveDuration(processingStart)
 
 	log.Debug(logger.NewLogClosure(func() string {
 		hashrate := difficulty.GetHashrateString(difficulty.CompactToBig(block.Header.Bits()), bp.targetTimePerBlock)
@@ -374,3 +389,11 @@ func (bp *blockProcessor) hasValidatedHeader(stagingArea *model.StagingArea, blo
 
 	return status != externalapi.StatusInvalid, nil
 }
+
+// observeBlockProcessingStage records the time that passed since stageStart as the duration
+// of the given block processing stage, and resets stageStart to now
+func observeBlockProcessingStage(stage string, stageStart *time.Time) {
+	now := time.Now()
+	metrics.BlockProcessingDuration.WithLabelValues(stage).Observe(now.Sub(*stageStart).Seconds())
+	*stageStart = now
+}
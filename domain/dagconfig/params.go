@@ -63,6 +63,9 @@ type Params struct {
 	// RPCPort defines the rpc server port
 	RPCPort string
 
+	// JSONRPCPort defines the JSON-RPC server port
+	JSONRPCPort string
+
 	// DefaultPort defines the default peer-to-peer port for the network.
 	DefaultPort string
 
@@ -212,6 +215,7 @@ var MainnetParams = Params{
 	Name:        "kaspa-mainnet",
 	Net:         appmessage.Mainnet,
 	RPCPort:     "16110",
+	JSONRPCPort: "18110",
 	DefaultPort: "16111",
 	DNSSeeds: []string{
 		// This DNS seeder is run by Wolfie
@@ -296,6 +300,7 @@ var TestnetParams = Params{
 	Name:        "kaspa-testnet-10",
 	Net:         appmessage.Testnet,
 	RPCPort:     "16210",
+	JSONRPCPort: "18210",
 	DefaultPort: "16211",
 	DNSSeeds:    []string{"testnet-10-dnsseed.kas.pa"},
 
@@ -364,6 +369,7 @@ var SimnetParams = Params{
 	Name:        "kaspa-simnet",
 	Net:         appmessage.Simnet,
 	RPCPort:     "16510",
+	JSONRPCPort: "18510",
 	DefaultPort: "16511",
 	DNSSeeds:    []string{}, // NOTE: There must NOT be any seeds.
 
@@ -424,6 +430,7 @@ var DevnetParams = Params{
 	Name:        "kaspa-devnet",
 	Net:         appmessage.Devnet,
 	RPCPort:     "16610",
+	JSONRPCPort: "18610",
 	DefaultPort: "16611",
 	DNSSeeds:    []string{}, // NOTE: There must NOT be any seeds.
 
@@ -0,0 +1,160 @@
+package dbcheck
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/hashset"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+// forEachBlockWithStatus calls handleBlock for every block that has a status, except for
+// the virtual genesis, which isn't a real block
+func (c *checker) forEachBlockWithStatus(handleBlock func(blockHash *externalapi.DomainHash) error) error {
+	iterator, err := c.blockStatusStore.AllBlockHashesIterator(c.dbContext)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ok := iterator.First(); ok; ok = iterator.Next() {
+		blockHash, err := iterator.Get()
+		if err != nil {
+			return err
+		}
+		if blockHash.Equal(model.VirtualGenesisBlockHash) {
+			continue
+		}
+		err = handleBlock(blockHash)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *checker) checkBlockHeaders() (*CheckResult, error) {
+	result := newCheckResult("block-headers",
+		"Every block in the block status store has a header", c.maxFailures)
+
+	stagingArea := model.NewStagingArea()
+	err := c.forEachBlockWithStatus(func(blockHash *externalapi.DomainHash) error {
+		result.CheckedCount++
+		hasHeader, err := c.blockHeaderStore.HasBlockHeader(c.dbContext, stagingArea, blockHash)
+		if err != nil {
+			return err
+		}
+		if !hasHeader {
+			result.fail(blockHash, "the block has a status but no header")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *checker) checkGHOSTDAGData() (*CheckResult, error) {
+	result := newCheckResult("ghostdag-data",
+		"The GHOSTDAG data of every block is consistent with the block relation store", c.maxFailures)
+
+	stagingArea := model.NewStagingArea()
+	err := c.forEachBlockWithStatus(func(blockHash *externalapi.DomainHash) error {
+		result.CheckedCount++
+		return c.checkBlockGHOSTDAGData(stagingArea, result, blockHash)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *checker) checkBlockGHOSTDAGData(stagingArea *model.StagingArea, result *CheckResult,
+	blockHash *externalapi.DomainHash) error {
+
+	blockRelations, err := c.blockRelationStore.BlockRelation(c.dbContext, stagingArea, blockHash)
+	if database.IsNotFoundError(err) {
+		result.fail(blockHash, "the block has a status but no block relations")
+		return nil
+	}
+	if err != nil {
+		result.fail(blockHash, "failed to read the block relations: %s", err)
+		return nil
+	}
+
+	for _, parent := range blockRelations.Parents {
+		parentRelations, err := c.blockRelationStore.BlockRelation(c.dbContext, stagingArea, parent)
+		if database.IsNotFoundError(err) {
+			// The parents of the earliest blocks of a node that synced from a pruning
+			// point proof are missing by design
+			continue
+		}
+		if err != nil {
+			result.fail(blockHash, "failed to read the block relations of parent %s: %s", parent, err)
+			continue
+		}
+		if !hashset.NewFromSlice(parentRelations.Children...).Contains(blockHash) {
+			result.fail(blockHash, "the block is missing from the children of its parent %s", parent)
+		}
+	}
+
+	ghostdagData, err := c.ghostdagDataStore.Get(c.dbContext, stagingArea, blockHash, false)
+	if database.IsNotFoundError(err) {
+		// Blocks that were received along with the pruning point proof only have
+		// trusted GHOSTDAG data, which refers to blocks that aren't in the DAG
+		_, trustedErr := c.ghostdagDataStore.Get(c.dbContext, stagingArea, blockHash, true)
+		if database.IsNotFoundError(trustedErr) {
+			result.fail(blockHash, "the block has a status but no GHOSTDAG data")
+		}
+		return nil
+	}
+	if err != nil {
+		result.fail(blockHash, "failed to read the GHOSTDAG data: %s", err)
+		return nil
+	}
+
+	selectedParent := ghostdagData.SelectedParent()
+	if !hashset.NewFromSlice(blockRelations.Parents...).Contains(selectedParent) {
+		result.fail(blockHash, "the selected parent %s is not one of the block parents %s",
+			selectedParent, blockRelations.Parents)
+		return nil
+	}
+
+	mergeSet := hashset.NewFromSlice(ghostdagData.MergeSetBlues()...)
+	for _, red := range ghostdagData.MergeSetReds() {
+		mergeSet.Add(red)
+	}
+	for _, parent := range blockRelations.Parents {
+		if parent.Equal(model.VirtualGenesisBlockHash) {
+			continue
+		}
+		if !mergeSet.Contains(parent) {
+			result.fail(blockHash, "the parent %s is not in the block merge set", parent)
+		}
+	}
+
+	// The virtual genesis is the selected parent of the genesis and of the earliest blocks
+	// of a node that synced from a pruning point proof, and it isn't in their merge set
+	if selectedParent.Equal(model.VirtualGenesisBlockHash) {
+		return nil
+	}
+	if len(ghostdagData.MergeSetBlues()) == 0 || !ghostdagData.MergeSetBlues()[0].Equal(selectedParent) {
+		result.fail(blockHash, "the selected parent %s is not the first blue in the merge set", selectedParent)
+		return nil
+	}
+
+	selectedParentGHOSTDAGData, err := c.ghostdagDataStore.Get(c.dbContext, stagingArea, selectedParent, false)
+	if database.IsNotFoundError(err) {
+		selectedParentGHOSTDAGData, err = c.ghostdagDataStore.Get(c.dbContext, stagingArea, selectedParent, true)
+	}
+	if err != nil {
+		result.fail(blockHash, "failed to read the GHOSTDAG data of the selected parent %s: %s", selectedParent, err)
+		return nil
+	}
+	expectedBlueScore := selectedParentGHOSTDAGData.BlueScore() + uint64(len(ghostdagData.MergeSetBlues()))
+	if ghostdagData.BlueScore() != expectedBlueScore {
+		result.fail(blockHash, "the blue score is %d, but the blue score of the selected parent and the "+
+			"merge set blues add up to %d", ghostdagData.BlueScore(), expectedBlueScore)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package dbcheck
+
+import (
+	consensusdatabase "github.com/kaspanet/kaspad/domain/consensus/database"
+	"github.com/kaspanet/kaspad/domain/consensus/datastructures/blockheaderstore"
+	"github.com/kaspanet/kaspad/domain/consensus/datastructures/blockrelationstore"
+	"github.com/kaspanet/kaspad/domain/consensus/datastructures/blockstatusstore"
+	"github.com/kaspanet/kaspad/domain/consensus/datastructures/consensusstatestore"
+	"github.com/kaspanet/kaspad/domain/consensus/datastructures/ghostdagdatastore"
+	"github.com/kaspanet/kaspad/domain/consensus/datastructures/multisetstore"
+	"github.com/kaspanet/kaspad/domain/consensus/datastructures/reachabilitydatastore"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/prefixmanager/prefix"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+// storeCacheSize is the cache size of every store the checker reads through. Every
+// block is visited only a few times, so there's little to gain from large caches
+const storeCacheSize = 1000
+
+// checker holds the consensus stores of the checked database
+type checker struct {
+	db          database.Database
+	dbContext   model.DBReader
+	maxFailures int
+
+	blockStatusStore      model.BlockStatusStore
+	blockHeaderStore      model.BlockHeaderStore
+	blockRelationStore    model.BlockRelationStore
+	ghostdagDataStore     model.GHOSTDAGDataStore
+	reachabilityDataStore model.ReachabilityDataStore
+	consensusStateStore   model.ConsensusStateStore
+	multisetStore         model.MultisetStore
+}
+
+// Check verifies the invariants that must hold between the stores of the consensus with the
+// given prefix in the given database, and between the consensus and the UTXO index, if there
+// is one. It only reads from the database.
+// Each check reports at most maxFailuresPerCheck failures. An error is returned only if the
+// database can't be checked at all.
+func Check(db database.Database, dbPrefix *prefix.Prefix, maxFailuresPerCheck int) (*Report, error) {
+	checker, err := newChecker(db, dbPrefix, maxFailuresPerCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := []func() (*CheckResult, error){
+		checker.checkBlockHeaders,
+		checker.checkGHOSTDAGData,
+		checker.checkReachabilityIntervals,
+		checker.checkVirtualUTXOCommitment,
+		checker.checkUTXOIndex,
+	}
+	report := &Report{
+		OK:     true,
+		Checks: make([]*CheckResult, 0, len(checks)),
+	}
+	for _, check := range checks {
+		result, err := check()
+		if err != nil {
+			return nil, err
+		}
+		report.Checks = append(report.Checks, result)
+		report.OK = report.OK && result.OK
+	}
+	return report, nil
+}
+
+func newChecker(db database.Database, dbPrefix *prefix.Prefix, maxFailures int) (*checker, error) {
+	dbContext := consensusdatabase.New(db)
+	prefixBucket := consensusdatabase.MakeBucket(dbPrefix.Serialize())
+
+	// Only the stores of block level 0 are checked. They're kept in their own bucket, see dagStores
+	// in the consensus factory
+	blockLevelZeroBucket := prefixBucket.Bucket([]byte{0})
+
+	blockHeaderStore, err := blockheaderstore.New(dbContext, prefixBucket, storeCacheSize, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Databases that weren't migrated to the new reachability algorithm keep their
+	// reachability data along with the rest of the block level 0 stores
+	reachabilityDataStore := reachabilitydatastore.New(blockLevelZeroBucket, storeCacheSize, false)
+	isOldReachabilityInitialized, err := reachabilityDataStore.HasReachabilityData(
+		dbContext, model.NewStagingArea(), model.VirtualGenesisBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !isOldReachabilityInitialized {
+		reachabilityDataStore = reachabilitydatastore.New(prefixBucket, storeCacheSize, false)
+	}
+
+	return &checker{
+		db:          db,
+		dbContext:   dbContext,
+		maxFailures: maxFailures,
+
+		blockStatusStore:      blockstatusstore.New(prefixBucket, storeCacheSize, false),
+		blockHeaderStore:      blockHeaderStore,
+		blockRelationStore:    blockrelationstore.New(blockLevelZeroBucket, storeCacheSize, false),
+		ghostdagDataStore:     ghostdagdatastore.New(blockLevelZeroBucket, storeCacheSize, false),
+		reachabilityDataStore: reachabilityDataStore,
+		consensusStateStore:   consensusstatestore.New(prefixBucket, storeCacheSize, false),
+		multisetStore:         multisetstore.New(prefixBucket, storeCacheSize, false),
+	}, nil
+}
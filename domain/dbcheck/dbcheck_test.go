@@ -0,0 +1,145 @@
+package dbcheck
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus"
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/model/testapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/multiset"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/testutils"
+	"github.com/kaspanet/kaspad/domain/prefixmanager/prefix"
+	"github.com/kaspanet/kaspad/util/staging"
+)
+
+const testMaxFailures = 10
+
+func TestCheck(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestCheck")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		// Build a DAG with some merges, so that blocks have more than a single parent
+		tipHashes := []*externalapi.DomainHash{consensusConfig.GenesisHash}
+		for i := 0; i < 10; i++ {
+			var newTipHashes []*externalapi.DomainHash
+			for j := 0; j < 2; j++ {
+				tipHash, _, err := tc.AddBlock(tipHashes, nil, nil)
+				if err != nil {
+					t.Fatalf("AddBlock: %+v", err)
+				}
+				newTipHashes = append(newTipHashes, tipHash)
+			}
+			tipHashes = newTipHashes
+		}
+
+		report := check(t, tc)
+		if !report.OK {
+			t.Fatalf("The check of a valid database unexpectedly failed: %s", failuresString(report))
+		}
+		for _, result := range report.Checks {
+			if result.Name == "utxo-index" {
+				if !result.Skipped {
+					t.Fatalf("The UTXO index check wasn't skipped for a database without a UTXO index")
+				}
+				continue
+			}
+			if result.CheckedCount == 0 {
+				t.Fatalf("The %s check didn't check anything", result.Name)
+			}
+		}
+
+		stagingArea := model.NewStagingArea()
+		tc.BlockHeaderStore().Delete(stagingArea, tipHashes[0])
+
+		ghostdagData, err := tc.GHOSTDAGDataStore().Get(tc.DatabaseContext(), stagingArea, tipHashes[1], false)
+		if err != nil {
+			t.Fatalf("GHOSTDAGDataStore.Get: %+v", err)
+		}
+		corruptedGHOSTDAGData := externalapi.NewBlockGHOSTDAGData(ghostdagData.BlueScore()+1, ghostdagData.BlueWork(),
+			ghostdagData.SelectedParent(), ghostdagData.MergeSetBlues(), ghostdagData.MergeSetReds(),
+			ghostdagData.BluesAnticoneSizes())
+		tc.GHOSTDAGDataStore().Stage(stagingArea, tipHashes[1], corruptedGHOSTDAGData, false)
+
+		reachabilityData, err := tc.ReachabilityDataStore().ReachabilityData(tc.DatabaseContext(), stagingArea, tipHashes[1])
+		if err != nil {
+			t.Fatalf("ReachabilityData: %+v", err)
+		}
+		corruptedReachabilityData := reachabilityData.CloneMutable()
+		corruptedReachabilityData.SetInterval(&model.ReachabilityInterval{Start: 0, End: reachabilityData.Interval().End})
+		tc.ReachabilityDataStore().StageReachabilityData(stagingArea, tipHashes[1], corruptedReachabilityData)
+
+		tc.MultisetStore().Stage(stagingArea, model.VirtualBlockHash, multiset.New())
+		err = staging.CommitAllChanges(tc.DatabaseContext(), stagingArea)
+		if err != nil {
+			t.Fatalf("CommitAllChanges: %+v", err)
+		}
+
+		report = check(t, tc)
+		if report.OK {
+			t.Fatalf("The check of a corrupted database unexpectedly succeeded")
+		}
+		expectedFailedChecks := map[string]string{
+			"block-headers":           tipHashes[0].String(),
+			"ghostdag-data":           tipHashes[1].String(),
+			"reachability-intervals":  tipHashes[1].String(),
+			"virtual-utxo-commitment": model.VirtualBlockHash.String(),
+		}
+		for _, result := range report.Checks {
+			expectedSubject, shouldFail := expectedFailedChecks[result.Name]
+			if !shouldFail {
+				if !result.OK {
+					t.Fatalf("The %s check unexpectedly failed: %s", result.Name, failuresString(report))
+				}
+				continue
+			}
+			if result.OK {
+				t.Fatalf("The %s check unexpectedly succeeded", result.Name)
+			}
+			for _, failure := range result.Failures {
+				if failure.Subject != expectedSubject {
+					t.Fatalf("Unexpected failures of the %s check: %s", result.Name, failuresString(report))
+				}
+			}
+		}
+	})
+}
+
+func TestCheckResultMaxFailures(t *testing.T) {
+	result := newCheckResult("test", "test", testMaxFailures)
+	for i := 0; i < testMaxFailures+5; i++ {
+		result.addFailure("subject", "message")
+	}
+	if result.OK {
+		t.Fatalf("The result is unexpectedly OK")
+	}
+	if len(result.Failures) != testMaxFailures {
+		t.Fatalf("Unexpected number of failures. Want: %d, got: %d", testMaxFailures, len(result.Failures))
+	}
+	if result.OmittedFailureCount != 5 {
+		t.Fatalf("Unexpected number of omitted failures. Want: 5, got: %d", result.OmittedFailureCount)
+	}
+}
+
+func check(t *testing.T, tc testapi.TestConsensus) *Report {
+	report, err := Check(tc.Database(), &prefix.Prefix{}, testMaxFailures)
+	if err != nil {
+		t.Fatalf("Check: %+v", err)
+	}
+	return report
+}
+
+func failuresString(report *Report) string {
+	failures := ""
+	for _, result := range report.Checks {
+		for _, failure := range result.Failures {
+			failures += "\n" + result.Name + ": " + failure.Subject + ": " + failure.Message
+		}
+	}
+	return failures
+}
@@ -0,0 +1,87 @@
+package dbcheck
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+func (c *checker) checkReachabilityIntervals() (*CheckResult, error) {
+	result := newCheckResult("reachability-intervals",
+		"The reachability interval of every block in the reachability tree is nested in its parent's, "+
+			"and the intervals of siblings don't overlap", c.maxFailures)
+
+	stagingArea := model.NewStagingArea()
+	rootData, err := c.reachabilityDataStore.ReachabilityData(c.dbContext, stagingArea, model.VirtualGenesisBlockHash)
+	if database.IsNotFoundError(err) {
+		result.fail(model.VirtualGenesisBlockHash, "the root of the reachability tree has no reachability data")
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	type treeNode struct {
+		hash *externalapi.DomainHash
+		data model.ReachabilityData
+	}
+
+	// The tree is as deep as the selected chain, so it's traversed without recursion
+	visited := map[externalapi.DomainHash]struct{}{*model.VirtualGenesisBlockHash: {}}
+	stack := []*treeNode{{hash: model.VirtualGenesisBlockHash, data: rootData}}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result.CheckedCount++
+
+		interval := node.data.Interval()
+		// An empty interval ends right before it starts
+		if interval.Start > interval.End && interval.Start-interval.End != 1 {
+			result.fail(node.hash, "the reachability interval %s is invalid", interval)
+			continue
+		}
+
+		var previousChildInterval *model.ReachabilityInterval
+		for _, child := range node.data.Children() {
+			if _, ok := visited[*child]; ok {
+				result.fail(child, "the block appears more than once in the reachability tree")
+				continue
+			}
+			visited[*child] = struct{}{}
+
+			childData, err := c.reachabilityDataStore.ReachabilityData(c.dbContext, stagingArea, child)
+			if database.IsNotFoundError(err) {
+				result.fail(child, "the block is a reachability tree child of %s but has no reachability data",
+					node.hash)
+				continue
+			}
+			if err != nil {
+				result.fail(child, "failed to read the reachability data: %s", err)
+				continue
+			}
+
+			if childData.Parent() == nil || !childData.Parent().Equal(node.hash) {
+				result.fail(child, "the block is a reachability tree child of %s, but its reachability "+
+					"tree parent is %s", node.hash, childData.Parent())
+			}
+			childInterval := childData.Interval()
+			if !isNestedIn(childInterval, interval) {
+				result.fail(child, "the reachability interval %s isn't nested in the interval %s of "+
+					"its reachability tree parent %s", childInterval, interval, node.hash)
+			}
+			if previousChildInterval != nil && childInterval.Start <= previousChildInterval.End {
+				result.fail(child, "the reachability interval %s overlaps or precedes the interval %s "+
+					"of its previous sibling", childInterval, previousChildInterval)
+			}
+			previousChildInterval = childInterval
+
+			stack = append(stack, &treeNode{hash: child, data: childData})
+		}
+	}
+	return result, nil
+}
+
+// isNestedIn returns whether the given interval is contained in the given outer interval
+func isNestedIn(interval *model.ReachabilityInterval, outer *model.ReachabilityInterval) bool {
+	return interval.Start >= outer.Start && interval.End <= outer.End
+}
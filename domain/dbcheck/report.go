@@ -0,0 +1,67 @@
+package dbcheck
+
+import "fmt"
+
+// Report is the result of checking a database. It's meant to be written out as JSON
+type Report struct {
+	OK     bool           `json:"ok"`
+	Checks []*CheckResult `json:"checks"`
+}
+
+// CheckResult is the result of a single check
+type CheckResult struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	OK          bool   `json:"ok"`
+
+	// Skipped is set when the check doesn't apply to the database, e.g.
+	// when checking the UTXO index of a node that doesn't have one
+	Skipped bool `json:"skipped"`
+
+	// CheckedCount is the number of items the check went over, e.g. blocks or UTXOs
+	CheckedCount uint64 `json:"checkedCount"`
+
+	// Failures holds up to the maximum number of failures per check. The number of
+	// failures beyond that is kept in OmittedFailureCount
+	Failures            []*Failure `json:"failures"`
+	OmittedFailureCount uint64     `json:"omittedFailureCount"`
+
+	maxFailures int
+}
+
+// Failure is a single inconsistency found by a check
+type Failure struct {
+	// Subject is what the failure concerns, e.g. a block hash or an outpoint
+	Subject string `json:"subject"`
+	Message string `json:"message"`
+}
+
+func newCheckResult(name string, description string, maxFailures int) *CheckResult {
+	return &CheckResult{
+		Name:        name,
+		Description: description,
+		OK:          true,
+		Failures:    []*Failure{},
+		maxFailures: maxFailures,
+	}
+}
+
+func (r *CheckResult) fail(subject fmt.Stringer, format string, args ...interface{}) {
+	r.addFailure(subject.String(), fmt.Sprintf(format, args...))
+}
+
+func (r *CheckResult) addFailure(subject string, message string) {
+	r.OK = false
+	if len(r.Failures) >= r.maxFailures {
+		r.OmittedFailureCount++
+		return
+	}
+	r.Failures = append(r.Failures, &Failure{
+		Subject: subject,
+		Message: message,
+	})
+}
+
+func (r *CheckResult) skip() {
+	r.Skipped = true
+}
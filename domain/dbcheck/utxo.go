@@ -0,0 +1,75 @@
+package dbcheck
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/multiset"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/domain/utxoindex"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+func (c *checker) checkVirtualUTXOCommitment() (*CheckResult, error) {
+	result := newCheckResult("virtual-utxo-commitment",
+		"The multiset of the virtual UTXO set matches the stored multiset of the virtual", c.maxFailures)
+
+	stagingArea := model.NewStagingArea()
+	virtualUTXOSetIterator, err := c.consensusStateStore.VirtualUTXOSetIterator(c.dbContext, stagingArea)
+	if err != nil {
+		return nil, err
+	}
+	defer virtualUTXOSetIterator.Close()
+
+	virtualUTXOSetMultiset := multiset.New()
+	for ok := virtualUTXOSetIterator.First(); ok; ok = virtualUTXOSetIterator.Next() {
+		outpoint, entry, err := virtualUTXOSetIterator.Get()
+		if err != nil {
+			return nil, err
+		}
+		result.CheckedCount++
+		serializedUTXO, err := utxo.SerializeUTXO(entry, outpoint)
+		if err != nil {
+			return nil, err
+		}
+		virtualUTXOSetMultiset.Add(serializedUTXO)
+	}
+
+	virtualMultiset, err := c.multisetStore.Get(c.dbContext, stagingArea, model.VirtualBlockHash)
+	if database.IsNotFoundError(err) {
+		result.fail(model.VirtualBlockHash, "the virtual has no stored multiset")
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !virtualMultiset.Hash().Equal(virtualUTXOSetMultiset.Hash()) {
+		result.fail(model.VirtualBlockHash, "the stored multiset of the virtual is %s, but the virtual "+
+			"UTXO set hashes to %s", virtualMultiset.Hash(), virtualUTXOSetMultiset.Hash())
+	}
+	return result, nil
+}
+
+func (c *checker) checkUTXOIndex() (*CheckResult, error) {
+	result := newCheckResult("utxo-index",
+		"The UTXO index matches the virtual UTXO set", c.maxFailures)
+
+	stagingArea := model.NewStagingArea()
+	virtualRelations, err := c.blockRelationStore.BlockRelation(c.dbContext, stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	virtualUTXOSetIterator, err := c.consensusStateStore.VirtualUTXOSetIterator(c.dbContext, stagingArea)
+	if err != nil {
+		return nil, err
+	}
+
+	hasIndex, checkedCount, err := utxoindex.CheckAgainstVirtualUTXOSet(c.db, virtualRelations.Parents,
+		virtualUTXOSetIterator, result.addFailure)
+	if err != nil {
+		return nil, err
+	}
+	if !hasIndex {
+		result.skip()
+	}
+	result.CheckedCount = checkedCount
+	return result, nil
+}
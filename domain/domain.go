@@ -14,6 +14,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/prefixmanager"
 	"github.com/kaspanet/kaspad/domain/prefixmanager/prefix"
 	infrastructuredatabase "github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/dbsnapshot"
 	"github.com/pkg/errors"
 )
 
@@ -26,6 +27,11 @@ type Domain interface {
 	CommitStagingConsensus() error
 	DeleteStagingConsensus() error
 	ConsensusEventsChannel() chan externalapi.ConsensusEvent
+
+	// CreateSnapshot writes a consistent, point-in-time snapshot of the whole
+	// database, including the consensus and all the indexes, to a new file in
+	// the given path
+	CreateSnapshot(path string) (*dbsnapshot.Summary, error)
 }
 
 type domain struct {
@@ -2,12 +2,14 @@ package blocktemplatebuilder
 
 import (
 	"github.com/kaspanet/kaspad/domain/consensus/processes/coinbasemanager"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/merkle"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/transactionhelper"
 	"github.com/kaspanet/kaspad/domain/consensusreference"
 	"github.com/kaspanet/kaspad/util/mstime"
 	"math"
 	"sort"
+	"sync"
 
 	"github.com/kaspanet/kaspad/util/difficulty"
 
@@ -28,6 +30,28 @@ type candidateTx struct {
 	end   float64
 
 	isMarkedForDeletion bool
+
+	// packages are the candidate packages this transaction is a ready transaction of
+	packages []*candidatePackage
+}
+
+// candidatePackage is a transaction along with its ancestors that aren't in the DAG yet.
+// fee and mass are of the transactions of the package that weren't selected yet, so that
+// ancestors selected with another package no longer count towards its fee rate
+type candidatePackage struct {
+	readyTxs          []*candidateTx
+	fee               uint64
+	mass              uint64
+
+	// unselectedTxCount is the number of ready transactions that weren't selected yet
+	unselectedTxCount int
+
+	// heapIndex is the index of the package in its candidatePackageHeap
+	heapIndex int
+}
+
+func (cp *candidatePackage) feeRate() float64 {
+	return float64(cp.fee) / float64(cp.mass)
 }
 
 // blockTemplateBuilder creates block templates for a miner to consume
@@ -37,15 +61,23 @@ type blockTemplateBuilder struct {
 	policy             policy
 
 	coinbasePayloadScriptPublicKeyMaxLength uint8
+
+	lastBlockTemplateStats     *miningmanagerapi.BlockTemplateStats
+	lastBlockTemplateStatsLock sync.RWMutex
 }
 
 // New creates a new blockTemplateBuilder
 func New(consensusReference consensusreference.ConsensusReference, mempool miningmanagerapi.Mempool,
-	blockMaxMass uint64, coinbasePayloadScriptPublicKeyMaxLength uint8) miningmanagerapi.BlockTemplateBuilder {
+	blockMaxMass uint64, coinbasePayloadScriptPublicKeyMaxLength uint8,
+	randomizedTransactionSelection bool) miningmanagerapi.BlockTemplateBuilder {
+
 	return &blockTemplateBuilder{
 		consensusReference: consensusReference,
 		mempool:            mempool,
-		policy:             policy{BlockMaxMass: blockMaxMass},
+		policy: policy{
+			BlockMaxMass:                   blockMaxMass,
+			RandomizedTransactionSelection: randomizedTransactionSelection,
+		},
 
 		coinbasePayloadScriptPublicKeyMaxLength: coinbasePayloadScriptPublicKeyMaxLength,
 	}
@@ -118,30 +150,31 @@ func New(consensusReference consensusreference.ConsensusReference, mempool minin
 func (btb *blockTemplateBuilder) BuildBlockTemplate(
 	coinbaseData *consensusexternalapi.DomainCoinbaseData) (*consensusexternalapi.DomainBlockTemplate, error) {
 
-	mempoolTransactions := btb.mempool.BlockCandidateTransactions()
-	candidateTxs := make([]*candidateTx, 0, len(mempoolTransactions))
-	for _, tx := range mempoolTransactions {
-		// Calculate the tx value
-		gasLimit := uint64(0)
-		if !subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
-			panic("We currently don't support non native subnetworks")
+	transactionPackages := btb.mempool.BlockCandidatePackages()
+	candidatePackages, candidateTxsByID := btb.newCandidatePackages(transactionPackages)
+
+	var blockTxs selectedTransactions
+	if btb.policy.RandomizedTransactionSelection {
+		candidateTxs := make([]*candidateTx, 0, len(candidateTxsByID))
+		for _, candidate := range candidateTxsByID {
+			candidateTxs = append(candidateTxs, candidate)
 		}
-		candidateTxs = append(candidateTxs, &candidateTx{
-			DomainTransaction: tx,
-			txValue:           btb.calcTxValue(tx),
-			gasLimit:          gasLimit,
+
+		// Sort the candidate txs by subnetworkID.
+		sort.Slice(candidateTxs, func(i, j int) bool {
+			return subnetworks.Less(candidateTxs[i].SubnetworkID, candidateTxs[j].SubnetworkID)
 		})
-	}
 
-	// Sort the candidate txs by subnetworkID.
-	sort.Slice(candidateTxs, func(i, j int) bool {
-		return subnetworks.Less(candidateTxs[i].SubnetworkID, candidateTxs[j].SubnetworkID)
-	})
+		log.Debugf("Considering %d transactions for inclusion to new block",
+			len(candidateTxs))
 
-	log.Debugf("Considering %d transactions for inclusion to new block",
-		len(candidateTxs))
+		blockTxs = btb.selectTransactions(candidateTxs)
+	} else {
+		log.Debugf("Considering %d transaction packages of %d transactions for inclusion to new block",
+			len(candidatePackages), len(candidateTxsByID))
 
-	blockTxs := btb.selectTransactions(candidateTxs)
+		blockTxs = btb.selectPackages(candidatePackages)
+	}
 	blockTemplate, err := btb.consensusReference.Consensus().BuildBlockTemplate(coinbaseData, blockTxs.selectedTxs)
 
 	invalidTxsErr := ruleerrors.ErrInvalidTransactionsInNewBlock{}
@@ -169,6 +202,15 @@ func (btb *blockTemplateBuilder) BuildBlockTemplate(
 	log.Debugf("Created new block template (%d transactions, %d in fees, %d mass, target difficulty %064x)",
 		len(blockTemplate.Block.Transactions), blockTxs.totalFees, blockTxs.totalMass, difficulty.CompactToBig(blockTemplate.Block.Header.Bits()))
 
+	btb.lastBlockTemplateStatsLock.Lock()
+	btb.lastBlockTemplateStats = &miningmanagerapi.BlockTemplateStats{
+		TransactionCount: len(blockTxs.selectedTxs),
+		TotalMass:        blockTxs.totalMass,
+		TotalFees:        blockTxs.totalFees,
+		IsMassLimited:    blockTxs.isMassLimited,
+	}
+	btb.lastBlockTemplateStatsLock.Unlock()
+
 	return blockTemplate, nil
 }
 
@@ -205,14 +247,68 @@ func (btb *blockTemplateBuilder) ModifyBlockTemplate(newCoinbaseData *consensuse
 	return blockTemplateToModify, nil
 }
 
-// calcTxValue calculates a value to be used in transaction selection.
+// LastBlockTemplateStats returns the stats of the most recently built block template,
+// or nil if no block template was built yet
+func (btb *blockTemplateBuilder) LastBlockTemplateStats() *miningmanagerapi.BlockTemplateStats {
+	btb.lastBlockTemplateStatsLock.RLock()
+	defer btb.lastBlockTemplateStatsLock.RUnlock()
+
+	return btb.lastBlockTemplateStats
+}
+
+// newCandidatePackages converts the given transaction packages to candidate packages. Packages that
+// share ready transactions share the same candidateTx instances of them
+func (btb *blockTemplateBuilder) newCandidatePackages(transactionPackages []*miningmanagerapi.TransactionPackage) (
+	[]*candidatePackage, map[consensusexternalapi.DomainTransactionID]*candidateTx) {
+
+	candidatePackages := make([]*candidatePackage, 0, len(transactionPackages))
+	candidateTxsByID := make(map[consensusexternalapi.DomainTransactionID]*candidateTx)
+	for _, transactionPackage := range transactionPackages {
+		readyTxs := make([]*candidateTx, 0, len(transactionPackage.ReadyTransactions))
+		for _, tx := range transactionPackage.ReadyTransactions {
+			txID := *consensushashing.TransactionID(tx)
+			candidate, ok := candidateTxsByID[txID]
+			if !ok {
+				gasLimit := uint64(0)
+				if !subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
+					panic("We currently don't support non native subnetworks")
+				}
+				candidate = &candidateTx{
+					DomainTransaction: tx,
+					gasLimit:          gasLimit,
+				}
+				candidateTxsByID[txID] = candidate
+			}
+
+			// Calculate the tx value. A transaction is as valuable as the best package it's
+			// a part of, so that a high-fee transaction pays for its low-fee ancestors
+			txValue := btb.calcTxValue(tx, transactionPackage.Fee, transactionPackage.Mass)
+			if txValue > candidate.txValue {
+				candidate.txValue = txValue
+			}
+			readyTxs = append(readyTxs, candidate)
+		}
+		newCandidatePackage := &candidatePackage{
+			readyTxs:          readyTxs,
+			fee:               transactionPackage.Fee,
+			mass:              transactionPackage.Mass,
+			unselectedTxCount: len(readyTxs),
+		}
+		for _, candidate := range readyTxs {
+			candidate.packages = append(candidate.packages, newCandidatePackage)
+		}
+		candidatePackages = append(candidatePackages, newCandidatePackage)
+	}
+	return candidatePackages, candidateTxsByID
+}
+
+// calcTxValue calculates a value to be used in transaction selection, given
+// the fee and mass of a package the transaction is a part of.
 // The higher the number the more likely it is that the transaction will be
 // included in the block.
-func (btb *blockTemplateBuilder) calcTxValue(tx *consensusexternalapi.DomainTransaction) float64 {
+func (btb *blockTemplateBuilder) calcTxValue(tx *consensusexternalapi.DomainTransaction, fee uint64, mass uint64) float64 {
 	massLimit := btb.policy.BlockMaxMass
 
-	mass := tx.Mass
-	fee := tx.Fee
 	if subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
 		return float64(fee) / (float64(mass) / float64(massLimit))
 	}
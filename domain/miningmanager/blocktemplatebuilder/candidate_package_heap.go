@@ -0,0 +1,72 @@
+package blocktemplatebuilder
+
+import (
+	"container/heap"
+)
+
+// candidatePackageHeap is an implementation of heap.Interface that keeps the candidate
+// package with the highest fee rate at its top. Among packages with the same fee rate,
+// the one with the fewest unselected transactions is on top
+type candidatePackageHeap []*candidatePackage
+
+func (h candidatePackageHeap) Len() int { return len(h) }
+
+func (h candidatePackageHeap) Less(i, j int) bool {
+	feeRateI, feeRateJ := h[i].feeRate(), h[j].feeRate()
+	if feeRateI != feeRateJ {
+		return feeRateI > feeRateJ
+	}
+	return h[i].unselectedTxCount < h[j].unselectedTxCount
+}
+
+func (h candidatePackageHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIndex = i
+	h[j].heapIndex = j
+}
+
+func (h *candidatePackageHeap) Push(x interface{}) {
+	candidatePackage := x.(*candidatePackage)
+	candidatePackage.heapIndex = len(*h)
+	*h = append(*h, candidatePackage)
+}
+
+func (h *candidatePackageHeap) Pop() interface{} {
+	oldHeap := *h
+	oldLength := len(oldHeap)
+	popped := oldHeap[oldLength-1]
+	oldHeap[oldLength-1] = nil
+	popped.heapIndex = -1
+	*h = oldHeap[:oldLength-1]
+	return popped
+}
+
+// newCandidatePackageHeap returns a candidatePackageHeap of the given packages
+func newCandidatePackageHeap(candidatePackages []*candidatePackage) *candidatePackageHeap {
+	h := make(candidatePackageHeap, len(candidatePackages))
+	for i, candidatePackage := range candidatePackages {
+		candidatePackage.heapIndex = i
+		h[i] = candidatePackage
+	}
+	heap.Init(&h)
+	return &h
+}
+
+// removeSelectedTx updates the fee, mass and position of every package that contains
+// the given transaction, which was just selected with another package. Packages that
+// have no transactions left to select are removed from the heap
+func (h *candidatePackageHeap) removeSelectedTx(selectedTx *candidateTx) {
+	for _, candidatePackage := range selectedTx.packages {
+		if candidatePackage.heapIndex < 0 {
+			continue
+		}
+		candidatePackage.fee -= selectedTx.Fee
+		candidatePackage.mass -= selectedTx.Mass
+		candidatePackage.unselectedTxCount--
+		if candidatePackage.unselectedTxCount == 0 {
+			heap.Remove(h, candidatePackage.heapIndex)
+			continue
+		}
+		heap.Fix(h, candidatePackage.heapIndex)
+	}
+}
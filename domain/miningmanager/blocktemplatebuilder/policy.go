@@ -11,4 +11,8 @@ type policy struct {
 	// BlockMaxMass is the maximum block mass to be used when generating a
 	// block template.
 	BlockMaxMass uint64
+
+	// RandomizedTransactionSelection selects transactions at random, weighted by
+	// their package fee rates, instead of greedily by package fee rate.
+	RandomizedTransactionSelection bool
 }
@@ -1,6 +1,7 @@
 package blocktemplatebuilder
 
 import (
+	"container/heap"
 	"math"
 	"math/rand"
 	"sort"
@@ -33,6 +34,8 @@ type selectedTransactions struct {
 	txFees      []uint64
 	totalMass   uint64
 	totalFees   uint64
+
+	isMassLimited bool
 }
 
 // selectTransactions implements a probabilistic transaction selection algorithm.
@@ -105,6 +108,7 @@ func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx)
 			txsForBlockTemplate.totalMass+selectedTx.Mass > btb.policy.BlockMaxMass {
 			log.Tracef("Tx %s would exceed the max block mass. "+
 				"As such, stopping.", consensushashing.TransactionID(tx))
+			txsForBlockTemplate.isMassLimited = true
 			break
 		}
 
@@ -163,6 +167,74 @@ func (btb *blockTemplateBuilder) selectTransactions(candidateTxs []*candidateTx)
 	return txsForBlockTemplate
 }
 
+// selectPackages greedily selects the candidate packages with the highest fee rates,
+// and appends their ready transactions to the ones that will be included in the next
+// block. A package is selected only if all of its ready transactions fit in the block
+// along with the previously selected ones, but smaller packages with lower fee rates
+// may still be selected after it's skipped.
+// Ready transactions may be shared by several packages, so once a package is selected,
+// the fee rates of the packages it shares transactions with are recalculated without them.
+func (btb *blockTemplateBuilder) selectPackages(candidatePackages []*candidatePackage) selectedTransactions {
+	txsForBlockTemplate := selectedTransactions{
+		selectedTxs: make([]*consensusexternalapi.DomainTransaction, 0, len(candidatePackages)),
+		txMasses:    make([]uint64, 0, len(candidatePackages)),
+		txFees:      make([]uint64, 0, len(candidatePackages)),
+		totalMass:   0,
+		totalFees:   0,
+	}
+
+	packageHeap := newCandidatePackageHeap(candidatePackages)
+	selectedTxs := make([]*candidateTx, 0)
+	for packageHeap.Len() > 0 {
+		candidatePackage := heap.Pop(packageHeap).(*candidatePackage)
+
+		packageTxs := make([]*candidateTx, 0, candidatePackage.unselectedTxCount)
+		packageMass := uint64(0)
+		for _, candidateTx := range candidatePackage.readyTxs {
+			if candidateTx.isMarkedForDeletion {
+				continue
+			}
+			packageTxs = append(packageTxs, candidateTx)
+			packageMass += candidateTx.Mass
+		}
+
+		// Enforce maximum transaction mass per block. Also check
+		// for overflow.
+		if txsForBlockTemplate.totalMass+packageMass < txsForBlockTemplate.totalMass ||
+			txsForBlockTemplate.totalMass+packageMass > btb.policy.BlockMaxMass {
+			log.Tracef("Package of %d txs would exceed the max block mass. "+
+				"As such, skipping it.", len(packageTxs))
+			txsForBlockTemplate.isMassLimited = true
+			continue
+		}
+
+		// Only native transactions are currently supported, so there's no
+		// gas to enforce here.
+		packageFeeRate := candidatePackage.feeRate()
+		for _, candidateTx := range packageTxs {
+			selectedTxs = append(selectedTxs, candidateTx)
+			txsForBlockTemplate.totalMass += candidateTx.Mass
+			txsForBlockTemplate.totalFees += candidateTx.Fee
+			candidateTx.isMarkedForDeletion = true
+			packageHeap.removeSelectedTx(candidateTx)
+
+			log.Tracef("Adding tx %s (feePerMegaGram %d, package feePerMegaGram %d)",
+				consensushashing.TransactionID(candidateTx.DomainTransaction), candidateTx.Fee*1e6/candidateTx.Mass,
+				uint64(packageFeeRate*1e6))
+		}
+	}
+
+	sort.Slice(selectedTxs, func(i, j int) bool {
+		return subnetworks.Less(selectedTxs[i].SubnetworkID, selectedTxs[j].SubnetworkID)
+	})
+	for _, selectedTx := range selectedTxs {
+		txsForBlockTemplate.selectedTxs = append(txsForBlockTemplate.selectedTxs, selectedTx.DomainTransaction)
+		txsForBlockTemplate.txMasses = append(txsForBlockTemplate.txMasses, selectedTx.Mass)
+		txsForBlockTemplate.txFees = append(txsForBlockTemplate.txFees, selectedTx.Fee)
+	}
+	return txsForBlockTemplate
+}
+
 func rebalanceCandidates(oldCandidateTxs []*candidateTx, isFirstRun bool) (
 	candidateTxs []*candidateTx, totalP float64) {
 
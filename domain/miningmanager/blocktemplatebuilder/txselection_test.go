@@ -0,0 +1,64 @@
+package blocktemplatebuilder
+
+import (
+	"testing"
+
+	consensusexternalapi "github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/subnetworks"
+	miningmanagerapi "github.com/kaspanet/kaspad/domain/miningmanager/model"
+)
+
+func TestSelectPackages(t *testing.T) {
+	newTransaction := func(id byte, fee uint64) *consensusexternalapi.DomainTransaction {
+		return &consensusexternalapi.DomainTransaction{
+			Inputs:       []*consensusexternalapi.DomainTransactionInput{},
+			Outputs:      []*consensusexternalapi.DomainTransactionOutput{},
+			SubnetworkID: subnetworks.SubnetworkIDNative,
+			Payload:      []byte{id},
+			Fee:          fee,
+			Mass:         1000,
+		}
+	}
+
+	// child spends both highFeeParent and lowFeeParent, so it isn't ready yet, but its package
+	// pays for both of them. Once highFeeParent is selected, the rest of the child's package
+	// pays less than independentTx
+	highFeeParent := newTransaction(0, 300_000)
+	lowFeeParent := newTransaction(1, 1_000)
+	child := newTransaction(2, 10_000)
+	independentTx := newTransaction(3, 50_000)
+	transactionPackages := []*miningmanagerapi.TransactionPackage{
+		{ReadyTransactions: []*consensusexternalapi.DomainTransaction{highFeeParent}, Fee: highFeeParent.Fee, Mass: 1000},
+		{ReadyTransactions: []*consensusexternalapi.DomainTransaction{lowFeeParent}, Fee: lowFeeParent.Fee, Mass: 1000},
+		{
+			ReadyTransactions: []*consensusexternalapi.DomainTransaction{highFeeParent, lowFeeParent},
+			Fee:               highFeeParent.Fee + lowFeeParent.Fee + child.Fee,
+			Mass:              3000,
+		},
+		{ReadyTransactions: []*consensusexternalapi.DomainTransaction{independentTx}, Fee: independentTx.Fee, Mass: 1000},
+	}
+
+	btb := &blockTemplateBuilder{policy: policy{BlockMaxMass: 2000}}
+	candidatePackages, _ := btb.newCandidatePackages(transactionPackages)
+	selected := btb.selectPackages(candidatePackages)
+
+	if len(selected.selectedTxs) != 2 || !containsTransaction(selected.selectedTxs, highFeeParent) ||
+		!containsTransaction(selected.selectedTxs, independentTx) {
+		t.Fatalf("Expected the high fee parent and the independent transaction to be selected, "+
+			"but got %d transactions with a total fee of %d", len(selected.selectedTxs), selected.totalFees)
+	}
+	if !selected.isMassLimited {
+		t.Fatalf("Expected the selection to be mass limited")
+	}
+}
+
+func containsTransaction(transactions []*consensusexternalapi.DomainTransaction,
+	transaction *consensusexternalapi.DomainTransaction) bool {
+
+	for _, candidate := range transactions {
+		if candidate == transaction {
+			return true
+		}
+	}
+	return false
+}
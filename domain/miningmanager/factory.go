@@ -21,7 +21,8 @@ func (f *factory) NewMiningManager(consensusReference consensusreference.Consens
 	mempoolConfig *mempoolpkg.Config) MiningManager {
 
 	mempool := mempoolpkg.New(mempoolConfig, consensusReference)
-	blockTemplateBuilder := blocktemplatebuilder.New(consensusReference, mempool, params.MaxBlockMass, params.CoinbasePayloadScriptPublicKeyMaxLength)
+	blockTemplateBuilder := blocktemplatebuilder.New(consensusReference, mempool, params.MaxBlockMass,
+		params.CoinbasePayloadScriptPublicKeyMaxLength, mempoolConfig.RandomizedBlockTemplateSelection)
 
 	return &miningManager{
 		consensusReference:   consensusReference,
@@ -29,6 +30,10 @@ func (f *factory) NewMiningManager(consensusReference consensusreference.Consens
 		blockTemplateBuilder: blockTemplateBuilder,
 		cachingTime:          time.Time{},
 		cacheLock:            &sync.Mutex{},
+
+		targetTimePerBlock:         params.TargetTimePerBlock,
+		maxBlockMass:               params.MaxBlockMass,
+		minimumRelayTransactionFee: mempoolConfig.MinimumRelayTransactionFee,
 	}
 }
 
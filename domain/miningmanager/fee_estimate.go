@@ -0,0 +1,109 @@
+package miningmanager
+
+import (
+	"math"
+	"time"
+
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+)
+
+// The time frames in which transactions paying the estimated fee rates are expected to be
+// included in a block. The priority bucket always targets the next block.
+var (
+	normalBucketTargetTimes = []time.Duration{10 * time.Second, 30 * time.Second, time.Minute}
+	lowBucketTargetTimes    = []time.Duration{10 * time.Minute, time.Hour}
+)
+
+// typicalTransactionMass is the mass of a transaction that spends a single P2PK input into
+// two P2PK outputs. The fee rates are estimated for a transaction of this mass
+const typicalTransactionMass = 2036
+
+// GetFeeEstimate estimates the fee rates required for a transaction to be included
+// in a block within several time frames, according to the transactions currently
+// in the mempool
+func (mm *miningManager) GetFeeEstimate() *miningmanagermodel.FeeEstimate {
+	massPerBlock := mm.maxBlockMass
+	lastBlockTemplateStats := mm.blockTemplateBuilder.LastBlockTemplateStats()
+	if lastBlockTemplateStats != nil && lastBlockTemplateStats.IsMassLimited && lastBlockTemplateStats.TotalMass > 0 {
+		// A full block template tells us how much transaction mass actually fits in a block
+		massPerBlock = lastBlockTemplateStats.TotalMass
+	}
+
+	// minimumRelayTransactionFee is in sompi per 1000 grams
+	minimumFeeRate := float64(mm.minimumRelayTransactionFee) / 1000
+
+	return estimateFees(mm.mempool.TransactionFeeRates(), massPerBlock, mm.targetTimePerBlock, minimumFeeRate,
+		typicalTransactionMass)
+}
+
+// estimateFees builds a FeeEstimate for a transaction of the given mass out of the given transaction
+// fee rates, which are expected to be ordered from the highest fee rate to the lowest
+func estimateFees(transactionFeeRates []miningmanagermodel.TransactionFeeRate, massPerBlock uint64,
+	targetTimePerBlock time.Duration, minimumFeeRate float64, transactionMass uint64) *miningmanagermodel.FeeEstimate {
+
+	// A transaction larger than a block never fits, so it's estimated as if it filled a whole block
+	if transactionMass > massPerBlock {
+		transactionMass = massPerBlock
+	}
+	bucket := func(targetTime time.Duration) miningmanagermodel.FeeRateBucket {
+		return estimateFeeRateBucket(transactionFeeRates, massPerBlock, targetTimePerBlock, minimumFeeRate,
+			transactionMass, targetTime)
+	}
+
+	feeEstimate := &miningmanagermodel.FeeEstimate{
+		PriorityBucket: bucket(targetTimePerBlock),
+		NormalBuckets:  make([]miningmanagermodel.FeeRateBucket, len(normalBucketTargetTimes)),
+		LowBuckets:     make([]miningmanagermodel.FeeRateBucket, len(lowBucketTargetTimes)),
+	}
+	for i, targetTime := range normalBucketTargetTimes {
+		feeEstimate.NormalBuckets[i] = bucket(targetTime)
+	}
+	for i, targetTime := range lowBucketTargetTimes {
+		feeEstimate.LowBuckets[i] = bucket(targetTime)
+	}
+	return feeEstimate
+}
+
+// estimateFeeRateBucket finds the lowest fee rate that outbids enough of the given transactions
+// for a transaction of transactionMass paying it to fit within the blocks mined during targetTime
+func estimateFeeRateBucket(transactionFeeRates []miningmanagermodel.TransactionFeeRate, massPerBlock uint64,
+	targetTimePerBlock time.Duration, minimumFeeRate float64, transactionMass uint64,
+	targetTime time.Duration) miningmanagermodel.FeeRateBucket {
+
+	blockCount := uint64(targetTime / targetTimePerBlock)
+	if blockCount == 0 {
+		blockCount = 1
+	}
+	capacity := blockCount * massPerBlock
+
+	// The first transaction after which there's no room left for the new transaction has to be
+	// outbid. Paying the same fee rate would only tie with it, so the bid is strictly above it
+	feeRate := minimumFeeRate
+	cumulativeMass := uint64(0)
+	for _, transactionFeeRate := range transactionFeeRates {
+		cumulativeMass += transactionFeeRate.Mass
+		if cumulativeMass+transactionMass > capacity {
+			outbiddingFeeRate := math.Nextafter(transactionFeeRate.FeeRate, math.Inf(1))
+			if outbiddingFeeRate > feeRate {
+				feeRate = outbiddingFeeRate
+			}
+			break
+		}
+	}
+	massAhead := uint64(0)
+	for _, transactionFeeRate := range transactionFeeRates {
+		if transactionFeeRate.FeeRate < feeRate {
+			break
+		}
+		massAhead += transactionFeeRate.Mass
+	}
+
+	blocksUntilInclusion := (massAhead + transactionMass + massPerBlock - 1) / massPerBlock
+	if blocksUntilInclusion == 0 {
+		blocksUntilInclusion = 1
+	}
+	return miningmanagermodel.FeeRateBucket{
+		FeeRate:          feeRate,
+		EstimatedSeconds: (time.Duration(blocksUntilInclusion) * targetTimePerBlock).Seconds(),
+	}
+}
@@ -0,0 +1,58 @@
+package miningmanager
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+)
+
+func TestEstimateFees(t *testing.T) {
+	const massPerBlock = 1000
+	const targetTimePerBlock = time.Second
+	const minimumFeeRate = 1.0
+	const transactionMass = 100
+
+	// An empty mempool means that any transaction paying the minimum fee rate makes it into the next block
+	feeEstimate := estimateFees(nil, massPerBlock, targetTimePerBlock, minimumFeeRate, transactionMass)
+	allBuckets := append([]miningmanagermodel.FeeRateBucket{feeEstimate.PriorityBucket},
+		append(feeEstimate.NormalBuckets, feeEstimate.LowBuckets...)...)
+	for i, bucket := range allBuckets {
+		if bucket.FeeRate != minimumFeeRate || bucket.EstimatedSeconds != 1 {
+			t.Fatalf("bucket %d: unexpected bucket for an empty mempool: %+v", i, bucket)
+		}
+	}
+
+	// 30 transactions of mass 100, paying fee rates 30 down to 1, fill three blocks
+	transactionFeeRates := make([]miningmanagermodel.TransactionFeeRate, 30)
+	for i := range transactionFeeRates {
+		transactionFeeRates[i] = miningmanagermodel.TransactionFeeRate{FeeRate: float64(30 - i), Mass: 100}
+	}
+	feeEstimate = estimateFees(transactionFeeRates, massPerBlock, targetTimePerBlock, minimumFeeRate, transactionMass)
+
+	// Only nine transactions fit in the next block along with the new one, so the transaction paying
+	// a fee rate of 21 has to be outbid, and paying exactly 21 would only tie with it
+	expectedPriorityBucket := miningmanagermodel.FeeRateBucket{FeeRate: math.Nextafter(21, math.Inf(1)), EstimatedSeconds: 1}
+	if feeEstimate.PriorityBucket != expectedPriorityBucket {
+		t.Fatalf("unexpected priority bucket. Want: %+v, got: %+v", expectedPriorityBucket, feeEstimate.PriorityBucket)
+	}
+	if feeEstimate.PriorityBucket.FeeRate <= 21 {
+		t.Fatalf("the priority bucket doesn't outbid the last transaction in the next block: %+v",
+			feeEstimate.PriorityBucket)
+	}
+
+	// All the transactions fit within ten seconds, along with the new one after them
+	expectedNormalBucket := miningmanagermodel.FeeRateBucket{FeeRate: minimumFeeRate, EstimatedSeconds: 4}
+	if len(feeEstimate.NormalBuckets) != len(normalBucketTargetTimes) {
+		t.Fatalf("unexpected amount of normal buckets: %d", len(feeEstimate.NormalBuckets))
+	}
+	for i, bucket := range feeEstimate.NormalBuckets {
+		if bucket != expectedNormalBucket {
+			t.Fatalf("unexpected normal bucket %d. Want: %+v, got: %+v", i, expectedNormalBucket, bucket)
+		}
+	}
+	if len(feeEstimate.LowBuckets) != len(lowBucketTargetTimes) {
+		t.Fatalf("unexpected amount of low buckets: %d", len(feeEstimate.LowBuckets))
+	}
+}
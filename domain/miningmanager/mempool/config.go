@@ -23,6 +23,10 @@ const (
 	// removeOrphans when removeRedeemers = true
 	defaultMaximumOrphanTransactionCount = 50
 
+	// defaultMaximumReplacedTransactionCount specifies the maximum number of transactions, including
+	// their descendants, that a single replace-by-fee transaction may evict from the mempool
+	defaultMaximumReplacedTransactionCount = 100
+
 	// defaultMinimumRelayTransactionFee specifies the minimum transaction fee for a transaction to be accepted to
 	// the mempool and relayed. It is specified in sompi per 1kg (or 1000 grams) of transaction mass.
 	defaultMinimumRelayTransactionFee = util.Amount(1000)
@@ -45,11 +49,16 @@ type Config struct {
 	OrphanExpireScanIntervalDAAScore      uint64
 	MaximumOrphanTransactionMass          uint64
 	MaximumOrphanTransactionCount         uint64
+	MaximumReplacedTransactionCount       uint64
 	AcceptNonStandard                     bool
 	MaximumMassPerBlock                   uint64
 	MinimumRelayTransactionFee            util.Amount
 	MinimumStandardTransactionVersion     uint16
 	MaximumStandardTransactionVersion     uint16
+
+	// RandomizedBlockTemplateSelection makes the block template builder select mempool transactions
+	// at random, weighted by fee rate, instead of greedily by transaction package fee rate
+	RandomizedBlockTemplateSelection bool
 }
 
 // DefaultConfig returns the default mempool configuration
@@ -65,6 +74,7 @@ func DefaultConfig(dagParams *dagconfig.Params) *Config {
 		OrphanExpireScanIntervalDAAScore:      uint64(float64(defaultOrphanExpireScanIntervalSeconds) / targetBlocksPerSecond),
 		MaximumOrphanTransactionMass:          defaultMaximumOrphanTransactionMass,
 		MaximumOrphanTransactionCount:         defaultMaximumOrphanTransactionCount,
+		MaximumReplacedTransactionCount:       defaultMaximumReplacedTransactionCount,
 		AcceptNonStandard:                     dagParams.RelayNonStdTxs,
 		MaximumMassPerBlock:                   dagParams.MaxBlockMass,
 		MinimumRelayTransactionFee:            defaultMinimumRelayTransactionFee,
@@ -50,6 +50,7 @@ const (
 	RejectFinality        RejectCode = 0x43
 	RejectDifficulty      RejectCode = 0x44
 	RejectImmatureSpend   RejectCode = 0x45
+	RejectReplacement     RejectCode = 0x46
 	RejectBadOrphan       RejectCode = 0x64
 )
 
@@ -66,6 +67,7 @@ var rejectCodeStrings = map[RejectCode]string{
 	RejectDifficulty:      "REJECT_DIFFICULTY",
 	RejectNotRequested:    "REJECT_NOT_REQUESTED",
 	RejectImmatureSpend:   "REJECT_IMMATURE_SPEND",
+	RejectReplacement:     "REJECT_REPLACEMENT",
 	RejectBadOrphan:       "REJECT_BAD_ORPHAN",
 }
 
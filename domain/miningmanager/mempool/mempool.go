@@ -12,12 +12,19 @@ import (
 type mempool struct {
 	mtx sync.RWMutex
 
+	// saveMtx serializes saves to mempool files, so that concurrent saves can't
+	// replace a mempool file with an older one
+	saveMtx sync.Mutex
+
 	config             *Config
 	consensusReference consensusreference.ConsensusReference
 
 	mempoolUTXOSet   *mempoolUTXOSet
 	transactionsPool *transactionsPool
 	orphansPool      *orphansPool
+
+	onMempoolChangedHandler miningmanagermodel.OnMempoolChangedHandler
+	pendingChanges          []*miningmanagermodel.MempoolChange
 }
 
 // New constructs a new mempool
@@ -39,10 +46,21 @@ func (mp *mempool) ValidateAndInsertTransaction(transaction *externalapi.DomainT
 
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.dispatchPendingChanges()
 
 	return mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
 }
 
+func (mp *mempool) ValidateAndReplaceTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+	acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	defer mp.dispatchPendingChanges()
+
+	return mp.validateAndReplaceTransaction(transaction, isHighPriority)
+}
+
 func (mp *mempool) GetTransaction(transactionID *externalapi.DomainTransactionID,
 	includeTransactionPool bool,
 	includeOrphanPool bool) (
@@ -128,25 +146,41 @@ func (mp *mempool) TransactionCount(includeTransactionPool bool, includeOrphanPo
 	return transactionCount
 }
 
+func (mp *mempool) TransactionPoolMass() uint64 {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.transactionsPool.transactionsMass()
+}
+
+func (mp *mempool) TransactionFeeRates() []miningmanagermodel.TransactionFeeRate {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.transactionsPool.transactionFeeRates()
+}
+
 func (mp *mempool) HandleNewBlockTransactions(transactions []*externalapi.DomainTransaction) (
 	acceptedOrphans []*externalapi.DomainTransaction, err error) {
 
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.dispatchPendingChanges()
 
 	return mp.handleNewBlockTransactions(transactions)
 }
 
-func (mp *mempool) BlockCandidateTransactions() []*externalapi.DomainTransaction {
+func (mp *mempool) BlockCandidatePackages() []*miningmanagermodel.TransactionPackage {
 	mp.mtx.RLock()
 	defer mp.mtx.RUnlock()
 
-	return mp.transactionsPool.allReadyTransactions()
+	return mp.transactionsPool.allTransactionPackages()
 }
 
 func (mp *mempool) RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error) {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.dispatchPendingChanges()
 
 	return mp.revalidateHighPriorityTransactions()
 }
@@ -154,13 +188,40 @@ func (mp *mempool) RevalidateHighPriorityTransactions() (validTransactions []*ex
 func (mp *mempool) RemoveTransactions(transactions []*externalapi.DomainTransaction, removeRedeemers bool) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.dispatchPendingChanges()
 
-	return mp.removeTransactions(transactions, removeRedeemers)
+	return mp.removeTransactions(transactions, removeRedeemers, miningmanagermodel.MempoolRemovalReasonEvicted)
 }
 
 func (mp *mempool) RemoveTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	defer mp.dispatchPendingChanges()
+
+	return mp.removeTransaction(transactionID, removeRedeemers, miningmanagermodel.MempoolRemovalReasonEvicted)
+}
+
+func (mp *mempool) SaveToFile(filePath string) (int, error) {
+	mp.saveMtx.Lock()
+	defer mp.saveMtx.Unlock()
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.saveToFile(filePath)
+}
+
+func (mp *mempool) LoadFromFile(filePath string) (int, error) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	defer mp.dispatchPendingChanges()
+
+	return mp.loadFromFile(filePath)
+}
+
+func (mp *mempool) SetOnMempoolChangedHandler(onMempoolChangedHandler miningmanagermodel.OnMempoolChangedHandler) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
 
-	return mp.removeTransaction(transactionID, removeRedeemers)
+	mp.onMempoolChangedHandler = onMempoolChangedHandler
 }
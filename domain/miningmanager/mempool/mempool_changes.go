@@ -0,0 +1,41 @@
+package mempool
+
+import (
+	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+)
+
+func (mp *mempool) recordTransactionAccepted(transaction *model.MempoolTransaction) {
+	if mp.onMempoolChangedHandler == nil {
+		return
+	}
+	mp.pendingChanges = append(mp.pendingChanges, &miningmanagermodel.MempoolChange{
+		Transaction: transaction.Transaction(),
+		IsRemoved:   false,
+	})
+}
+
+func (mp *mempool) recordTransactionRemoved(transaction *model.MempoolTransaction,
+	reason miningmanagermodel.MempoolRemovalReason) {
+
+	if mp.onMempoolChangedHandler == nil {
+		return
+	}
+	mp.pendingChanges = append(mp.pendingChanges, &miningmanagermodel.MempoolChange{
+		Transaction:   transaction.Transaction(),
+		IsRemoved:     true,
+		RemovalReason: reason,
+	})
+}
+
+// dispatchPendingChanges passes all the changes recorded since the last call to the
+// onMempoolChanged handler. It must be called while the mempool is still locked,
+// so that the handler receives changes in the same order they were made.
+func (mp *mempool) dispatchPendingChanges() {
+	if len(mp.pendingChanges) == 0 {
+		return
+	}
+	changes := mp.pendingChanges
+	mp.pendingChanges = nil
+	mp.onMempoolChangedHandler(changes)
+}
@@ -80,3 +80,22 @@ func (mpus *mempoolUTXOSet) checkDoubleSpends(transaction *externalapi.DomainTra
 
 	return nil
 }
+
+// conflictingTransactions returns the transactions in the mempool that spend any of
+// the outpoints the given transaction spends
+func (mpus *mempoolUTXOSet) conflictingTransactions(transaction *externalapi.DomainTransaction) []*model.MempoolTransaction {
+	conflictingTransactions := []*model.MempoolTransaction{}
+	seen := map[externalapi.DomainTransactionID]struct{}{}
+	for _, input := range transaction.Inputs {
+		existingTransaction, exists := mpus.transactionByPreviousOutpoint[input.PreviousOutpoint]
+		if !exists {
+			continue
+		}
+		if _, ok := seen[*existingTransaction.TransactionID()]; ok {
+			continue
+		}
+		seen[*existingTransaction.TransactionID()] = struct{}{}
+		conflictingTransactions = append(conflictingTransactions, existingTransaction)
+	}
+	return conflictingTransactions
+}
@@ -11,6 +11,13 @@ type MempoolTransaction struct {
 	parentTransactionsInPool IDToTransactionMap
 	isHighPriority           bool
 	addedAtDAAScore          uint64
+
+	// packageFee, packageMass and packageReadyTransactions describe the transaction along
+	// with all of its ancestors in the pool. They're kept up to date by the pool as
+	// transactions are added and removed, so that they aren't recalculated for every block template
+	packageFee               uint64
+	packageMass              uint64
+	packageReadyTransactions IDToTransactionMap
 }
 
 // NewMempoolTransaction constructs a new MempoolTransaction
@@ -57,3 +64,40 @@ func (mt *MempoolTransaction) IsHighPriority() bool {
 func (mt *MempoolTransaction) AddedAtDAAScore() uint64 {
 	return mt.addedAtDAAScore
 }
+
+// PackageFee returns the total fee of this MempoolTransaction along with all of its ancestors in the pool
+func (mt *MempoolTransaction) PackageFee() uint64 {
+	return mt.packageFee
+}
+
+// PackageMass returns the total mass of this MempoolTransaction along with all of its ancestors in the pool
+func (mt *MempoolTransaction) PackageMass() uint64 {
+	return mt.packageMass
+}
+
+// PackageReadyTransactions returns the transactions out of this MempoolTransaction and its ancestors in
+// the pool that have no parents in the pool, and as such may be included in the next block
+func (mt *MempoolTransaction) PackageReadyTransactions() IDToTransactionMap {
+	return mt.packageReadyTransactions
+}
+
+// SetPackage sets the total fee and mass of this MempoolTransaction along with all of its ancestors
+// in the pool, and the ones out of them that have no parents in the pool
+func (mt *MempoolTransaction) SetPackage(fee uint64, mass uint64, readyTransactions IDToTransactionMap) {
+	mt.packageFee = fee
+	mt.packageMass = mass
+	mt.packageReadyTransactions = readyTransactions
+}
+
+// RemoveAncestorFromPackage removes an ancestor that left the pool from the package of this MempoolTransaction
+func (mt *MempoolTransaction) RemoveAncestorFromPackage(ancestor *MempoolTransaction) {
+	mt.packageFee -= ancestor.Transaction().Fee
+	mt.packageMass -= ancestor.Transaction().Mass
+	delete(mt.packageReadyTransactions, *ancestor.TransactionID())
+}
+
+// AddReadyTransactionToPackage marks a transaction of the package of this MempoolTransaction
+// as one that has no parents in the pool
+func (mt *MempoolTransaction) AddReadyTransactionToPackage(readyTransaction *MempoolTransaction) {
+	mt.packageReadyTransactions[*readyTransaction.TransactionID()] = readyTransaction
+}
@@ -0,0 +1,225 @@
+package mempool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kaspanet/kaspad/domain/consensus/database/serialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	"github.com/pkg/errors"
+)
+
+// mempoolFileVersion is the version of the format of mempool files. Files of other
+// versions are ignored
+const mempoolFileVersion uint32 = 1
+
+// maximumSerializedTransactionSize guards against allocating absurd amounts of memory
+// when reading a corrupted mempool file
+const maximumSerializedTransactionSize = 10_000_000
+
+const (
+	persistedTransactionFlagHighPriority uint8 = 1 << iota
+)
+
+// persistedTransaction is a mempool transaction as it's saved to a mempool file
+type persistedTransaction struct {
+	transaction    *externalapi.DomainTransaction
+	isHighPriority bool
+}
+
+// saveToFile writes all the transactions in the transaction pool and the orphan pool to the
+// given file, and returns the number of saved transactions.
+// Transactions in the transaction pool are written before their redeemers, so that they're
+// accepted in the same order when the file is loaded.
+func (mp *mempool) saveToFile(filePath string) (int, error) {
+	transactions := make([]*persistedTransaction, 0,
+		mp.transactionsPool.transactionCount()+mp.orphansPool.orphanTransactionCount())
+
+	visited := make(map[externalapi.DomainTransactionID]struct{}, mp.transactionsPool.transactionCount())
+	var visit func(mempoolTransaction *model.MempoolTransaction)
+	visit = func(mempoolTransaction *model.MempoolTransaction) {
+		if _, ok := visited[*mempoolTransaction.TransactionID()]; ok {
+			return
+		}
+		visited[*mempoolTransaction.TransactionID()] = struct{}{}
+		for _, parent := range mempoolTransaction.ParentTransactionsInPool() {
+			visit(parent)
+		}
+		transactions = append(transactions, &persistedTransaction{
+			transaction:    mempoolTransaction.Transaction(),
+			isHighPriority: mempoolTransaction.IsHighPriority(),
+		})
+	}
+	for _, mempoolTransaction := range mp.transactionsPool.allTransactions {
+		visit(mempoolTransaction)
+	}
+	for _, orphanTransaction := range mp.orphansPool.allOrphans {
+		transactions = append(transactions, &persistedTransaction{
+			transaction:    orphanTransaction.Transaction(),
+			isHighPriority: orphanTransaction.IsHighPriority(),
+		})
+	}
+
+	// Write to a temporary file of its own first, so that a crash while saving doesn't
+	// corrupt the previously saved mempool
+	temporaryFile, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	err = writeMempoolFile(temporaryFile, transactions)
+	closeErr := temporaryFile.Close()
+	if err == nil {
+		err = errors.WithStack(closeErr)
+	}
+	if err == nil {
+		err = errors.WithStack(os.Rename(temporaryFile.Name(), filePath))
+	}
+	if err != nil {
+		removeErr := os.Remove(temporaryFile.Name())
+		if removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) {
+			log.Warnf("Couldn't remove the temporary mempool file %s: %s", temporaryFile.Name(), removeErr)
+		}
+		return 0, err
+	}
+
+	return len(transactions), nil
+}
+
+func writeMempoolFile(file *os.File, transactions []*persistedTransaction) error {
+	writer := bufio.NewWriter(file)
+	err := binary.Write(writer, binary.LittleEndian, mempoolFileVersion)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = binary.Write(writer, binary.LittleEndian, uint64(len(transactions)))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, transaction := range transactions {
+		serializedTransaction, err := proto.Marshal(serialization.DomainTransactionToDbTransaction(transaction.transaction))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		flags := uint8(0)
+		if transaction.isHighPriority {
+			flags |= persistedTransactionFlagHighPriority
+		}
+		err = binary.Write(writer, binary.LittleEndian, flags)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		err = binary.Write(writer, binary.LittleEndian, uint32(len(serializedTransaction)))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = writer.Write(serializedTransaction)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	err = writer.Flush()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(file.Sync())
+}
+
+func readMempoolFile(filePath string) ([]*persistedTransaction, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var version uint32
+	err = binary.Read(reader, binary.LittleEndian, &version)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if version != mempoolFileVersion {
+		return nil, errors.Errorf("unsupported mempool file version %d", version)
+	}
+	var count uint64
+	err = binary.Read(reader, binary.LittleEndian, &count)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	transactions := make([]*persistedTransaction, 0)
+	for i := uint64(0); i < count; i++ {
+		var flags uint8
+		err = binary.Read(reader, binary.LittleEndian, &flags)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		var length uint32
+		err = binary.Read(reader, binary.LittleEndian, &length)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if length > maximumSerializedTransactionSize {
+			return nil, errors.Errorf("transaction %d in the mempool file is %d bytes long, while the maximum is %d",
+				i, length, maximumSerializedTransactionSize)
+		}
+		serializedTransaction := make([]byte, length)
+		_, err = io.ReadFull(reader, serializedTransaction)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		dbTransaction := &serialization.DbTransaction{}
+		err = proto.Unmarshal(serializedTransaction, dbTransaction)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		transaction, err := serialization.DbTransactionToDomainTransaction(dbTransaction)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, &persistedTransaction{
+			transaction:    transaction,
+			isHighPriority: flags&persistedTransactionFlagHighPriority != 0,
+		})
+	}
+
+	return transactions, nil
+}
+
+// loadFromFile inserts the transactions saved in the given file into the mempool, and returns
+// the number of transactions that were loaded. Each transaction is revalidated against the
+// current state of consensus, and ones that are no longer valid are dropped.
+// A missing file is not an error.
+func (mp *mempool) loadFromFile(filePath string) (int, error) {
+	transactions, err := readMempoolFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	loadedCount := 0
+	for _, transaction := range transactions {
+		_, err := mp.validateAndInsertTransaction(transaction.transaction, transaction.isHighPriority, true)
+		if err != nil {
+			if !errors.As(err, &RuleError{}) {
+				return loadedCount, err
+			}
+			log.Debugf("Dropping saved transaction %s: %s",
+				consensushashing.TransactionID(transaction.transaction), err)
+			continue
+		}
+		loadedCount++
+	}
+
+	return loadedCount, nil
+}
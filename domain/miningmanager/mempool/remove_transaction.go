@@ -4,11 +4,14 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 )
 
-func (mp *mempool) removeTransactions(transactions []*externalapi.DomainTransaction, removeRedeemers bool) error {
+func (mp *mempool) removeTransactions(transactions []*externalapi.DomainTransaction, removeRedeemers bool,
+	reason miningmanagermodel.MempoolRemovalReason) error {
+
 	for _, transaction := range transactions {
-		err := mp.removeTransaction(consensushashing.TransactionID(transaction), removeRedeemers)
+		err := mp.removeTransaction(consensushashing.TransactionID(transaction), removeRedeemers, reason)
 		if err != nil {
 			return err
 		}
@@ -16,7 +19,9 @@ func (mp *mempool) removeTransactions(transactions []*externalapi.DomainTransact
 	return nil
 }
 
-func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
+func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool,
+	reason miningmanagermodel.MempoolRemovalReason) error {
+
 	if _, ok := mp.orphansPool.allOrphans[*transactionID]; ok {
 		return mp.orphansPool.removeOrphan(transactionID, true)
 	}
@@ -34,10 +39,11 @@ func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactio
 		for _, redeemer := range redeemers {
 			redeemer.RemoveParentTransactionInPool(transactionID)
 		}
+		mp.transactionsPool.removeAncestorFromPackages(mempoolTransaction, redeemers)
 	}
 
 	for _, transactionToRemove := range transactionsToRemove {
-		err := mp.removeTransactionFromSets(transactionToRemove, removeRedeemers)
+		err := mp.removeTransactionFromSets(transactionToRemove, removeRedeemers, reason)
 		if err != nil {
 			return err
 		}
@@ -53,7 +59,9 @@ func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactio
 	return nil
 }
 
-func (mp *mempool) removeTransactionFromSets(mempoolTransaction *model.MempoolTransaction, removeRedeemers bool) error {
+func (mp *mempool) removeTransactionFromSets(mempoolTransaction *model.MempoolTransaction, removeRedeemers bool,
+	reason miningmanagermodel.MempoolRemovalReason) error {
+
 	mp.mempoolUTXOSet.removeTransaction(mempoolTransaction)
 
 	err := mp.transactionsPool.removeTransaction(mempoolTransaction)
@@ -66,5 +74,7 @@ func (mp *mempool) removeTransactionFromSets(mempoolTransaction *model.MempoolTr
 		return err
 	}
 
+	mp.recordTransactionRemoved(mempoolTransaction, reason)
+
 	return nil
 }
@@ -0,0 +1,146 @@
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+// validateAndReplaceTransaction inserts the given transaction into the mempool, evicting the transactions
+// it double-spends along with all their descendants.
+// The transaction must double-spend at least one transaction in the mempool, pay a strictly higher fee rate
+// than each of the transactions it double-spends, and pay a strictly higher absolute fee than all the
+// transactions it evicts combined.
+func (mp *mempool) validateAndReplaceTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+	acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	transactionID := consensushashing.TransactionID(transaction)
+	onEnd := logger.LogAndMeasureExecutionTime(log, fmt.Sprintf("validateAndReplaceTransaction %s", transactionID))
+	defer onEnd()
+
+	// Populate mass in the beginning, it will be used in multiple places throughout the validation and insertion.
+	mp.consensusReference.Consensus().PopulateMass(transaction)
+
+	err = mp.validateTransactionInIsolation(transaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conflictingTransactions := mp.mempoolUTXOSet.conflictingTransactions(transaction)
+	if len(conflictingTransactions) == 0 {
+		str := fmt.Sprintf("transaction %s does not double-spend any transaction in the mempool", transactionID)
+		return nil, nil, transactionRuleError(RejectReplacement, str)
+	}
+
+	transactionsToEvict := model.IDToTransactionMap{}
+	for _, conflictingTransaction := range conflictingTransactions {
+		transactionsToEvict[*conflictingTransaction.TransactionID()] = conflictingTransaction
+		for _, redeemer := range mp.transactionsPool.getRedeemers(conflictingTransaction) {
+			transactionsToEvict[*redeemer.TransactionID()] = redeemer
+		}
+	}
+	if uint64(len(transactionsToEvict)) > mp.config.MaximumReplacedTransactionCount {
+		str := fmt.Sprintf("transaction %s would replace %d transactions, while the maximum is %d",
+			transactionID, len(transactionsToEvict), mp.config.MaximumReplacedTransactionCount)
+		return nil, nil, transactionRuleError(RejectReplacement, str)
+	}
+
+	parentsInPool, missingOutpoints, err := mp.fillInputsAndGetMissingParents(transaction)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(missingOutpoints) > 0 {
+		str := fmt.Sprintf("transaction %s is an orphan, which can't replace other transactions", transactionID)
+		return nil, nil, transactionRuleError(RejectBadOrphan, str)
+	}
+	for parentID := range parentsInPool {
+		if _, ok := transactionsToEvict[parentID]; ok {
+			str := fmt.Sprintf("transaction %s spends an output of transaction %s, which it replaces",
+				transactionID, parentID)
+			return nil, nil, transactionRuleError(RejectReplacement, str)
+		}
+	}
+
+	err = mp.validateTransactionInContext(transaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = checkReplacementFee(transaction, conflictingTransactions, transactionsToEvict)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Everything that may fail is done before evicting anything, so that a failed replacement leaves
+	// the mempool unchanged. Adding the prepared transaction to the pool only fails for transactions
+	// without fee or mass, while a replacement always has both
+	mempoolTransaction, err := mp.transactionsPool.newMempoolTransaction(transaction, parentsInPool, isHighPriority)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replacedTransactions = make([]*externalapi.DomainTransaction, 0, len(transactionsToEvict))
+	for _, transactionToEvict := range transactionsToEvict {
+		replacedTransactions = append(replacedTransactions, transactionToEvict.Transaction().Clone()) //these pointers leave the mempool, hence we clone.
+	}
+	for _, conflictingTransaction := range conflictingTransactions {
+		log.Debugf("Replacing transaction %s with transaction %s", conflictingTransaction.TransactionID(), transactionID)
+		err = mp.removeTransaction(conflictingTransaction.TransactionID(), true, miningmanagermodel.MempoolRemovalReasonDoubleSpent)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = mp.transactionsPool.addMempoolTransaction(mempoolTransaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acceptedOrphans, err := mp.orphansPool.processOrphansAfterAcceptedTransaction(mempoolTransaction.Transaction())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acceptedTransactions = append([]*externalapi.DomainTransaction{transaction.Clone()}, acceptedOrphans...) //these pointer leave the mempool, hence we clone.
+
+	err = mp.transactionsPool.limitTransactionCount()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return acceptedTransactions, replacedTransactions, nil
+}
+
+// checkReplacementFee makes sure that the given transaction pays a strictly higher fee rate than each of
+// the transactions it double-spends, and a strictly higher fee than all the transactions it evicts combined
+func checkReplacementFee(transaction *externalapi.DomainTransaction,
+	conflictingTransactions []*model.MempoolTransaction, transactionsToEvict model.IDToTransactionMap) error {
+
+	transactionID := consensushashing.TransactionID(transaction)
+	feeRate := float64(transaction.Fee) / float64(transaction.Mass)
+	for _, conflictingTransaction := range conflictingTransactions {
+		conflictingFeeRate := float64(conflictingTransaction.Transaction().Fee) / float64(conflictingTransaction.Transaction().Mass)
+		if feeRate <= conflictingFeeRate {
+			str := fmt.Sprintf("transaction %s has a fee rate of %f sompi/gram, which is not higher than "+
+				"the fee rate of %f sompi/gram of transaction %s that it double-spends",
+				transactionID, feeRate, conflictingFeeRate, conflictingTransaction.TransactionID())
+			return transactionRuleError(RejectInsufficientFee, str)
+		}
+	}
+
+	evictedFees := uint64(0)
+	for _, transactionToEvict := range transactionsToEvict {
+		evictedFees += transactionToEvict.Transaction().Fee
+	}
+	if transaction.Fee <= evictedFees {
+		str := fmt.Sprintf("transaction %s has a fee of %d sompi, which is not higher than the %d sompi "+
+			"paid by the %d transactions it replaces", transactionID, transaction.Fee, evictedFees, len(transactionsToEvict))
+		return transactionRuleError(RejectInsufficientFee, str)
+	}
+
+	return nil
+}
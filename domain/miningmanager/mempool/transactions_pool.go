@@ -8,6 +8,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
 	"github.com/kaspanet/kaspad/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
 )
 
 type transactionsPool struct {
@@ -35,14 +36,11 @@ func newTransactionsPool(mp *mempool) *transactionsPool {
 func (tp *transactionsPool) addTransaction(transaction *externalapi.DomainTransaction,
 	parentTransactionsInPool model.IDToTransactionMap, isHighPriority bool) (*model.MempoolTransaction, error) {
 
-	virtualDAAScore, err := tp.mempool.consensusReference.Consensus().GetVirtualDAAScore()
+	mempoolTransaction, err := tp.newMempoolTransaction(transaction, parentTransactionsInPool, isHighPriority)
 	if err != nil {
 		return nil, err
 	}
 
-	mempoolTransaction := model.NewMempoolTransaction(
-		transaction, parentTransactionsInPool, isHighPriority, virtualDAAScore)
-
 	err = tp.addMempoolTransaction(mempoolTransaction)
 	if err != nil {
 		return nil, err
@@ -51,6 +49,21 @@ func (tp *transactionsPool) addTransaction(transaction *externalapi.DomainTransa
 	return mempoolTransaction, nil
 }
 
+// newMempoolTransaction creates a MempoolTransaction for the given transaction, without adding it to the pool
+func (tp *transactionsPool) newMempoolTransaction(transaction *externalapi.DomainTransaction,
+	parentTransactionsInPool model.IDToTransactionMap, isHighPriority bool) (*model.MempoolTransaction, error) {
+
+	virtualDAAScore, err := tp.mempool.consensusReference.Consensus().GetVirtualDAAScore()
+	if err != nil {
+		return nil, err
+	}
+
+	// The packages of the transaction's descendants are calculated from its fee and mass, so the pool
+	// keeps its own copy of it rather than one the caller may go on to modify
+	return model.NewMempoolTransaction(
+		transaction.Clone(), parentTransactionsInPool, isHighPriority, virtualDAAScore), nil
+}
+
 func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTransaction) error {
 	tp.allTransactions[*transaction.TransactionID()] = transaction
 
@@ -65,6 +78,8 @@ func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTran
 
 	tp.mempool.mempoolUTXOSet.addTransaction(transaction)
 
+	tp.updatePackage(transaction)
+
 	err := tp.transactionsOrderedByFeeRate.Push(transaction)
 	if err != nil {
 		return err
@@ -74,6 +89,8 @@ func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTran
 		tp.highPriorityTransactions[*transaction.TransactionID()] = transaction
 	}
 
+	tp.mempool.recordTransactionAccepted(transaction)
+
 	return nil
 }
 
@@ -119,7 +136,8 @@ func (tp *transactionsPool) expireOldTransactions() error {
 		if daaScoreSinceAdded > tp.mempool.config.TransactionExpireIntervalDAAScore {
 			log.Debugf("Removing transaction %s, because it expired. DAAScore moved by %d, expire interval: %d",
 				mempoolTransaction.TransactionID(), daaScoreSinceAdded, tp.mempool.config.TransactionExpireIntervalDAAScore)
-			err = tp.mempool.removeTransaction(mempoolTransaction.TransactionID(), true)
+			err = tp.mempool.removeTransaction(mempoolTransaction.TransactionID(), true,
+				miningmanagermodel.MempoolRemovalReasonExpired)
 			if err != nil {
 				return err
 			}
@@ -131,16 +149,105 @@ func (tp *transactionsPool) expireOldTransactions() error {
 	return nil
 }
 
-func (tp *transactionsPool) allReadyTransactions() []*externalapi.DomainTransaction {
-	result := []*externalapi.DomainTransaction{}
+// allTransactionPackages returns the ancestor package of every transaction in the pool
+func (tp *transactionsPool) allTransactionPackages() []*miningmanagermodel.TransactionPackage {
+	// Ready transactions are cloned once, so that packages sharing them share the same instance
+	readyTransactions := make(map[externalapi.DomainTransactionID]*externalapi.DomainTransaction)
 
+	packages := make([]*miningmanagermodel.TransactionPackage, 0, len(tp.allTransactions))
 	for _, mempoolTransaction := range tp.allTransactions {
-		if len(mempoolTransaction.ParentTransactionsInPool()) == 0 {
-			result = append(result, mempoolTransaction.Transaction().Clone()) //this pointer leaves the mempool, and gets its utxo set to nil, hence we clone.
+		transactionPackage := &miningmanagermodel.TransactionPackage{
+			Fee:  mempoolTransaction.PackageFee(),
+			Mass: mempoolTransaction.PackageMass(),
 		}
+		for transactionID, packageTransaction := range mempoolTransaction.PackageReadyTransactions() {
+			readyTransaction, ok := readyTransactions[transactionID]
+			if !ok {
+				readyTransaction = packageTransaction.Transaction().Clone() //this pointer leaves the mempool, and gets its utxo set to nil, hence we clone.
+				readyTransactions[transactionID] = readyTransaction
+			}
+			transactionPackage.ReadyTransactions = append(transactionPackage.ReadyTransactions, readyTransaction)
+		}
+		packages = append(packages, transactionPackage)
 	}
 
-	return result
+	return packages
+}
+
+// updatePackage calculates the package of the given transaction from all of its ancestors in the pool
+func (tp *transactionsPool) updatePackage(transaction *model.MempoolTransaction) {
+	fee, mass := uint64(0), uint64(0)
+	readyTransactions := model.IDToTransactionMap{}
+	for transactionID, packageTransaction := range tp.getAncestors(transaction) {
+		fee += packageTransaction.Transaction().Fee
+		mass += packageTransaction.Transaction().Mass
+		if len(packageTransaction.ParentTransactionsInPool()) == 0 {
+			readyTransactions[transactionID] = packageTransaction
+		}
+	}
+	transaction.SetPackage(fee, mass, readyTransactions)
+}
+
+// removeAncestorFromPackages updates the packages of the redeemers of a transaction that leaves
+// the pool without them, typically since it was included in a block. It must be called after the
+// transaction is removed from the parents of its redeemers, and before it's removed from the pool
+func (tp *transactionsPool) removeAncestorFromPackages(transaction *model.MempoolTransaction,
+	redeemers []*model.MempoolTransaction) {
+
+	// A redeemer may be reached through several paths of chained transactions
+	uniqueRedeemers := model.IDToTransactionMap{}
+	for _, redeemer := range redeemers {
+		uniqueRedeemers[*redeemer.TransactionID()] = redeemer
+	}
+
+	// The ancestors of a transaction that has parents in the pool may stay in the pool, while no longer being
+	// ancestors of some of its redeemers. This doesn't happen to mined transactions, so it's simpler to
+	// recalculate the packages of its redeemers from scratch
+	if len(transaction.ParentTransactionsInPool()) > 0 {
+		for _, redeemer := range uniqueRedeemers {
+			tp.updatePackage(redeemer)
+		}
+		return
+	}
+
+	for _, redeemer := range uniqueRedeemers {
+		redeemer.RemoveAncestorFromPackage(transaction)
+	}
+
+	// Children with no other parents in the pool now become ready, along with the packages of their redeemers
+	for _, child := range tp.chainedTransactionsByParentID[*transaction.TransactionID()] {
+		if len(child.ParentTransactionsInPool()) > 0 {
+			continue
+		}
+		child.AddReadyTransactionToPackage(child)
+		childRedeemers := model.IDToTransactionMap{}
+		for _, childRedeemer := range tp.getRedeemers(child) {
+			childRedeemers[*childRedeemer.TransactionID()] = childRedeemer
+		}
+		for _, childRedeemer := range childRedeemers {
+			childRedeemer.AddReadyTransactionToPackage(child)
+		}
+	}
+}
+
+// getAncestors returns the given transaction along with all of its ancestors in the pool
+func (tp *transactionsPool) getAncestors(transaction *model.MempoolTransaction) model.IDToTransactionMap {
+	ancestors := model.IDToTransactionMap{*transaction.TransactionID(): transaction}
+	stack := []*model.MempoolTransaction{transaction}
+	for len(stack) > 0 {
+		var current *model.MempoolTransaction
+		last := len(stack) - 1
+		current, stack = stack[last], stack[:last]
+
+		for parentID, parent := range current.ParentTransactionsInPool() {
+			if _, ok := ancestors[parentID]; ok {
+				continue
+			}
+			ancestors[parentID] = parent
+			stack = append(stack, parent)
+		}
+	}
+	return ancestors
 }
 
 func (tp *transactionsPool) getParentTransactionsInPool(
@@ -194,7 +301,8 @@ func (tp *transactionsPool) limitTransactionCount() error {
 
 		log.Debugf("Removing transaction %s, because mempoolTransaction count (%d) exceeded the limit (%d)",
 			transactionToRemove.TransactionID(), len(tp.allTransactions), tp.mempool.config.MaximumTransactionCount)
-		err := tp.mempool.removeTransaction(transactionToRemove.TransactionID(), true)
+		err := tp.mempool.removeTransaction(transactionToRemove.TransactionID(), true,
+			miningmanagermodel.MempoolRemovalReasonEvicted)
 		if err != nil {
 			return err
 		}
@@ -250,3 +358,27 @@ func (tp *transactionsPool) getAllTransactions() []*externalapi.DomainTransactio
 func (tp *transactionsPool) transactionCount() int {
 	return len(tp.allTransactions)
 }
+
+// transactionsMass returns the total mass of all the transactions in the pool
+func (tp *transactionsPool) transactionsMass() uint64 {
+	mass := uint64(0)
+	for _, transaction := range tp.allTransactions {
+		mass += transaction.Transaction().Mass
+	}
+	return mass
+}
+
+// transactionFeeRates returns the fee rates and masses of all the transactions in the pool,
+// ordered from the highest fee rate to the lowest
+func (tp *transactionsPool) transactionFeeRates() []miningmanagermodel.TransactionFeeRate {
+	transactionCount := tp.transactionsOrderedByFeeRate.Len()
+	feeRates := make([]miningmanagermodel.TransactionFeeRate, 0, transactionCount)
+	for i := transactionCount - 1; i >= 0; i-- {
+		transaction := tp.transactionsOrderedByFeeRate.GetByIndex(i).Transaction()
+		feeRates = append(feeRates, miningmanagermodel.TransactionFeeRate{
+			FeeRate: float64(transaction.Fee) / float64(transaction.Mass),
+			Mass:    transaction.Mass,
+		})
+	}
+	return feeRates
+}
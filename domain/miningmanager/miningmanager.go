@@ -7,6 +7,7 @@ import (
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/consensusreference"
 	miningmanagermodel "github.com/kaspanet/kaspad/domain/miningmanager/model"
+	"github.com/kaspanet/kaspad/util"
 )
 
 // MiningManager creates block templates for mining as well as maintaining
@@ -29,10 +30,17 @@ type MiningManager interface {
 		transactionPoolTransactions []*externalapi.DomainTransaction,
 		orphanPoolTransactions []*externalapi.DomainTransaction)
 	TransactionCount(includeTransactionPool bool, includeOrphanPool bool) int
+	TransactionPoolMass() uint64
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndReplaceTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+		acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error)
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
+	GetFeeEstimate() *miningmanagermodel.FeeEstimate
+	SaveMempool(filePath string) (transactionCount int, err error)
+	LoadMempool(filePath string) (transactionCount int, err error)
+	SetOnMempoolChangedHandler(onMempoolChangedHandler miningmanagermodel.OnMempoolChangedHandler)
 }
 
 type miningManager struct {
@@ -42,6 +50,10 @@ type miningManager struct {
 	cachedBlockTemplate  *externalapi.DomainBlockTemplate
 	cachingTime          time.Time
 	cacheLock            *sync.Mutex
+
+	targetTimePerBlock         time.Duration
+	maxBlockMass               uint64
+	minimumRelayTransactionFee util.Amount
 }
 
 // GetBlockTemplate obtains a block template for a miner to consume
@@ -117,6 +129,15 @@ func (mm *miningManager) ValidateAndInsertTransaction(transaction *externalapi.D
 	return mm.mempool.ValidateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
 }
 
+// ValidateAndReplaceTransaction validates the given transaction, and adds it
+// to the set of known transactions that have not yet been added to any block,
+// in place of the transactions it double-spends and their descendants
+func (mm *miningManager) ValidateAndReplaceTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool) (
+	acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return mm.mempool.ValidateAndReplaceTransaction(transaction, isHighPriority)
+}
+
 func (mm *miningManager) GetTransaction(
 	transactionID *externalapi.DomainTransactionID,
 	includeTransactionPool bool,
@@ -149,8 +170,30 @@ func (mm *miningManager) TransactionCount(includeTransactionPool bool, includeOr
 	return mm.mempool.TransactionCount(includeTransactionPool, includeOrphanPool)
 }
 
+// TransactionPoolMass returns the total mass of the transactions in the mempool, excluding orphans
+func (mm *miningManager) TransactionPoolMass() uint64 {
+	return mm.mempool.TransactionPoolMass()
+}
+
 func (mm *miningManager) RevalidateHighPriorityTransactions() (
 	validTransactions []*externalapi.DomainTransaction, err error) {
 
 	return mm.mempool.RevalidateHighPriorityTransactions()
 }
+
+// SaveMempool writes all the transactions in the mempool to the given file
+func (mm *miningManager) SaveMempool(filePath string) (transactionCount int, err error) {
+	return mm.mempool.SaveToFile(filePath)
+}
+
+// LoadMempool revalidates the transactions saved by SaveMempool to the given file, and inserts
+// the valid ones into the mempool
+func (mm *miningManager) LoadMempool(filePath string) (transactionCount int, err error) {
+	return mm.mempool.LoadFromFile(filePath)
+}
+
+// SetOnMempoolChangedHandler sets the handler that's called whenever transactions are
+// accepted to the mempool or removed from it
+func (mm *miningManager) SetOnMempoolChangedHandler(onMempoolChangedHandler miningmanagermodel.OnMempoolChangedHandler) {
+	mm.mempool.SetOnMempoolChangedHandler(onMempoolChangedHandler)
+}
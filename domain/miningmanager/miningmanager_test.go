@@ -6,6 +6,8 @@ import (
 	"github.com/kaspanet/kaspad/domain/miningmanager/model"
 	"github.com/kaspanet/kaspad/util"
 	"github.com/kaspanet/kaspad/version"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -172,6 +174,376 @@ func TestDoubleSpendInMempool(t *testing.T) {
 	})
 }
 
+// TestReplaceTransaction verifies that a transaction in the mempool is replaced only by a conflicting
+// transaction that pays a higher fee.
+func TestReplaceTransaction(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestReplaceTransaction")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		transaction, err := createChildAndParentTxsAndAddParentToConsensus(tc)
+		if err != nil {
+			t.Fatalf("Error creating transaction: %+v", err)
+		}
+
+		_, _, err = miningManager.ValidateAndReplaceTransaction(transaction, true)
+		if err == nil || !strings.Contains(err.Error(), "does not double-spend") {
+			t.Fatalf("ValidateAndReplaceTransaction: expected a transaction without conflicts to be rejected, got: %v", err)
+		}
+
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, true, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+
+		cheaperTransaction := transaction.Clone()
+		cheaperTransaction.ID = nil
+		cheaperTransaction.Outputs[0].Value++
+		_, _, err = miningManager.ValidateAndReplaceTransaction(cheaperTransaction, true)
+		if err == nil || !strings.Contains(err.Error(), "fee") {
+			t.Fatalf("ValidateAndReplaceTransaction: expected a cheaper replacement to be rejected, got: %v", err)
+		}
+
+		replacementTransaction := transaction.Clone()
+		replacementTransaction.ID = nil
+		replacementTransaction.Outputs[0].Value -= 10000
+		acceptedTransactions, replacedTransactions, err := miningManager.ValidateAndReplaceTransaction(replacementTransaction, true)
+		if err != nil {
+			t.Fatalf("ValidateAndReplaceTransaction: %v", err)
+		}
+		if len(acceptedTransactions) != 1 || !contains(replacementTransaction, acceptedTransactions) {
+			t.Fatalf("Expected the replacement to be the only accepted transaction")
+		}
+		if len(replacedTransactions) != 1 || !contains(transaction, replacedTransactions) {
+			t.Fatalf("Expected the original transaction to be the only replaced transaction")
+		}
+
+		mempoolTransactions, _ := miningManager.AllTransactions(true, false)
+		if contains(transaction, mempoolTransactions) || !contains(replacementTransaction, mempoolTransactions) {
+			t.Fatalf("Expected the mempool to contain the replacement instead of the original transaction")
+		}
+	})
+}
+
+// failingVirtualDAAScoreConsensus is a consensus that fails to return the virtual DAA score,
+// which the mempool needs only once it adds a validated transaction to the pool
+type failingVirtualDAAScoreConsensus struct {
+	externalapi.Consensus
+}
+
+var errVirtualDAAScore = errors.New("failed to get the virtual DAA score")
+
+func (c *failingVirtualDAAScoreConsensus) GetVirtualDAAScore() (uint64, error) {
+	return 0, errVirtualDAAScore
+}
+
+// TestReplaceTransactionFailure verifies that a replacement that fails to be added to the mempool
+// leaves the transactions it would have replaced in the mempool.
+func TestReplaceTransactionFailure(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestReplaceTransactionFailure")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		transaction, err := createChildAndParentTxsAndAddParentToConsensus(tc)
+		if err != nil {
+			t.Fatalf("Error creating transaction: %+v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, true, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+
+		tcAsConsensus = &failingVirtualDAAScoreConsensus{Consensus: tc}
+		replacementTransaction := transaction.Clone()
+		replacementTransaction.ID = nil
+		replacementTransaction.Outputs[0].Value -= 10000
+		_, _, err = miningManager.ValidateAndReplaceTransaction(replacementTransaction, true)
+		if !errors.Is(err, errVirtualDAAScore) {
+			t.Fatalf("ValidateAndReplaceTransaction: expected %v, got: %v", errVirtualDAAScore, err)
+		}
+		tcAsConsensus = tc
+
+		mempoolTransactions, _ := miningManager.AllTransactions(true, false)
+		if len(mempoolTransactions) != 1 || !contains(transaction, mempoolTransactions) {
+			t.Fatalf("Expected the mempool to contain only the original transaction after a failed replacement")
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(transaction.Clone(), true, true)
+		if err == nil {
+			t.Fatalf("ValidateAndInsertTransaction: expected the original transaction to still be in the mempool")
+		}
+	})
+}
+
+// TestMempoolChangedHandler verifies that the mempool reports accepted and removed transactions,
+// along with the reason for which they were removed, in the order the changes were made.
+func TestMempoolChangedHandler(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestMempoolChangedHandler")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+
+		var changes []*model.MempoolChange
+		miningManager.SetOnMempoolChangedHandler(func(newChanges []*model.MempoolChange) {
+			changes = append(changes, newChanges...)
+		})
+		expectChanges := func(expectedChanges ...*model.MempoolChange) {
+			defer func() { changes = nil }()
+			if len(changes) != len(expectedChanges) {
+				t.Fatalf("Expected %d mempool changes, got %d", len(expectedChanges), len(changes))
+			}
+			for i, change := range changes {
+				expectedChange := expectedChanges[i]
+				if !consensushashing.TransactionID(change.Transaction).Equal(consensushashing.TransactionID(expectedChange.Transaction)) ||
+					change.IsRemoved != expectedChange.IsRemoved || change.RemovalReason != expectedChange.RemovalReason {
+
+					t.Fatalf("Unexpected mempool change %d: got {%s, %t, %s}, want {%s, %t, %s}", i,
+						consensushashing.TransactionID(change.Transaction), change.IsRemoved, change.RemovalReason,
+						consensushashing.TransactionID(expectedChange.Transaction), expectedChange.IsRemoved, expectedChange.RemovalReason)
+				}
+			}
+		}
+
+		transaction, err := createChildAndParentTxsAndAddParentToConsensus(tc)
+		if err != nil {
+			t.Fatalf("Error creating transaction: %+v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, true, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		expectChanges(&model.MempoolChange{Transaction: transaction})
+
+		replacementTransaction := transaction.Clone()
+		replacementTransaction.ID = nil
+		replacementTransaction.Outputs[0].Value -= 10000
+		_, _, err = miningManager.ValidateAndReplaceTransaction(replacementTransaction, true)
+		if err != nil {
+			t.Fatalf("ValidateAndReplaceTransaction: %v", err)
+		}
+		expectChanges(
+			&model.MempoolChange{Transaction: transaction, IsRemoved: true, RemovalReason: model.MempoolRemovalReasonDoubleSpent},
+			&model.MempoolChange{Transaction: replacementTransaction},
+		)
+
+		_, err = miningManager.HandleNewBlockTransactions([]*externalapi.DomainTransaction{nil, replacementTransaction})
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %v", err)
+		}
+		expectChanges(
+			&model.MempoolChange{Transaction: replacementTransaction, IsRemoved: true, RemovalReason: model.MempoolRemovalReasonMined},
+		)
+	})
+}
+
+// TestChildPaysForParent verifies that a high-fee child transaction bumps its low-fee parent into
+// the block template, ahead of an unrelated transaction that pays a higher fee than the parent alone.
+func TestChildPaysForParent(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestChildPaysForParent")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		parentAndChild, err := createTransactionChain(tc, 1000, 100_000)
+		if err != nil {
+			t.Fatalf("Error creating transactions: %+v", err)
+		}
+		parentTransaction, childTransaction := parentAndChild[0], parentAndChild[1]
+		unrelated, err := createTransactionChain(tc, 10_000)
+		if err != nil {
+			t.Fatalf("Error creating transactions: %+v", err)
+		}
+		unrelatedTransaction := unrelated[0]
+
+		// Limit the block template to a single transaction
+		tc.PopulateMass(parentTransaction)
+		params := consensusConfig.Params
+		params.MaxBlockMass = parentTransaction.Mass * 3 / 2
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &params, mempool.DefaultConfig(&params))
+
+		for _, transaction := range []*externalapi.DomainTransaction{parentTransaction, childTransaction, unrelatedTransaction} {
+			_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %v", err)
+			}
+		}
+
+		block, _, err := miningManager.GetBlockTemplate(&externalapi.DomainCoinbaseData{
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: nil, Version: 0},
+			ExtraData:       nil})
+		if err != nil {
+			t.Fatalf("Failed get a block template: %v", err)
+		}
+		blockTransactions := block.Transactions[transactionhelper.CoinbaseTransactionIndex+1:]
+		if len(blockTransactions) != 1 || !contains(parentTransaction, blockTransactions) {
+			t.Fatalf("Expected the parent transaction to be the only transaction in the block template")
+		}
+
+		// Once the parent is mined, the child should make it into the next block template on its own
+		tips, err := tc.Tips()
+		if err != nil {
+			t.Fatalf("Tips: %+v", err)
+		}
+		minedBlockHash, _, err := tc.AddBlock(tips, nil, []*externalapi.DomainTransaction{parentTransaction})
+		if err != nil {
+			t.Fatalf("AddBlock: %+v", err)
+		}
+		minedBlock, err := tc.GetBlock(minedBlockHash)
+		if err != nil {
+			t.Fatalf("GetBlock: %+v", err)
+		}
+		_, err = miningManager.HandleNewBlockTransactions(minedBlock.Transactions)
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %v", err)
+		}
+		miningManager.ClearBlockTemplate()
+
+		block, _, err = miningManager.GetBlockTemplate(&externalapi.DomainCoinbaseData{
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: nil, Version: 0},
+			ExtraData:       nil})
+		if err != nil {
+			t.Fatalf("Failed get a block template: %v", err)
+		}
+		blockTransactions = block.Transactions[transactionhelper.CoinbaseTransactionIndex+1:]
+		if len(blockTransactions) != 1 || !contains(childTransaction, blockTransactions) {
+			t.Fatalf("Expected the child transaction to be the only transaction in the block template")
+		}
+	})
+}
+
+// TestSaveAndLoadMempool verifies that the transactions saved from one mempool are loaded into another,
+// along with their high-priority flags.
+func TestSaveAndLoadMempool(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestSaveAndLoadMempool")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+
+		transactions, err := createTransactionChain(tc, 1000, 1000)
+		if err != nil {
+			t.Fatalf("Error creating transactions: %+v", err)
+		}
+		parentTransaction, childTransaction := transactions[0], transactions[1]
+		_, err = miningManager.ValidateAndInsertTransaction(parentTransaction, true, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(childTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+
+		mempoolFilePath := filepath.Join(t.TempDir(), "mempool.dat")
+		savedCount, err := miningManager.SaveMempool(mempoolFilePath)
+		if err != nil {
+			t.Fatalf("SaveMempool: %v", err)
+		}
+		if savedCount != 2 {
+			t.Fatalf("Expected 2 transactions to be saved, but got %d", savedCount)
+		}
+
+		// Concurrent saves, such as the periodic one and the SaveMempool RPC, don't corrupt the file
+		// nor leave temporary files behind
+		saveErrors := make(chan error)
+		const concurrentSaveCount = 10
+		for i := 0; i < concurrentSaveCount; i++ {
+			go func() {
+				_, err := miningManager.SaveMempool(mempoolFilePath)
+				saveErrors <- err
+			}()
+		}
+		for i := 0; i < concurrentSaveCount; i++ {
+			err := <-saveErrors
+			if err != nil {
+				t.Fatalf("SaveMempool: %v", err)
+			}
+		}
+		mempoolDirectoryEntries, err := os.ReadDir(filepath.Dir(mempoolFilePath))
+		if err != nil {
+			t.Fatalf("ReadDir: %v", err)
+		}
+		if len(mempoolDirectoryEntries) != 1 {
+			t.Fatalf("Expected only the mempool file to be left after saving, but got %d files",
+				len(mempoolDirectoryEntries))
+		}
+
+		reloadedMiningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		loadedCount, err := reloadedMiningManager.LoadMempool(mempoolFilePath)
+		if err != nil {
+			t.Fatalf("LoadMempool: %v", err)
+		}
+		if loadedCount != 2 {
+			t.Fatalf("Expected 2 transactions to be loaded, but got %d", loadedCount)
+		}
+		mempoolTransactions, _ := reloadedMiningManager.AllTransactions(true, false)
+		if len(mempoolTransactions) != 2 || !contains(parentTransaction, mempoolTransactions) ||
+			!contains(childTransaction, mempoolTransactions) {
+			t.Fatalf("Expected the loaded mempool to contain the saved transactions")
+		}
+		highPriorityTransactions, err := reloadedMiningManager.RevalidateHighPriorityTransactions()
+		if err != nil {
+			t.Fatalf("RevalidateHighPriorityTransactions: %v", err)
+		}
+		if len(highPriorityTransactions) != 1 || !contains(parentTransaction, highPriorityTransactions) {
+			t.Fatalf("Expected only the parent transaction to be loaded as high-priority")
+		}
+
+		// Loading a mempool that was never saved is not an error
+		loadedCount, err = reloadedMiningManager.LoadMempool(filepath.Join(t.TempDir(), "missing.dat"))
+		if err != nil || loadedCount != 0 {
+			t.Fatalf("LoadMempool: expected no transactions and no error from a missing file, got %d, %v", loadedCount, err)
+		}
+	})
+}
+
 // TestHandleNewBlockTransactions verifies that all the transactions in the block were successfully removed from the mempool.
 func TestHandleNewBlockTransactions(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
@@ -940,6 +1312,41 @@ func createParentAndChildrenTransactions(tc testapi.TestConsensus) (txParent *ex
 	return txParent, txChild, nil
 }
 
+// createTransactionChain creates a chain of transactions that pay the given fees, the first of which
+// spends a new coinbase transaction
+func createTransactionChain(tc testapi.TestConsensus, fees ...uint64) ([]*externalapi.DomainTransaction, error) {
+	// We will add two blocks by consensus before the chain, in order to fund it.
+	for i := 0; i < 2; i++ {
+		tips, err := tc.Tips()
+		if err != nil {
+			return nil, err
+		}
+		_, _, err = tc.AddBlock(tips, nil, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "AddBlock: ")
+		}
+	}
+	tips, err := tc.Tips()
+	if err != nil {
+		return nil, err
+	}
+	fundingBlock, err := tc.GetBlock(tips[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "GetBlock: ")
+	}
+
+	transactions := make([]*externalapi.DomainTransaction, len(fees))
+	previousTransaction := fundingBlock.Transactions[transactionhelper.CoinbaseTransactionIndex]
+	for i, fee := range fees {
+		transactions[i], err = testutils.CreateTransaction(previousTransaction, fee)
+		if err != nil {
+			return nil, err
+		}
+		previousTransaction = transactions[i]
+	}
+	return transactions, nil
+}
+
 func createChildAndParentTxsAndAddParentToConsensus(tc testapi.TestConsensus) (*externalapi.DomainTransaction, error) {
 	firstBlockHash, _, err := tc.AddBlock([]*externalapi.DomainHash{tc.DAGParams().GenesisHash}, nil, nil)
 	if err != nil {
@@ -0,0 +1,30 @@
+package model
+
+// FeeEstimate is an estimation of the fee rates required for a transaction
+// to be included in a block within several time frames
+type FeeEstimate struct {
+	// PriorityBucket is the fee rate required for inclusion in the next block
+	PriorityBucket FeeRateBucket
+
+	// NormalBuckets are the fee rates required for inclusion within up to a
+	// minute, ordered from the fastest to the slowest
+	NormalBuckets []FeeRateBucket
+
+	// LowBuckets are the fee rates required for inclusion within up to an
+	// hour, ordered from the fastest to the slowest
+	LowBuckets []FeeRateBucket
+}
+
+// FeeRateBucket is a fee rate, in sompi per gram of mass, along with the
+// estimated time, in seconds, until a transaction paying it is included in a block
+type FeeRateBucket struct {
+	FeeRate          float64
+	EstimatedSeconds float64
+}
+
+// TransactionFeeRate is the fee rate, in sompi per gram of mass, and the mass of
+// a transaction
+type TransactionFeeRate struct {
+	FeeRate float64
+	Mass    uint64
+}
@@ -9,4 +9,16 @@ type BlockTemplateBuilder interface {
 	BuildBlockTemplate(coinbaseData *consensusexternalapi.DomainCoinbaseData) (*consensusexternalapi.DomainBlockTemplate, error)
 	ModifyBlockTemplate(newCoinbaseData *consensusexternalapi.DomainCoinbaseData,
 		blockTemplateToModify *consensusexternalapi.DomainBlockTemplate) (*consensusexternalapi.DomainBlockTemplate, error)
+	LastBlockTemplateStats() *BlockTemplateStats
+}
+
+// BlockTemplateStats summarizes the transactions selected into a block template
+type BlockTemplateStats struct {
+	TransactionCount int
+	TotalMass        uint64
+	TotalFees        uint64
+
+	// IsMassLimited is set if transactions were left out of the
+	// block template because it reached the maximum block mass
+	IsMassLimited bool
 }
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// MempoolRemovalReason is the reason for which a transaction was removed from the mempool
+type MempoolRemovalReason uint8
+
+const (
+	// MempoolRemovalReasonMined means the transaction was included in a block
+	MempoolRemovalReasonMined MempoolRemovalReason = iota
+
+	// MempoolRemovalReasonDoubleSpent means a conflicting transaction was either included
+	// in a block or replaced the transaction in the mempool
+	MempoolRemovalReasonDoubleSpent
+
+	// MempoolRemovalReasonExpired means the transaction stayed in the mempool for too long
+	MempoolRemovalReasonExpired
+
+	// MempoolRemovalReasonEvicted means the transaction was evicted, either because the
+	// mempool is full or because it's no longer valid
+	MempoolRemovalReasonEvicted
+)
+
+var mempoolRemovalReasonStrings = map[MempoolRemovalReason]string{
+	MempoolRemovalReasonMined:       "mined",
+	MempoolRemovalReasonDoubleSpent: "double-spent",
+	MempoolRemovalReasonExpired:     "expired",
+	MempoolRemovalReasonEvicted:     "evicted",
+}
+
+func (reason MempoolRemovalReason) String() string {
+	if reasonString, ok := mempoolRemovalReasonStrings[reason]; ok {
+		return reasonString
+	}
+	return "unknown"
+}
+
+// MempoolChange is a single transaction that was either accepted to the mempool or removed from it
+type MempoolChange struct {
+	Transaction   *externalapi.DomainTransaction
+	IsRemoved     bool
+	RemovalReason MempoolRemovalReason
+}
+
+// OnMempoolChangedHandler is a handler function that's triggered whenever transactions
+// are accepted to the mempool or removed from it. The changes are given in the order
+// they were made.
+// The handler is called while the mempool is locked, so it must neither call the mempool
+// nor retain the given transactions.
+type OnMempoolChangedHandler func(changes []*MempoolChange)
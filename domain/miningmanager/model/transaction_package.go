@@ -0,0 +1,24 @@
+package model
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// TransactionPackage is a mempool transaction along with all of its ancestors in the mempool.
+// Since a block may not contain chained transactions, only the ancestors that don't spend
+// outputs of other mempool transactions may be included in the next block. Including them
+// is what eventually allows the rest of the package to be mined
+type TransactionPackage struct {
+	// ReadyTransactions are the transactions of the package that may be included in the next block.
+	// Packages that share ancestors share the same instances of these transactions
+	ReadyTransactions []*externalapi.DomainTransaction
+
+	// Fee and Mass are the total fee and mass of all the transactions in the package
+	Fee  uint64
+	Mass uint64
+}
+
+// FeeRate returns the fee rate of the package, in sompi per gram of mass
+func (tp *TransactionPackage) FeeRate() float64 {
+	return float64(tp.Fee) / float64(tp.Mass)
+}
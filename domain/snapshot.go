@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/db/dbsnapshot"
+	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+// maxSnapshotAttempts is the number of times CreateSnapshot tries to take a
+// database snapshot that doesn't straddle a pruning point movement
+const maxSnapshotAttempts = 10
+
+func (d *domain) CreateSnapshot(path string) (*dbsnapshot.Summary, error) {
+	d.stagingConsensusLock.RLock()
+	hasStagingConsensus := d.stagingConsensus != nil
+	d.stagingConsensusLock.RUnlock()
+	if hasStagingConsensus {
+		return nil, errors.Errorf("cannot create a snapshot while syncing a new pruning point")
+	}
+
+	for i := 0; i < maxSnapshotAttempts; i++ {
+		summary, ok, err := d.tryCreateSnapshot(path)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return summary, nil
+		}
+		log.Debugf("The pruning point moved while taking a database snapshot. Retrying")
+	}
+	return nil, errors.Errorf("the pruning point kept moving while taking a database snapshot")
+}
+
+// tryCreateSnapshot writes a snapshot file of the whole database to the given path.
+// The pruning point in the snapshot header is read before and after the database
+// snapshot is taken, and nothing is written if it moved in between.
+func (d *domain) tryCreateSnapshot(path string) (summary *dbsnapshot.Summary, ok bool, err error) {
+	consensus := d.Consensus()
+	pruningPoint, err := consensus.PruningPoint()
+	if err != nil {
+		return nil, false, err
+	}
+	virtualDAAScore, err := consensus.GetVirtualDAAScore()
+	if err != nil {
+		return nil, false, err
+	}
+
+	snapshot, err := d.db.Snapshot()
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() {
+		releaseErr := snapshot.Release()
+		if err == nil {
+			err = releaseErr
+		}
+	}()
+
+	pruningPointAfterSnapshot, err := consensus.PruningPoint()
+	if err != nil {
+		return nil, false, err
+	}
+	if !pruningPoint.Equal(pruningPointAfterSnapshot) {
+		return nil, false, nil
+	}
+
+	header := &dbsnapshot.Header{
+		Network:         d.consensusConfig.Params.Name,
+		PruningPoint:    pruningPoint.String(),
+		VirtualDAAScore: virtualDAAScore,
+		Timestamp:       mstime.Now().UnixMilliseconds(),
+	}
+	summary, err = dbsnapshot.WriteFile(path, header, snapshot)
+	if err != nil {
+		return nil, false, err
+	}
+	return summary, true, nil
+}
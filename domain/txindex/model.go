@@ -0,0 +1,15 @@
+package txindex
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+)
+
+// TxAcceptanceData is the data the transaction index holds for every
+// transaction accepted by the virtual's selected parent chain
+type TxAcceptanceData struct {
+	IncludingBlockHash *externalapi.DomainHash
+	AcceptingBlockHash *externalapi.DomainHash
+}
+
+// TxAcceptanceDataByID is a map between transaction IDs and their acceptance data
+type TxAcceptanceDataByID map[externalapi.DomainTransactionID]*TxAcceptanceData
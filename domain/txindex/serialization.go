@@ -0,0 +1,43 @@
+package txindex
+
+import (
+	"io"
+
+	"github.com/kaspanet/kaspad/domain/consensus/database/binaryserialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+const txAcceptanceDataSize = 2 * externalapi.DomainHashSize
+
+func serializeTxAcceptanceData(txAcceptanceData *TxAcceptanceData) []byte {
+	serializedTxAcceptanceData := make([]byte, 0, txAcceptanceDataSize)
+	serializedTxAcceptanceData = append(serializedTxAcceptanceData,
+		binaryserialization.SerializeHash(txAcceptanceData.IncludingBlockHash)...)
+	serializedTxAcceptanceData = append(serializedTxAcceptanceData,
+		binaryserialization.SerializeHash(txAcceptanceData.AcceptingBlockHash)...)
+	return serializedTxAcceptanceData
+}
+
+func deserializeTxAcceptanceData(serializedTxAcceptanceData []byte) (*TxAcceptanceData, error) {
+	if len(serializedTxAcceptanceData) != txAcceptanceDataSize {
+		return nil, errors.Wrapf(io.ErrUnexpectedEOF, "unexpected length %d while deserializing "+
+			"transaction acceptance data", len(serializedTxAcceptanceData))
+	}
+
+	includingBlockHash, err := binaryserialization.DeserializeHash(
+		serializedTxAcceptanceData[:externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+	acceptingBlockHash, err := binaryserialization.DeserializeHash(
+		serializedTxAcceptanceData[externalapi.DomainHashSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxAcceptanceData{
+		IncludingBlockHash: includingBlockHash,
+		AcceptingBlockHash: acceptingBlockHash,
+	}, nil
+}
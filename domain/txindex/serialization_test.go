@@ -0,0 +1,48 @@
+package txindex
+
+import (
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func Test_serializeTxAcceptanceData(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+
+	for i := 0; i < 32; i++ {
+		var includingBlockHashBytes, acceptingBlockHashBytes [externalapi.DomainHashSize]byte
+		r.Read(includingBlockHashBytes[:])
+		r.Read(acceptingBlockHashBytes[:])
+		txAcceptanceData := &TxAcceptanceData{
+			IncludingBlockHash: externalapi.NewDomainHashFromByteArray(&includingBlockHashBytes),
+			AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&acceptingBlockHashBytes),
+		}
+
+		result, err := deserializeTxAcceptanceData(serializeTxAcceptanceData(txAcceptanceData))
+		if err != nil {
+			t.Fatalf("Failed deserializing transaction acceptance data: %v", err)
+		}
+		if !result.IncludingBlockHash.Equal(txAcceptanceData.IncludingBlockHash) {
+			t.Fatalf("Expected including block hash %s but got %s",
+				txAcceptanceData.IncludingBlockHash, result.IncludingBlockHash)
+		}
+		if !result.AcceptingBlockHash.Equal(txAcceptanceData.AcceptingBlockHash) {
+			t.Fatalf("Expected accepting block hash %s but got %s",
+				txAcceptanceData.AcceptingBlockHash, result.AcceptingBlockHash)
+		}
+	}
+}
+
+func Test_deserializeTxAcceptanceDataFailure(t *testing.T) {
+	serialized := serializeTxAcceptanceData(&TxAcceptanceData{
+		IncludingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+	})
+	_, err := deserializeTxAcceptanceData(serialized[:len(serialized)-1])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected error to be EOF, instead got: %v", err)
+	}
+}
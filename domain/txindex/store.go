@@ -0,0 +1,171 @@
+package txindex
+
+import (
+	"github.com/kaspanet/kaspad/domain/consensus/database/binaryserialization"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+var txIndexBucket = database.MakeBucket([]byte("tx-index"))
+var virtualParentsKey = database.MakeBucket([]byte("")).Key([]byte("tx-index-virtual-parents"))
+
+type txIndexStore struct {
+	database database.Database
+	toAdd    TxAcceptanceDataByID
+	toRemove map[externalapi.DomainTransactionID]struct{}
+
+	virtualParents []*externalapi.DomainHash
+}
+
+func newTXIndexStore(database database.Database) *txIndexStore {
+	return &txIndexStore{
+		database: database,
+		toAdd:    make(TxAcceptanceDataByID),
+		toRemove: make(map[externalapi.DomainTransactionID]struct{}),
+	}
+}
+
+func (tis *txIndexStore) add(transactionID *externalapi.DomainTransactionID, txAcceptanceData *TxAcceptanceData) {
+	log.Tracef("Adding transaction %s accepted by block %s to the TX index",
+		transactionID, txAcceptanceData.AcceptingBlockHash)
+
+	// An addition overrides any removal of the same transaction staged earlier, since
+	// chain blocks are removed before new chain blocks are added
+	delete(tis.toRemove, *transactionID)
+	tis.toAdd[*transactionID] = txAcceptanceData
+}
+
+func (tis *txIndexStore) remove(transactionID *externalapi.DomainTransactionID) {
+	log.Tracef("Removing transaction %s from the TX index", transactionID)
+
+	delete(tis.toAdd, *transactionID)
+	tis.toRemove[*transactionID] = struct{}{}
+}
+
+func (tis *txIndexStore) updateVirtualParents(virtualParents []*externalapi.DomainHash) {
+	tis.virtualParents = virtualParents
+}
+
+func (tis *txIndexStore) discard() {
+	tis.toAdd = make(TxAcceptanceDataByID)
+	tis.toRemove = make(map[externalapi.DomainTransactionID]struct{})
+	tis.virtualParents = nil
+}
+
+func (tis *txIndexStore) commit() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "txIndexStore.commit")
+	defer onEnd()
+
+	dbTransaction, err := tis.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	for transactionID := range tis.toRemove {
+		err := dbTransaction.Delete(tis.convertTransactionIDToKey(&transactionID))
+		if err != nil {
+			return err
+		}
+	}
+
+	for transactionID, txAcceptanceData := range tis.toAdd {
+		err := dbTransaction.Put(tis.convertTransactionIDToKey(&transactionID),
+			serializeTxAcceptanceData(txAcceptanceData))
+		if err != nil {
+			return err
+		}
+	}
+
+	if tis.virtualParents != nil {
+		err = dbTransaction.Put(virtualParentsKey, binaryserialization.SerializeHashes(tis.virtualParents))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTransaction.Commit()
+	if err != nil {
+		return err
+	}
+
+	tis.discard()
+	return nil
+}
+
+func (tis *txIndexStore) updateAndCommitVirtualParentsWithoutTransaction(virtualParents []*externalapi.DomainHash) error {
+	return tis.database.Put(virtualParentsKey, binaryserialization.SerializeHashes(virtualParents))
+}
+
+func (tis *txIndexStore) convertTransactionIDToKey(transactionID *externalapi.DomainTransactionID) *database.Key {
+	return txIndexBucket.Key(transactionID.ByteSlice())
+}
+
+func (tis *txIndexStore) isAnythingStaged() bool {
+	return len(tis.toAdd) > 0 || len(tis.toRemove) > 0
+}
+
+func (tis *txIndexStore) getTxAcceptanceData(transactionID *externalapi.DomainTransactionID) (
+	txAcceptanceData *TxAcceptanceData, found bool, err error) {
+
+	if tis.isAnythingStaged() {
+		return nil, false, errors.Errorf("cannot get transaction acceptance data while staging isn't empty")
+	}
+
+	serializedTxAcceptanceData, err := tis.database.Get(tis.convertTransactionIDToKey(transactionID))
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	txAcceptanceData, err = deserializeTxAcceptanceData(serializedTxAcceptanceData)
+	if err != nil {
+		return nil, false, err
+	}
+	return txAcceptanceData, true, nil
+}
+
+func (tis *txIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
+	if tis.isAnythingStaged() {
+		return nil, errors.Errorf("cannot get the virtual parents while staging isn't empty")
+	}
+
+	serializedHashes, err := tis.database.Get(virtualParentsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return binaryserialization.DeserializeHashes(serializedHashes)
+}
+
+func (tis *txIndexStore) deleteAll() error {
+	// First we delete the virtual parents, so if anything goes wrong, the TX index will be marked as "not synced"
+	// and will be reset.
+	err := tis.database.Delete(virtualParentsKey)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := tis.database.Cursor(txIndexBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = tis.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
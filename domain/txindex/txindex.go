@@ -0,0 +1,230 @@
+package txindex
+
+import (
+	"sync"
+
+	"github.com/kaspanet/kaspad/domain"
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+)
+
+// TXIndex maintains an index between transaction IDs and the blocks
+// that include and accept them
+type TXIndex struct {
+	domain domain.Domain
+	store  *txIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new TX index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*TXIndex, error) {
+	txIndex := &TXIndex{
+		domain: domain,
+		store:  newTXIndexStore(database),
+	}
+	isSynced, err := txIndex.isSynced()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSynced {
+		err := txIndex.Reset()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return txIndex, nil
+}
+
+// Reset deletes the whole TX index and resyncs it from consensus.
+func (ti *TXIndex) Reset() error {
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	defer ti.store.discard()
+
+	err := ti.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualInfo, err := ti.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := ti.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	// Acceptance data is only guaranteed to exist for chain blocks above the
+	// pruning point, so that's where the index starts from.
+	chainPath, err := ti.domain.Consensus().GetVirtualSelectedParentChainFromBlock(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	const step = 1000
+	for position := 0; position < len(chainPath.Added); position += step {
+		end := position + step
+		if end > len(chainPath.Added) {
+			end = len(chainPath.Added)
+		}
+
+		err = ti.addChainBlocks(chainPath.Added[position:end])
+		if err != nil {
+			return err
+		}
+
+		err = ti.store.commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	return ti.store.updateAndCommitVirtualParentsWithoutTransaction(virtualInfo.ParentHashes)
+}
+
+func (ti *TXIndex) isSynced() (bool, error) {
+	txIndexVirtualParents, err := ti.store.getVirtualParents()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	virtualInfo, err := ti.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return externalapi.HashesEqual(virtualInfo.ParentHashes, txIndexVirtualParents), nil
+}
+
+// Update updates the TX index with the given DAG selected parent chain changes
+func (ti *TXIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.Update")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	// Changes staged by a failed update must not be left behind, since the store
+	// refuses to be read from while anything is staged
+	defer ti.store.discard()
+
+	chainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	if chainChanges != nil {
+		log.Tracef("Updating TX index with %d removed and %d added chain blocks",
+			len(chainChanges.Removed), len(chainChanges.Added))
+
+		err := ti.removeChainBlocks(chainChanges.Removed)
+		if err != nil {
+			return err
+		}
+
+		err = ti.addChainBlocks(chainChanges.Added)
+		if err != nil {
+			return err
+		}
+	}
+
+	ti.store.updateVirtualParents(virtualChangeSet.VirtualParents)
+
+	return ti.store.commit()
+}
+
+func (ti *TXIndex) addChainBlocks(chainBlockHashes []*externalapi.DomainHash) error {
+	if len(chainBlockHashes) == 0 {
+		return nil
+	}
+
+	chainBlocksAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(chainBlockHashes)
+	if err != nil {
+		return err
+	}
+
+	for i, acceptingBlockHash := range chainBlockHashes {
+		for _, blockAcceptanceData := range chainBlocksAcceptanceData[i] {
+			for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+				if !transactionAcceptanceData.IsAccepted {
+					continue
+				}
+				transactionID := consensushashing.TransactionID(transactionAcceptanceData.Transaction)
+				ti.store.add(transactionID, &TxAcceptanceData{
+					IncludingBlockHash: blockAcceptanceData.BlockHash,
+					AcceptingBlockHash: acceptingBlockHash,
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func (ti *TXIndex) removeChainBlocks(chainBlockHashes []*externalapi.DomainHash) error {
+	if len(chainBlockHashes) == 0 {
+		return nil
+	}
+
+	chainBlocksAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(chainBlockHashes)
+	if err != nil {
+		return err
+	}
+
+	for _, chainBlockAcceptanceData := range chainBlocksAcceptanceData {
+		for _, blockAcceptanceData := range chainBlockAcceptanceData {
+			for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+				if !transactionAcceptanceData.IsAccepted {
+					continue
+				}
+				ti.store.remove(consensushashing.TransactionID(transactionAcceptanceData.Transaction))
+			}
+		}
+	}
+	return nil
+}
+
+// TxAcceptanceData returns the acceptance data of the given transaction, if it
+// was accepted by the virtual's selected parent chain
+func (ti *TXIndex) TxAcceptanceData(transactionID *externalapi.DomainTransactionID) (
+	txAcceptanceData *TxAcceptanceData, found bool, err error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.TxAcceptanceData")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	return ti.store.getTxAcceptanceData(transactionID)
+}
+
+// TxsAcceptanceData returns the acceptance data of all the given transactions
+// that were accepted by the virtual's selected parent chain
+func (ti *TXIndex) TxsAcceptanceData(transactionIDs []*externalapi.DomainTransactionID) (TxAcceptanceDataByID, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.TxsAcceptanceData")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	txsAcceptanceData := make(TxAcceptanceDataByID, len(transactionIDs))
+	for _, transactionID := range transactionIDs {
+		txAcceptanceData, found, err := ti.store.getTxAcceptanceData(transactionID)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			txsAcceptanceData[*transactionID] = txAcceptanceData
+		}
+	}
+	return txsAcceptanceData, nil
+}
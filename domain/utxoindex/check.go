@@ -0,0 +1,110 @@
+package utxoindex
+
+import (
+	"fmt"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+// CheckAgainstVirtualUTXOSet compares the UTXO index stored in the given database against the
+// virtual UTXO set that virtualUTXOSetIterator goes over, without modifying the database.
+// virtualParents are the parents of the virtual that UTXO set belongs to.
+// Every inconsistency that's found is passed to reportInconsistency, along with the outpoint or
+// key it concerns. hasIndex is false if the database doesn't contain a UTXO index at all.
+// The iterator is closed once the check is done.
+func CheckAgainstVirtualUTXOSet(db database.Database, virtualParents []*externalapi.DomainHash,
+	virtualUTXOSetIterator externalapi.ReadOnlyUTXOSetIterator, reportInconsistency func(subject string, message string)) (
+	hasIndex bool, checkedCount uint64, err error) {
+
+	defer virtualUTXOSetIterator.Close()
+
+	store := newUTXOIndexStore(db)
+	utxoIndexVirtualParents, err := store.getVirtualParents()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, 0, nil
+		}
+		return false, 0, err
+	}
+
+	// An index that isn't synced to the virtual is reset on startup, so its
+	// contents aren't expected to match the virtual UTXO set
+	if !externalapi.HashesEqual(virtualParents, utxoIndexVirtualParents) {
+		reportInconsistency(string(virtualParentsKey.Suffix()), fmt.Sprintf("the UTXO index is synced to "+
+			"virtual parents %s, but the virtual parents are %s. It will be reset on the next startup",
+			utxoIndexVirtualParents, virtualParents))
+		return true, 0, nil
+	}
+
+	virtualSompiSupply := uint64(0)
+	foundCount := uint64(0)
+	for ok := virtualUTXOSetIterator.First(); ok; ok = virtualUTXOSetIterator.Next() {
+		outpoint, utxoEntry, err := virtualUTXOSetIterator.Get()
+		if err != nil {
+			return true, checkedCount, err
+		}
+		checkedCount++
+		virtualSompiSupply += utxoEntry.Amount()
+
+		key, err := store.convertOutpointToKey(store.bucketForScriptPublicKey(utxoEntry.ScriptPublicKey()), outpoint)
+		if err != nil {
+			return true, checkedCount, err
+		}
+		serializedIndexedUTXOEntry, err := db.Get(key)
+		if err != nil {
+			if database.IsNotFoundError(err) {
+				reportInconsistency(outpoint.String(), "the outpoint is in the virtual UTXO set, "+
+					"but it's missing from the UTXO index")
+				continue
+			}
+			return true, checkedCount, err
+		}
+		foundCount++
+		indexedUTXOEntry, err := deserializeUTXOEntry(serializedIndexedUTXOEntry)
+		if err != nil {
+			return true, checkedCount, err
+		}
+		if !indexedUTXOEntry.Equal(utxoEntry) {
+			reportInconsistency(outpoint.String(), "the UTXO entry in the UTXO index is different from "+
+				"the one in the virtual UTXO set")
+		}
+	}
+
+	// Any entry in the index beyond the ones that were found above is one that
+	// isn't in the virtual UTXO set
+	indexedCount, err := countIndexedUTXOs(db)
+	if err != nil {
+		return true, checkedCount, err
+	}
+	if indexedCount > foundCount {
+		reportInconsistency(string(utxoIndexBucket.Path()), fmt.Sprintf("the UTXO index has %d entries "+
+			"that aren't in the virtual UTXO set", indexedCount-foundCount))
+	}
+
+	circulatingSompiSupply, err := store.getCirculatingSompiSupply()
+	if err != nil && !database.IsNotFoundError(err) {
+		return true, checkedCount, err
+	}
+	if err == nil && circulatingSompiSupply != virtualSompiSupply {
+		reportInconsistency(string(circulatingSupplyKey.Suffix()), fmt.Sprintf("the circulating supply in "+
+			"the UTXO index is %d sompi, but the virtual UTXO set holds %d sompi",
+			circulatingSompiSupply, virtualSompiSupply))
+	}
+
+	return true, checkedCount, nil
+}
+
+func countIndexedUTXOs(db database.Database) (uint64, error) {
+	cursor, err := db.Cursor(utxoIndexBucket)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close()
+
+	count := uint64(0)
+	for cursor.Next() {
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,99 @@
+package utxoindex
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/utxo"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+)
+
+func TestCheckAgainstVirtualUTXOSet(t *testing.T) {
+	db, err := ldb.NewInMemoryLevelDB(8)
+	if err != nil {
+		t.Fatalf("NewInMemoryLevelDB: %s", err)
+	}
+	defer db.Close()
+
+	virtualParents := []*externalapi.DomainHash{externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1})}
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0}
+	utxoMap := make(map[externalapi.DomainOutpoint]externalapi.UTXOEntry)
+	utxoPairs := make([]*externalapi.OutpointAndUTXOEntryPair, 0)
+	for i := uint32(0); i < 3; i++ {
+		outpoint := &externalapi.DomainOutpoint{Index: i}
+		utxoEntry := utxo.NewUTXOEntry(uint64(i+1)*100, scriptPublicKey, false, 0)
+		utxoMap[*outpoint] = utxoEntry
+		utxoPairs = append(utxoPairs, &externalapi.OutpointAndUTXOEntryPair{Outpoint: outpoint, UTXOEntry: utxoEntry})
+	}
+
+	type inconsistency struct{ subject, message string }
+	check := func() (hasIndex bool, checkedCount uint64, inconsistencies []inconsistency) {
+		hasIndex, checkedCount, err := CheckAgainstVirtualUTXOSet(db, virtualParents,
+			utxo.NewUTXOCollection(utxoMap).Iterator(), func(subject string, message string) {
+				inconsistencies = append(inconsistencies, inconsistency{subject, message})
+			})
+		if err != nil {
+			t.Fatalf("CheckAgainstVirtualUTXOSet: %+v", err)
+		}
+		return hasIndex, checkedCount, inconsistencies
+	}
+
+	hasIndex, _, _ := check()
+	if hasIndex {
+		t.Fatalf("An empty database unexpectedly has a UTXO index")
+	}
+
+	store := newUTXOIndexStore(db)
+	err = store.initializeCirculatingSompiSupply()
+	if err != nil {
+		t.Fatalf("initializeCirculatingSompiSupply: %+v", err)
+	}
+	err = store.addAndCommitOutpointsWithoutTransaction(utxoPairs)
+	if err != nil {
+		t.Fatalf("addAndCommitOutpointsWithoutTransaction: %+v", err)
+	}
+	err = store.updateAndCommitVirtualParentsWithoutTransaction(virtualParents)
+	if err != nil {
+		t.Fatalf("updateAndCommitVirtualParentsWithoutTransaction: %+v", err)
+	}
+
+	hasIndex, checkedCount, inconsistencies := check()
+	if !hasIndex {
+		t.Fatalf("The UTXO index is unexpectedly missing")
+	}
+	if checkedCount != uint64(len(utxoMap)) {
+		t.Fatalf("Unexpected checked count. Want: %d, got: %d", len(utxoMap), checkedCount)
+	}
+	if len(inconsistencies) != 0 {
+		t.Fatalf("Unexpected inconsistencies: %v", inconsistencies)
+	}
+
+	// Change the virtual UTXO set behind the back of the index
+	missingOutpoint := externalapi.DomainOutpoint{Index: 100}
+	utxoMap[missingOutpoint] = utxo.NewUTXOEntry(1000, scriptPublicKey, false, 0)
+	delete(utxoMap, externalapi.DomainOutpoint{Index: 0})
+	_, _, inconsistencies = check()
+	expectedSubjects := map[string]bool{
+		missingOutpoint.String():              false,
+		string(utxoIndexBucket.Path()):        false,
+		string(circulatingSupplyKey.Suffix()): false,
+	}
+	for _, inconsistency := range inconsistencies {
+		if _, ok := expectedSubjects[inconsistency.subject]; !ok {
+			t.Fatalf("Unexpected inconsistency: %v", inconsistency)
+		}
+		expectedSubjects[inconsistency.subject] = true
+	}
+	for subject, found := range expectedSubjects {
+		if !found {
+			t.Fatalf("Expected an inconsistency of %s, but got %v", subject, inconsistencies)
+		}
+	}
+
+	// An index that isn't synced to the virtual isn't compared against it
+	virtualParents = []*externalapi.DomainHash{externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2})}
+	_, checkedCount, inconsistencies = check()
+	if checkedCount != 0 || len(inconsistencies) != 1 {
+		t.Fatalf("Expected a single inconsistency for an index that isn't synced, but got %v", inconsistencies)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
 	"github.com/kaspanet/kaspad/domain/dagconfig"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
 	"github.com/kaspanet/kaspad/infrastructure/logger"
 	"github.com/kaspanet/kaspad/util"
 	"github.com/kaspanet/kaspad/util/network"
@@ -30,6 +31,8 @@ import (
 const (
 	defaultConfigFilename      = "kaspad.conf"
 	defaultLogLevel            = "info"
+	defaultLogFormat           = "text"
+	defaultDbType              = dbfactory.DefaultType
 	defaultLogDirname          = "logs"
 	defaultLogFilename         = "kaspad.log"
 	defaultErrLogFilename      = "kaspad_err.log"
@@ -54,6 +57,9 @@ const (
 	sampleConfigFilename    = "sample-kaspad.conf"
 	defaultMaxUTXOCacheSize = 5_000_000_000
 	defaultProtocolVersion  = 5
+
+	defaultMempoolFilename     = "mempool.dat"
+	defaultMempoolSaveInterval = 10 * time.Minute
 )
 
 var (
@@ -81,6 +87,7 @@ type Flags struct {
 	ConfigFile                      string        `short:"C" long:"configfile" description:"Path to configuration file"`
 	AppDir                          string        `short:"b" long:"appdir" description:"Directory to store data"`
 	LogDir                          string        `long:"logdir" description:"Directory to log output."`
+	LogFormat                       string        `long:"logformat" description:"Format of log records {text, json} -- json writes every record as a single-line JSON object with its timestamp, level, subsystem, message and structured fields"`
 	AddPeers                        []string      `short:"a" long:"addpeer" description:"Add a peer to connect with at startup"`
 	ConnectPeers                    []string      `long:"connect" description:"Connect only to the specified peers at startup"`
 	DisableListen                   bool          `long:"nolisten" description:"Disable listening for incoming connections -- NOTE: Listening is automatically disabled if the --connect or --proxy options are used without also specifying listen interfaces via --listen"`
@@ -89,7 +96,7 @@ type Flags struct {
 	MaxInboundPeers                 int           `long:"maxinpeers" description:"Max number of inbound peers"`
 	EnableBanning                   bool          `long:"enablebanning" description:"Enable banning of misbehaving peers"`
 	BanDuration                     time.Duration `long:"banduration" description:"How long to ban misbehaving peers. Valid time units are {s, m, h}. Minimum 1 second"`
-	BanThreshold                    uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers."`
+	BanThreshold                    uint32        `long:"banthreshold" description:"Maximum allowed misbehavior score before disconnecting and banning misbehaving peers. Scores decay by half every 10 minutes"`
 	Whitelists                      []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned. (eg. 192.168.1.0/24 or ::1)"`
 	RPCListeners                    []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 16110, testnet: 16210)"`
 	RPCCert                         string        `long:"rpccert" description:"File containing the certificate file"`
@@ -97,22 +104,34 @@ type Flags struct {
 	RPCMaxClients                   int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
 	RPCMaxWebsockets                int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
 	RPCMaxConcurrentReqs            int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
+	JSONRPCListeners                []string      `long:"jsonrpclisten" description:"Add an interface/port to listen for JSON-RPC connections over HTTP and WebSocket (default port: 18110, testnet: 18210). JSON-RPC is disabled unless this is specified"`
+	JSONRPCAllowedOrigins           []string      `long:"jsonrpcallowedorigin" description:"Allow browsers on the given origin to make JSON-RPC requests, e.g. https://example.com. Use * to allow any origin"`
 	DisableRPC                      bool          `long:"norpc" description:"Disable built-in RPC server"`
 	SafeRPC                         bool          `long:"saferpc" description:"Disable RPC commands which affect the state of the node"`
+	RPCRoles                        []string      `long:"rpcrole" description:"Define an RPC role as <role>:<method>[,<method>...], where methods are RPC method names such as GetBlockDagInfo, or * for all methods"`
+	RPCUsers                        []string      `long:"rpcuser" description:"Add an RPC user as <username>:<password>:<role>. RPC authorization is disabled unless RPC users, tokens or an anonymous role are defined"`
+	RPCTokens                       []string      `long:"rpctoken" description:"Add a static RPC token as <token>:<role>"`
+	RPCAnonymousRole                string        `long:"rpcanonymousrole" description:"The RPC role of clients that present no credentials. If not set, such clients may not call any RPC method once RPC authorization is enabled"`
 	DisableDNSSeed                  bool          `long:"nodnsseed" description:"Disable DNS seeding for peers"`
 	DNSSeed                         string        `long:"dnsseed" description:"Override DNS seeds with specified hostname (Only 1 hostname allowed)"`
 	GRPCSeed                        string        `long:"grpcseed" description:"Hostname of gRPC server for seeding peers"`
 	ExternalIPs                     []string      `long:"externalip" description:"Add an ip to the list of local addresses we claim to listen on to peers"`
-	Proxy                           string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
+	Proxy                           string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050). Connecting to onion addresses requires this to be Tor's SOCKS5 port"`
 	ProxyUser                       string        `long:"proxyuser" description:"Username for proxy server"`
 	ProxyPass                       string        `long:"proxypass" default-mask:"-" description:"Password for proxy server"`
-	DbType                          string        `long:"dbtype" description:"Database backend to use for the Block DAG"`
+	Onion                           string        `long:"onion" description:"The address of a Tor v3 onion service that forwards to this node's P2P listener (eg. <56 characters>.onion:16111). It's advertised to peers instead of the node's IP addresses, and makes the node listen on localhost only unless --listen is specified"`
+	DbType                          string        `long:"dbtype" description:"Database backend to use for the Block DAG {leveldb, pebble, memory} -- pebble is only available in kaspad builds with -tags pebble, and is rejected otherwise -- memory keeps the DAG in memory only, and may only be used with --simnet or --devnet"`
 	Profile                         string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	Metrics                         string        `long:"metrics" description:"Serve Prometheus metrics over HTTP on the given interface/port, e.g. 127.0.0.1:9100. Metrics are disabled unless this is specified"`
 	LogLevel                        string        `short:"d" long:"loglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 	Upnp                            bool          `long:"upnp" description:"Use UPnP to map our listening port outside of NAT"`
 	MinRelayTxFee                   float64       `long:"minrelaytxfee" description:"The minimum transaction fee in KAS/kB to be considered a non-zero fee."`
 	MaxOrphanTxs                    uint64        `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
 	BlockMaxMass                    uint64        `long:"blockmaxmass" description:"Maximum transaction mass to be used when creating a block"`
+	RandomTxSelection               bool          `long:"randomtxselection" description:"Select the transactions of a block template at random, weighted by fee rate, as kaspad used to by default. By default, transactions are now selected greedily by the fee rates of their transaction packages"`
+	PersistMempool                  bool          `long:"persistmempool" description:"Save the mempool to disk on shutdown and periodically, and reload it on startup"`
+	MempoolFile                     string        `long:"mempoolfile" description:"File to save the mempool to (default: mempool.dat in the data directory)"`
+	MempoolSaveInterval             time.Duration `long:"mempoolsaveinterval" description:"How often to save the mempool when --persistmempool is set. Valid time units are {s, m, h}. 0 saves it only on shutdown"`
 	UserAgentComments               []string      `long:"uacomment" description:"Comment to add to the user agent -- See BIP 14 for more information."`
 	NoPeerBloomFilters              bool          `long:"nopeerbloomfilters" description:"Disable bloom filtering support"`
 	SigCacheMaxSize                 uint          `long:"sigcachemaxsize" description:"The maximum number of entries in the signature verification cache"`
@@ -120,8 +139,11 @@ type Flags struct {
 	RelayNonStd                     bool          `long:"relaynonstd" description:"Relay non-standard transactions regardless of the default settings for the active network."`
 	RejectNonStd                    bool          `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network."`
 	ResetDatabase                   bool          `long:"reset-db" description:"Reset database before starting node. It's needed when switching between subnetworks."`
+	RestoreSnapshot                 string        `long:"restore-snapshot" description:"Restore the database from a snapshot file made by the CreateSnapshot RPC before starting the node. The data directory must not contain a database"`
 	MaxUTXOCacheSize                uint64        `long:"maxutxocachesize" description:"Max size of loaded UTXO into ram from the disk in bytes"`
 	UTXOIndex                       bool          `long:"utxoindex" description:"Enable the UTXO index"`
+	TxIndex                         bool          `long:"txindex" description:"Enable the transaction index"`
+	AddressIndex                    bool          `long:"addressindex" description:"Enable the address history index"`
 	IsArchivalNode                  bool          `long:"archival" description:"Run as an archival node: don't delete old block data when moving the pruning point (Warning: heavy disk usage)'"`
 	AllowSubmitBlockWhenNotSynced   bool          `long:"allow-submit-block-when-not-synced" hidden:"true" description:"Allow the node to accept blocks from RPC while not synced (this flag is mainly used for testing)"`
 	EnableSanityCheckPruningUTXOSet bool          `long:"enable-sanity-check-pruning-utxo" hidden:"true" description:"When moving the pruning point - check that the utxo set matches the utxo commitment"`
@@ -141,6 +163,9 @@ type Config struct {
 	MinRelayTxFee util.Amount
 	Whitelists    []*net.IPNet
 	SubnetworkID  *externalapi.DomainSubnetworkID // nil in full nodes
+
+	// RPCAuthorization is nil when RPC authorization is disabled
+	RPCAuthorization *RPCAuthorization
 }
 
 // ServiceOptions defines the configuration options for the daemon as a service on
@@ -176,6 +201,8 @@ func defaultFlags() *Flags {
 	return &Flags{
 		ConfigFile:           defaultConfigFile,
 		LogLevel:             defaultLogLevel,
+		LogFormat:            defaultLogFormat,
+		DbType:               defaultDbType,
 		TargetOutboundPeers:  defaultTargetOutboundPeers,
 		MaxInboundPeers:      defaultMaxInboundPeers,
 		BanDuration:          defaultBanDuration,
@@ -193,6 +220,7 @@ func defaultFlags() *Flags {
 		MaxUTXOCacheSize:     defaultMaxUTXOCacheSize,
 		ServiceOptions:       &ServiceOptions{},
 		ProtocolVersion:      defaultProtocolVersion,
+		MempoolSaveInterval:  defaultMempoolSaveInterval,
 	}
 }
 
@@ -328,6 +356,11 @@ func LoadConfig() (*Config, error) {
 	// worry about changing names per network and such.
 	cfg.AppDir = filepath.Join(cfg.AppDir, cfg.NetParams().Name)
 
+	if cfg.MempoolFile == "" {
+		cfg.MempoolFile = filepath.Join(cfg.AppDir, defaultMempoolFilename)
+	}
+	cfg.MempoolFile = cleanAndExpandPath(cfg.MempoolFile)
+
 	// Logs directory is usually under the home directory, unless otherwise specified
 	if cfg.LogDir == "" {
 		cfg.LogDir = filepath.Join(cfg.AppDir, defaultLogDirname)
@@ -340,6 +373,15 @@ func LoadConfig() (*Config, error) {
 		os.Exit(0)
 	}
 
+	// Set the log format before any record is written, so that all the log
+	// files and stdout are written in the same format.
+	if err := logger.SetLogFormat(cfg.LogFormat); err != nil {
+		err := errors.Errorf("%s: %s", funcName, err.Error())
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Initialize log rotation. After log rotation has been initialized, the
 	// logger variables may be used.
 	logger.InitLog(filepath.Join(cfg.LogDir, defaultLogFilename), filepath.Join(cfg.LogDir, defaultErrLogFilename))
@@ -364,6 +406,50 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	// Validate the metrics listen address
+	if cfg.Metrics != "" {
+		_, _, err := net.SplitHostPort(cfg.Metrics)
+		if err != nil {
+			str := "%s: The metrics address is not in the form interface:port -- %s"
+			err := errors.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+
+	// Validate the database backend
+	err = dbfactory.ValidateType(cfg.DbType)
+	if err != nil {
+		err := errors.Errorf("%s: %s", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// An in-memory database loses the entire DAG on shutdown, so it's
+	// only allowed on networks that are meant to be thrown away
+	if cfg.DbType == dbfactory.TypeMemory && !cfg.Simnet && !cfg.Devnet {
+		str := "%s: The %s database type may only be used with --simnet or --devnet"
+		err := errors.Errorf(str, funcName, dbfactory.TypeMemory)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// A snapshot is restored into the data directory, which an in-memory
+	// database doesn't have
+	if cfg.RestoreSnapshot != "" {
+		if !dbfactory.IsPersistent(cfg.DbType) {
+			str := "%s: The restore-snapshot option may not be used with --dbtype=%s"
+			err := errors.Errorf(str, funcName, cfg.DbType)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+		cfg.RestoreSnapshot = cleanAndExpandPath(cfg.RestoreSnapshot)
+	}
+
 	// Don't allow ban durations that are too short.
 	if cfg.BanDuration < time.Second {
 		str := "%s: The banduration option may not be less than 1s -- parsed [%s]"
@@ -373,6 +459,14 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if cfg.MempoolSaveInterval < 0 {
+		str := "%s: The mempoolsaveinterval option may not be negative -- parsed [%s]"
+		err := errors.Errorf(str, funcName, cfg.MempoolSaveInterval)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Validate any given whitelisted IP addresses and networks.
 	if len(cfg.Whitelists) > 0 {
 		var ip net.IP
@@ -415,6 +509,36 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if cfg.Onion != "" {
+		onionAddresses, err := network.NormalizeAddresses([]string{cfg.Onion}, cfg.NetParams().DefaultPort)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Onion = onionAddresses[0]
+		// The onion host itself is fully validated when the address manager
+		// is created, since the config doesn't depend on the app layer
+		onionHost, _, err := net.SplitHostPort(cfg.Onion)
+		if err == nil && !strings.HasSuffix(strings.ToLower(onionHost), ".onion") {
+			err = errors.Errorf("%s is not an onion address", cfg.Onion)
+		}
+		if err != nil {
+			str := "%s: invalid --onion: %s"
+			err := errors.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+
+		// The onion service forwards connections to a local listener, so unless
+		// specified otherwise, listen on localhost only, so as not to expose the IP
+		// of the node
+		if len(cfg.Listeners) == 0 {
+			cfg.Listeners = []string{
+				net.JoinHostPort("127.0.0.1", cfg.NetParams().DefaultPort),
+			}
+		}
+	}
+
 	// --proxy or --connect without --listen disables listening.
 	if (cfg.Proxy != "" || len(cfg.ConnectPeers) > 0) &&
 		len(cfg.Listeners) == 0 {
@@ -449,6 +573,23 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	if cfg.DisableRPC && len(cfg.JSONRPCListeners) > 0 {
+		str := "%s: --norpc and --jsonrpclisten can not be used together"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	cfg.RPCAuthorization, err = ParseRPCAuthorization(cfg.RPCRoles, cfg.RPCUsers, cfg.RPCTokens, cfg.RPCAnonymousRole)
+	if err != nil {
+		str := "%s: invalid RPC authorization: %s"
+		err := errors.Errorf(str, funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	if cfg.RPCMaxConcurrentReqs < 0 {
 		str := "%s: The rpcmaxwebsocketconcurrentrequests option may " +
 			"not be less than 0 -- parsed [%d]"
@@ -518,6 +659,14 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	// Add default port to all JSON-RPC listener addresses if needed and remove
+	// duplicate addresses.
+	cfg.JSONRPCListeners, err = network.NormalizeAddresses(cfg.JSONRPCListeners,
+		cfg.NetParams().JSONRPCPort)
+	if err != nil {
+		return nil, err
+	}
+
 	// Disallow --addpeer and --connect used together
 	if len(cfg.AddPeers) > 0 && len(cfg.ConnectPeers) > 0 {
 		str := "%s: --addpeer and --connect can not be used together"
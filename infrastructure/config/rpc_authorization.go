@@ -0,0 +1,196 @@
+package config
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/pkg/errors"
+)
+
+// AllRPCMethods is the method name that allows an RPC role to call every RPC method
+const AllRPCMethods = "*"
+
+// RPCRole is a named set of RPC methods that RPC clients assigned to it are allowed to call
+type RPCRole struct {
+	Name string
+
+	// Methods are the names of the RPC methods the role may call, as given
+	// in --rpcrole. They are not validated against the RPC methods kaspad serves
+	Methods []string
+}
+
+// RPCAuthorization defines who may call which RPC methods. A nil RPCAuthorization
+// allows anyone to call every RPC method
+type RPCAuthorization struct {
+	// passwordsAndRoles maps usernames to their password and role
+	passwordsAndRoles map[string]*rpcPasswordAndRole
+	rolesByToken      map[string]*RPCRole
+	rolesByName       map[string]*RPCRole
+
+	// AnonymousRole is the role of clients that present no credentials.
+	// If it's nil, such clients may not call any RPC method
+	AnonymousRole *RPCRole
+}
+
+type rpcPasswordAndRole struct {
+	password string
+	role     *RPCRole
+}
+
+// Roles returns all the defined RPC roles
+func (a *RPCAuthorization) Roles() []*RPCRole {
+	roles := make([]*RPCRole, 0, len(a.rolesByName))
+	for _, role := range a.rolesByName {
+		roles = append(roles, role)
+	}
+	return roles
+}
+
+// UserRole returns the role of the user with the given username and password,
+// or nil if no such user exists
+func (a *RPCAuthorization) UserRole(username string, password string) *RPCRole {
+	passwordAndRole, ok := a.passwordsAndRoles[username]
+	if !ok || !constantTimeEqual(password, passwordAndRole.password) {
+		return nil
+	}
+	return passwordAndRole.role
+}
+
+// TokenRole returns the role of the given token, or nil if no such token exists
+func (a *RPCAuthorization) TokenRole(token string) *RPCRole {
+	for roleToken, role := range a.rolesByToken {
+		if constantTimeEqual(token, roleToken) {
+			return role
+		}
+	}
+	return nil
+}
+
+// IsAuthenticated returns whether a client that presents the given authorization, in the format
+// of an HTTP Authorization header value, has a role. It allows rejecting clients before reading
+// their requests
+func (a *RPCAuthorization) IsAuthenticated(authorization string) bool {
+	credentials, err := server.ParseAuthorization(authorization)
+	if err != nil {
+		return false
+	}
+	if credentials == nil {
+		return a.AnonymousRole != nil
+	}
+	if credentials.Token != "" {
+		return a.TokenRole(credentials.Token) != nil
+	}
+	return a.UserRole(credentials.Username, credentials.Password) != nil
+}
+
+// ParseRPCAuthorization parses the given --rpcrole, --rpcuser, --rpctoken and
+// --rpcanonymousrole values into an RPCAuthorization. It returns nil if neither
+// credentials nor an anonymous role are defined
+func ParseRPCAuthorization(roles []string, users []string, tokens []string, anonymousRole string) (
+	*RPCAuthorization, error) {
+
+	if len(users) == 0 && len(tokens) == 0 && anonymousRole == "" {
+		if len(roles) > 0 {
+			return nil, errors.Errorf("RPC roles are defined, but no RPC users, tokens or anonymous role use them")
+		}
+		return nil, nil
+	}
+
+	rolesByName, err := parseRPCRoles(roles)
+	if err != nil {
+		return nil, err
+	}
+	roleByName := func(name string) (*RPCRole, error) {
+		role, ok := rolesByName[name]
+		if !ok {
+			return nil, errors.Errorf("RPC role '%s' is not defined", name)
+		}
+		return role, nil
+	}
+
+	authorization := &RPCAuthorization{
+		passwordsAndRoles: make(map[string]*rpcPasswordAndRole, len(users)),
+		rolesByToken:      make(map[string]*RPCRole, len(tokens)),
+		rolesByName:       rolesByName,
+	}
+
+	for _, user := range users {
+		// The password may contain colons, so only the first and last ones are separators
+		firstColonIndex := strings.Index(user, ":")
+		lastColonIndex := strings.LastIndex(user, ":")
+		if firstColonIndex <= 0 || firstColonIndex == lastColonIndex || lastColonIndex == len(user)-1 {
+			return nil, errors.Errorf("RPC user must be of the form <username>:<password>:<role>")
+		}
+		username := user[:firstColonIndex]
+		if _, ok := authorization.passwordsAndRoles[username]; ok {
+			return nil, errors.Errorf("RPC user '%s' is defined more than once", username)
+		}
+		role, err := roleByName(user[lastColonIndex+1:])
+		if err != nil {
+			return nil, err
+		}
+		authorization.passwordsAndRoles[username] = &rpcPasswordAndRole{
+			password: user[firstColonIndex+1 : lastColonIndex],
+			role:     role,
+		}
+	}
+
+	for _, token := range tokens {
+		lastColonIndex := strings.LastIndex(token, ":")
+		if lastColonIndex <= 0 || lastColonIndex == len(token)-1 {
+			return nil, errors.Errorf("RPC token must be of the form <token>:<role>")
+		}
+		tokenValue := token[:lastColonIndex]
+		if _, ok := authorization.rolesByToken[tokenValue]; ok {
+			return nil, errors.Errorf("an RPC token is defined more than once")
+		}
+		role, err := roleByName(token[lastColonIndex+1:])
+		if err != nil {
+			return nil, err
+		}
+		authorization.rolesByToken[tokenValue] = role
+	}
+
+	if anonymousRole != "" {
+		authorization.AnonymousRole, err = roleByName(anonymousRole)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return authorization, nil
+}
+
+// parseRPCRoles parses roles of the form <role>:<method>[,<method>...]. Roles
+// that are defined more than once are allowed all the methods of all their definitions
+func parseRPCRoles(roles []string) (map[string]*RPCRole, error) {
+	rolesByName := make(map[string]*RPCRole)
+	for _, roleString := range roles {
+		parts := strings.SplitN(roleString, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("RPC role must be of the form <role>:<method>[,<method>...]")
+		}
+		name, methods := parts[0], parts[1]
+
+		role, ok := rolesByName[name]
+		if !ok {
+			role = &RPCRole{Name: name}
+			rolesByName[name] = role
+		}
+
+		for _, method := range strings.Split(methods, ",") {
+			method = strings.TrimSpace(method)
+			if method == "" {
+				return nil, errors.Errorf("RPC role '%s' has an empty RPC method", name)
+			}
+			role.Methods = append(role.Methods, method)
+		}
+	}
+
+	return rolesByName, nil
+}
+
+func constantTimeEqual(a string, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
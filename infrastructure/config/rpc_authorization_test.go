@@ -0,0 +1,88 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRPCAuthorization(t *testing.T) {
+	roles := []string{
+		"admin:*",
+		"reader:GetInfo,getBlockDagInfo",
+		"reader: GetBlock",
+	}
+	users := []string{"alice:pass:with:colons:admin"}
+	tokens := []string{"some-token:reader"}
+
+	authorization, err := ParseRPCAuthorization(roles, users, tokens, "reader")
+	if err != nil {
+		t.Fatalf("ParseRPCAuthorization: %s", err)
+	}
+
+	adminRole := authorization.UserRole("alice", "pass:with:colons")
+	if adminRole == nil || adminRole.Name != "admin" {
+		t.Fatalf("Unexpected role for alice: %+v", adminRole)
+	}
+	if !reflect.DeepEqual(adminRole.Methods, []string{AllRPCMethods}) {
+		t.Fatalf("Unexpected methods for admin: %s", adminRole.Methods)
+	}
+	if authorization.UserRole("alice", "wrong") != nil {
+		t.Fatalf("Got a role for alice with a wrong password")
+	}
+	if authorization.UserRole("bob", "pass:with:colons") != nil {
+		t.Fatalf("Got a role for a user that doesn't exist")
+	}
+
+	readerRole := authorization.TokenRole("some-token")
+	if readerRole == nil || readerRole != authorization.AnonymousRole {
+		t.Fatalf("Unexpected role for token: %+v", readerRole)
+	}
+	expectedReaderMethods := []string{"GetInfo", "getBlockDagInfo", "GetBlock"}
+	if !reflect.DeepEqual(readerRole.Methods, expectedReaderMethods) {
+		t.Fatalf("Unexpected methods for reader: got %s, want %s", readerRole.Methods, expectedReaderMethods)
+	}
+	if len(authorization.Roles()) != 2 {
+		t.Fatalf("Unexpected number of roles: %d", len(authorization.Roles()))
+	}
+	if authorization.TokenRole("other-token") != nil {
+		t.Fatalf("Got a role for a token that doesn't exist")
+	}
+}
+
+func TestParseRPCAuthorizationDisabled(t *testing.T) {
+	authorization, err := ParseRPCAuthorization(nil, nil, nil, "")
+	if err != nil {
+		t.Fatalf("ParseRPCAuthorization: %s", err)
+	}
+	if authorization != nil {
+		t.Fatalf("Expected RPC authorization to be disabled")
+	}
+}
+
+func TestParseRPCAuthorizationErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		roles         []string
+		users         []string
+		tokens        []string
+		anonymousRole string
+	}{
+		{name: "roles without users", roles: []string{"admin:*"}},
+		{name: "malformed role", roles: []string{"admin"}, anonymousRole: "admin"},
+		{name: "empty method", roles: []string{"admin:GetInfo,"}, anonymousRole: "admin"},
+		{name: "user without role", roles: []string{"admin:*"}, users: []string{"alice:pass"}},
+		{name: "user without username", roles: []string{"admin:*"}, users: []string{":pass:admin"}},
+		{name: "duplicate user", roles: []string{"admin:*"}, users: []string{"alice:a:admin", "alice:b:admin"}},
+		{name: "user with undefined role", roles: []string{"admin:*"}, users: []string{"alice:pass:reader"}},
+		{name: "token without role", roles: []string{"admin:*"}, tokens: []string{"token"}},
+		{name: "duplicate token", roles: []string{"admin:*"}, tokens: []string{"token:admin", "token:admin"}},
+		{name: "undefined anonymous role", roles: []string{"admin:*"}, anonymousRole: "reader"},
+	}
+
+	for _, test := range tests {
+		_, err := ParseRPCAuthorization(test.roles, test.users, test.tokens, test.anonymousRole)
+		if err == nil {
+			t.Errorf("%s: expected an error but got none", test.name)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/pkg/errors"
+)
+
+// RPCCredentialFlags holds the credentials an RPC client presents to kaspad's RPC server.
+type RPCCredentialFlags struct {
+	RPCUser     string `long:"rpcuser" description:"Username for RPC connections"`
+	RPCPassword string `long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
+	RPCToken    string `long:"rpctoken" default-mask:"-" description:"Static token for RPC connections. Can't be used together with --rpcuser"`
+}
+
+// ValidateRPCCredentials makes sure the RPC credential flags are consistent
+func (rcf *RPCCredentialFlags) ValidateRPCCredentials() error {
+	if rcf.RPCToken != "" && rcf.RPCUser != "" {
+		return errors.New("--rpctoken and --rpcuser can't be used together")
+	}
+	if rcf.RPCUser == "" && rcf.RPCPassword != "" {
+		return errors.New("--rpcpass requires --rpcuser")
+	}
+	return nil
+}
+
+// RPCAuthorization returns the authorization to present to the RPC server,
+// or an empty string if no credentials were given
+func (rcf *RPCCredentialFlags) RPCAuthorization() string {
+	credentials := &server.Credentials{
+		Username: rcf.RPCUser,
+		Password: rcf.RPCPassword,
+		Token:    rcf.RPCToken,
+	}
+	return credentials.Authorization()
+}
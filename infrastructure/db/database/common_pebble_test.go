@@ -0,0 +1,38 @@
+//go:build pebble
+// +build pebble
+
+package database_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/pebbledb"
+)
+
+func init() {
+	databasePrepareFuncs = append(databasePrepareFuncs, preparePebbleForTest)
+}
+
+func preparePebbleForTest(t *testing.T, testName string) (db database.Database, name string, teardownFunc func()) {
+	// Create a temp db to run tests against
+	path, err := ioutil.TempDir("", testName)
+	if err != nil {
+		t.Fatalf("%s: TempDir unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	db, err = pebbledb.NewPebbleDB(path, 8)
+	if err != nil {
+		t.Fatalf("%s: Open unexpectedly "+
+			"failed: %s", testName, err)
+	}
+	teardownFunc = func() {
+		err = db.Close()
+		if err != nil {
+			t.Fatalf("%s: Close unexpectedly "+
+				"failed: %s", testName, err)
+		}
+	}
+	return db, "pebble", teardownFunc
+}
@@ -14,6 +14,9 @@ type Database interface {
 	// Begin begins a new database transaction.
 	Begin() (Transaction, error)
 
+	// Snapshot takes a read-only, point-in-time snapshot of the database.
+	Snapshot() (Snapshot, error)
+
 	// Compact compacts the database instance.
 	Compact() error
 
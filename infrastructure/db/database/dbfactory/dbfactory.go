@@ -0,0 +1,157 @@
+package dbfactory
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/pebbledb"
+	"github.com/pkg/errors"
+)
+
+const (
+	// TypeLevelDB is the LevelDB database backend
+	TypeLevelDB = "leveldb"
+
+	// TypePebble is the Pebble database backend
+	TypePebble = "pebble"
+
+	// TypeMemory is a database that's kept entirely in memory, and is
+	// discarded once it's closed
+	TypeMemory = "memory"
+)
+
+// DefaultType is the database backend that's used when none is specified
+const DefaultType = TypeLevelDB
+
+// typeFileName is the name of the file in the database directory that records
+// which backend the database was created with
+const typeFileName = "dbtype"
+
+// SupportedTypes returns all the database backends that can be opened by this build
+func SupportedTypes() []string {
+	supportedTypes := []string{TypeLevelDB}
+	if pebbledb.Available {
+		supportedTypes = append(supportedTypes, TypePebble)
+	}
+	return append(supportedTypes, TypeMemory)
+}
+
+// ValidateType returns an error if the given database backend is unknown, or if it
+// isn't available in this build
+func ValidateType(dbType string) error {
+	switch dbType {
+	case TypeLevelDB, TypeMemory:
+		return nil
+	case TypePebble:
+		if !pebbledb.Available {
+			return pebbledb.ErrNotAvailable
+		}
+		return nil
+	default:
+		return errors.Errorf("unknown database type '%s'. Supported types: %s",
+			dbType, strings.Join(SupportedTypes(), ", "))
+	}
+}
+
+// IsPersistent returns whether databases of the given backend are stored on disk
+func IsPersistent(dbType string) bool {
+	return dbType != TypeMemory
+}
+
+// Open opens a database of the given backend in the given path. If it doesn't exist, it is created.
+// The path is ignored for in-memory databases.
+func Open(dbType string, path string, cacheSizeMiB int) (database.Database, error) {
+	switch dbType {
+	case TypeLevelDB:
+		db, err := ldb.NewLevelDB(path, cacheSizeMiB)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	case TypeMemory:
+		db, err := ldb.NewInMemoryLevelDB(cacheSizeMiB)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	case TypePebble:
+		return pebbledb.NewPebbleDB(path, cacheSizeMiB)
+	default:
+		return nil, ValidateType(dbType)
+	}
+}
+
+// OpenReadOnly opens the existing database of the given backend in the given path for
+// reading only. In-memory databases can't be opened this way, since they're never
+// stored anywhere.
+func OpenReadOnly(dbType string, path string, cacheSizeMiB int) (database.Database, error) {
+	switch dbType {
+	case TypeLevelDB:
+		db, err := ldb.NewReadOnlyLevelDB(path, cacheSizeMiB)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	case TypeMemory:
+		return nil, errors.Errorf("%s databases can't be opened read-only", TypeMemory)
+	case TypePebble:
+		return pebbledb.NewReadOnlyPebbleDB(path, cacheSizeMiB)
+	default:
+		return nil, ValidateType(dbType)
+	}
+}
+
+// ReadType returns the backend of the database in the given path.
+// Databases that were created before the backend was recorded are always
+// LevelDB databases. An empty string is returned if there's no database in
+// the given path.
+func ReadType(path string) (string, error) {
+	typeBytes, err := os.ReadFile(filepath.Join(path, typeFileName))
+	if err == nil {
+		return strings.TrimSpace(string(typeBytes)), nil
+	}
+	if !os.IsNotExist(err) {
+		return "", errors.WithStack(err)
+	}
+
+	// Both LevelDB and Pebble keep a CURRENT file that points to their manifest
+	_, err = os.Stat(filepath.Join(path, "CURRENT"))
+	if err == nil {
+		return TypeLevelDB, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", errors.WithStack(err)
+	}
+	return "", nil
+}
+
+// WriteType records the backend of the database in the given path
+func WriteType(path string, dbType string) error {
+	err := os.MkdirAll(path, 0700)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = os.WriteFile(filepath.Join(path, typeFileName), []byte(dbType), 0600)
+	return errors.WithStack(err)
+}
+
+// CheckType makes sure that the database in the given path, if there is one, was created
+// with the given backend, and records the backend for new databases.
+func CheckType(path string, dbType string) error {
+	existingType, err := ReadType(path)
+	if err != nil {
+		return err
+	}
+	if existingType == "" {
+		return WriteType(path, dbType)
+	}
+	if existingType != dbType {
+		return errors.Errorf("the database in %s is a %s database, but --dbtype=%s was given. "+
+			"Either run kaspad with --dbtype=%s, or convert the database with `kaspadb convert --dbtype=%s`",
+			path, existingType, dbType, existingType, dbType)
+	}
+	return nil
+}
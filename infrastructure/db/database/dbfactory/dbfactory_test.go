@@ -0,0 +1,134 @@
+package dbfactory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/pebbledb"
+	"github.com/pkg/errors"
+)
+
+func TestCheckType(t *testing.T) {
+	path := t.TempDir()
+
+	// A new database records the type it's created with
+	err := CheckType(path, TypeLevelDB)
+	if err != nil {
+		t.Fatalf("CheckType: %s", err)
+	}
+	dbType, err := ReadType(path)
+	if err != nil {
+		t.Fatalf("ReadType: %s", err)
+	}
+	if dbType != TypeLevelDB {
+		t.Fatalf("Unexpected type. Want: %s, got: %s", TypeLevelDB, dbType)
+	}
+
+	err = CheckType(path, TypeLevelDB)
+	if err != nil {
+		t.Fatalf("CheckType: %s", err)
+	}
+	err = CheckType(path, TypePebble)
+	if err == nil {
+		t.Fatalf("CheckType unexpectedly accepted a pebble type for a leveldb database")
+	}
+}
+
+func TestReadTypeOfUnrecordedDatabase(t *testing.T) {
+	path := t.TempDir()
+	dbType, err := ReadType(path)
+	if err != nil {
+		t.Fatalf("ReadType: %s", err)
+	}
+	if dbType != "" {
+		t.Fatalf("Unexpected type for an empty directory: %s", dbType)
+	}
+
+	// Databases from before types were recorded are always leveldb databases
+	db, err := Open(TypeLevelDB, path, 8)
+	if err != nil {
+		t.Fatalf("Open: %s", err)
+	}
+	err = db.Close()
+	if err != nil {
+		t.Fatalf("Close: %s", err)
+	}
+	_, err = os.Stat(filepath.Join(path, typeFileName))
+	if !os.IsNotExist(err) {
+		t.Fatalf("Open unexpectedly recorded the database type")
+	}
+	dbType, err = ReadType(path)
+	if err != nil {
+		t.Fatalf("ReadType: %s", err)
+	}
+	if dbType != TypeLevelDB {
+		t.Fatalf("Unexpected type. Want: %s, got: %s", TypeLevelDB, dbType)
+	}
+}
+
+func TestValidateType(t *testing.T) {
+	err := ValidateType(TypeLevelDB)
+	if err != nil {
+		t.Fatalf("ValidateType: %s", err)
+	}
+	err = ValidateType("rocksdb")
+	if err == nil {
+		t.Fatalf("ValidateType unexpectedly accepted an unknown type")
+	}
+
+	// Pebble must be rejected up front in builds that can't open it
+	err = ValidateType(TypePebble)
+	if pebbledb.Available && err != nil {
+		t.Fatalf("ValidateType: %s", err)
+	}
+	if !pebbledb.Available && !errors.Is(err, pebbledb.ErrNotAvailable) {
+		t.Fatalf("Unexpected error for the unavailable pebble type: %v", err)
+	}
+}
+
+func TestOpenReadOnly(t *testing.T) {
+	path := t.TempDir()
+
+	_, err := OpenReadOnly(TypeLevelDB, path, 8)
+	if err == nil {
+		t.Fatalf("OpenReadOnly unexpectedly created a database")
+	}
+
+	db, err := Open(TypeLevelDB, path, 8)
+	if err != nil {
+		t.Fatalf("Open: %s", err)
+	}
+	key := database.MakeBucket(nil).Key([]byte("key"))
+	err = db.Put(key, []byte("value"))
+	if err != nil {
+		t.Fatalf("Put: %s", err)
+	}
+	err = db.Close()
+	if err != nil {
+		t.Fatalf("Close: %s", err)
+	}
+
+	readOnlyDB, err := OpenReadOnly(TypeLevelDB, path, 8)
+	if err != nil {
+		t.Fatalf("OpenReadOnly: %s", err)
+	}
+	defer readOnlyDB.Close()
+	value, err := readOnlyDB.Get(key)
+	if err != nil {
+		t.Fatalf("Get: %s", err)
+	}
+	if string(value) != "value" {
+		t.Fatalf("Unexpected value. Want: value, got: %s", value)
+	}
+	err = readOnlyDB.Put(key, []byte("other value"))
+	if err == nil {
+		t.Fatalf("Put unexpectedly succeeded on a read-only database")
+	}
+
+	_, err = OpenReadOnly(TypeMemory, "", 8)
+	if err == nil {
+		t.Fatalf("OpenReadOnly unexpectedly opened an in-memory database")
+	}
+}
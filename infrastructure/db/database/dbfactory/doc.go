@@ -0,0 +1,16 @@
+/*
+Package dbfactory opens kaspad databases by the name of their backend.
+
+The supported backends are:
+
+	leveldb - the default, implemented by package ldb
+	pebble  - implemented by package pebbledb. Only available when built with the "pebble" build tag
+	memory  - a leveldb instance that's kept entirely in memory and discarded once it's closed.
+	          Meant for tests and throwaway nodes
+
+Every database directory records the backend it was created with, so that a
+database is never opened by the wrong backend. In-memory databases don't have a
+directory, so nothing is recorded for them. Databases that were created
+before backends were recorded are LevelDB databases.
+*/
+package dbfactory
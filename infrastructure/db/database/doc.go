@@ -6,8 +6,10 @@ Overview
 This package provides a database layer to store and retrieve data in a simple
 and efficient manner.
 
-The current backend is ffldb, which makes use of leveldb, flat files, and strict
-checksums in key areas to ensure data integrity.
+The available backends are ldb, which makes use of leveldb either on disk or entirely
+in memory, and pebbledb, which makes use of Pebble and is only compiled in with the
+"pebble" build tag. Package dbfactory
+opens either of them by name, as selected by kaspad's --dbtype flag.
 
 Implementors of additional backends are required to implement the following interfaces:
 
@@ -30,5 +32,10 @@ transaction then it will be available to get within the same transaction.
 Cursor
 
 This iterates over database entries given some bucket.
+
+Snapshot
+
+This defines the interface of a read-only view of a database as it was when the
+snapshot was taken.
 */
 package database
@@ -6,6 +6,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 	ldbErrors "github.com/syndtr/goleveldb/leveldb/errors"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/storage"
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
@@ -17,9 +18,7 @@ type LevelDB struct {
 // NewLevelDB opens a leveldb instance defined by the given path.
 func NewLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
 	// Open leveldb. If it doesn't exist, create it.
-	options := Options()
-	options.BlockCacheCapacity = cacheSizeMiB * opt.MiB
-	options.WriteBuffer = (cacheSizeMiB * opt.MiB) / 2
+	options := optionsWithCacheSize(cacheSizeMiB)
 	ldb, err := leveldb.OpenFile(path, &options)
 
 	// If the database is corrupted, attempt to recover.
@@ -48,12 +47,62 @@ func NewLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
 	return db, nil
 }
 
+// NewInMemoryLevelDB opens a leveldb instance that's stored entirely in
+// memory rather than on disk. Its contents are discarded once it's closed.
+func NewInMemoryLevelDB(cacheSizeMiB int) (*LevelDB, error) {
+	options := optionsWithCacheSize(cacheSizeMiB)
+	ldb, err := leveldb.Open(storage.NewMemStorage(), &options)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	db := &LevelDB{
+		ldb: ldb,
+	}
+	return db, nil
+}
+
+// NewReadOnlyLevelDB opens an existing leveldb instance defined by the given
+// path for reading only. Unlike NewLevelDB, it never creates the database nor
+// attempts to recover it from corruption, and all writes to it fail.
+func NewReadOnlyLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
+	options := optionsWithCacheSize(cacheSizeMiB)
+	options.ReadOnly = true
+	options.ErrorIfMissing = true
+	ldb, err := leveldb.OpenFile(path, &options)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	db := &LevelDB{
+		ldb: ldb,
+	}
+	return db, nil
+}
+
+func optionsWithCacheSize(cacheSizeMiB int) opt.Options {
+	options := Options()
+	options.BlockCacheCapacity = cacheSizeMiB * opt.MiB
+	options.WriteBuffer = (cacheSizeMiB * opt.MiB) / 2
+	return options
+}
+
 // Compact compacts the leveldb instance.
 func (db *LevelDB) Compact() error {
 	err := db.ldb.CompactRange(util.Range{Start: nil, Limit: nil})
 	return errors.WithStack(err)
 }
 
+// Stats returns the internal statistics of the leveldb instance.
+func (db *LevelDB) Stats() (*leveldb.DBStats, error) {
+	stats := &leveldb.DBStats{}
+	err := db.ldb.Stats(stats)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return stats, nil
+}
+
 // Close closes the leveldb instance.
 func (db *LevelDB) Close() error {
 	err := db.ldb.Close()
@@ -0,0 +1,80 @@
+package ldb
+
+import (
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// LevelDBSnapshot is a thin wrapper around native leveldb snapshots.
+type LevelDBSnapshot struct {
+	snapshot   *leveldb.Snapshot
+	isReleased bool
+}
+
+// Snapshot takes a read-only, point-in-time snapshot of the database.
+func (db *LevelDB) Snapshot() (database.Snapshot, error) {
+	snapshot, err := db.ldb.GetSnapshot()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &LevelDBSnapshot{
+		snapshot:   snapshot,
+		isReleased: false,
+	}, nil
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (s *LevelDBSnapshot) Get(key *database.Key) ([]byte, error) {
+	if s.isReleased {
+		return nil, errors.New("cannot get from a released snapshot")
+	}
+	data, err := s.snapshot.Get(key.Bytes(), nil)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil, errors.Wrapf(database.ErrNotFound,
+				"key %s not found", key)
+		}
+		return nil, errors.WithStack(err)
+	}
+	return data, nil
+}
+
+// Has returns true if the snapshot does contains the
+// given key.
+func (s *LevelDBSnapshot) Has(key *database.Key) (bool, error) {
+	if s.isReleased {
+		return false, errors.New("cannot has from a released snapshot")
+	}
+	exists, err := s.snapshot.Has(key.Bytes(), nil)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return exists, nil
+}
+
+// Cursor begins a new cursor over the given bucket.
+func (s *LevelDBSnapshot) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	if s.isReleased {
+		return nil, errors.New("cannot open a cursor from a released snapshot")
+	}
+	ldbIterator := s.snapshot.NewIterator(util.BytesPrefix(bucket.Path()), nil)
+
+	return &LevelDBCursor{
+		ldbIterator: ldbIterator,
+		bucket:      bucket,
+		isClosed:    false,
+	}, nil
+}
+
+// Release releases the snapshot.
+func (s *LevelDBSnapshot) Release() error {
+	if s.isReleased {
+		return errors.New("cannot release an already released snapshot")
+	}
+	s.isReleased = true
+	s.snapshot.Release()
+	return nil
+}
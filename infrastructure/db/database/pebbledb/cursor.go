@@ -0,0 +1,162 @@
+//go:build pebble
+// +build pebble
+
+package pebbledb
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// PebbleCursor is a thin wrapper around native pebble iterators.
+type PebbleCursor struct {
+	db             *PebbleDB
+	pebbleIterator *pebble.Iterator
+	bucket         *database.Bucket
+
+	// isPositioned is false until the cursor is first moved. Unlike leveldb
+	// iterators, pebble iterators don't treat the first call to Next as First
+	isPositioned bool
+	isClosed     bool
+}
+
+// Cursor begins a new cursor over the given prefix.
+func (db *PebbleDB) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	pebbleIterator, err := db.pebbleDB.NewIter(bucketIterOptions(bucket))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return newPebbleCursor(db, pebbleIterator, bucket), nil
+}
+
+func newPebbleCursor(db *PebbleDB, pebbleIterator *pebble.Iterator, bucket *database.Bucket) *PebbleCursor {
+	cursor := &PebbleCursor{
+		db:             db,
+		pebbleIterator: pebbleIterator,
+		bucket:         bucket,
+		isPositioned:   false,
+		isClosed:       false,
+	}
+
+	db.openResourcesLock.Lock()
+	defer db.openResourcesLock.Unlock()
+	db.openCursors[cursor] = struct{}{}
+
+	return cursor
+}
+
+// bucketIterOptions returns iterator options that limit an iterator to the given bucket
+func bucketIterOptions(bucket *database.Bucket) *pebble.IterOptions {
+	return &pebble.IterOptions{
+		LowerBound: bucket.Path(),
+		UpperBound: prefixUpperBound(bucket.Path()),
+	}
+}
+
+// prefixUpperBound returns the smallest key that's greater than every key
+// with the given prefix, or nil if there's no such key
+func prefixUpperBound(prefix []byte) []byte {
+	upperBound := append([]byte{}, prefix...)
+	for i := len(upperBound) - 1; i >= 0; i-- {
+		upperBound[i]++
+		if upperBound[i] != 0 {
+			return upperBound[:i+1]
+		}
+	}
+	return nil
+}
+
+// Next moves the iterator to the next key/value pair. It returns whether the
+// iterator is exhausted. Panics if the cursor is closed.
+func (c *PebbleCursor) Next() bool {
+	if c.isClosed {
+		panic("cannot call next on a closed cursor")
+	}
+	if !c.isPositioned {
+		c.isPositioned = true
+		return c.pebbleIterator.First()
+	}
+	return c.pebbleIterator.Next()
+}
+
+// First moves the iterator to the first key/value pair. It returns false if
+// such a pair does not exist. Panics if the cursor is closed.
+func (c *PebbleCursor) First() bool {
+	if c.isClosed {
+		panic("cannot call first on a closed cursor")
+	}
+	c.isPositioned = true
+	return c.pebbleIterator.First()
+}
+
+// Seek moves the iterator to the first key/value pair whose key is greater
+// than or equal to the given key. It returns ErrNotFound if such pair does not
+// exist.
+func (c *PebbleCursor) Seek(key *database.Key) error {
+	if c.isClosed {
+		return errors.New("cannot seek a closed cursor")
+	}
+
+	c.isPositioned = true
+	found := c.pebbleIterator.SeekGE(key.Bytes())
+	if !found {
+		return errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+
+	// Use c.pebbleIterator.Key because c.Key removes the prefix from the key
+	if !bytes.Equal(c.pebbleIterator.Key(), key.Bytes()) {
+		return errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+
+	return nil
+}
+
+// Key returns the key of the current key/value pair, or ErrNotFound if done.
+// Note that the key is trimmed to not include the prefix the cursor was opened
+// with. The caller should not modify the contents of the returned slice, and
+// its contents may change on the next call to Next.
+func (c *PebbleCursor) Key() (*database.Key, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the key of a closed cursor")
+	}
+	if !c.isPositioned || !c.pebbleIterator.Valid() {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"key of an exhausted cursor")
+	}
+	suffix := bytes.TrimPrefix(c.pebbleIterator.Key(), c.bucket.Path())
+	return c.bucket.Key(suffix), nil
+}
+
+// Value returns the value of the current key/value pair, or ErrNotFound if done.
+// The caller should not modify the contents of the returned slice, and its
+// contents may change on the next call to Next.
+func (c *PebbleCursor) Value() ([]byte, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the value of a closed cursor")
+	}
+	if !c.isPositioned || !c.pebbleIterator.Valid() {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"value of an exhausted cursor")
+	}
+	return c.pebbleIterator.Value(), nil
+}
+
+// Close releases associated resources.
+func (c *PebbleCursor) Close() error {
+	if c.isClosed {
+		return errors.New("cannot close an already closed cursor")
+	}
+	c.isClosed = true
+
+	c.db.openResourcesLock.Lock()
+	delete(c.db.openCursors, c)
+	c.db.openResourcesLock.Unlock()
+
+	err := c.pebbleIterator.Close()
+	c.pebbleIterator = nil
+	c.bucket = nil
+	return errors.WithStack(err)
+}
@@ -0,0 +1,21 @@
+//go:build !pebble
+// +build !pebble
+
+package pebbledb
+
+import "github.com/kaspanet/kaspad/infrastructure/db/database"
+
+// Available is true if kaspad was built with the Pebble backend
+const Available = false
+
+// NewPebbleDB always returns ErrNotAvailable, since kaspad was built
+// without the "pebble" build tag.
+func NewPebbleDB(path string, cacheSizeMiB int) (database.Database, error) {
+	return nil, ErrNotAvailable
+}
+
+// NewReadOnlyPebbleDB always returns ErrNotAvailable, since kaspad was built
+// without the "pebble" build tag.
+func NewReadOnlyPebbleDB(path string, cacheSizeMiB int) (database.Database, error) {
+	return nil, ErrNotAvailable
+}
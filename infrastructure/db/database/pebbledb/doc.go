@@ -0,0 +1,12 @@
+/*
+Package pebbledb implements the database interfaces on top of Pebble
+(https://github.com/cockroachdb/pebble), a LevelDB/RocksDB inspired key-value
+store with better write throughput and less write amplification than goleveldb.
+
+The Pebble backend is only compiled in when building with the "pebble" build tag:
+
+	go build -tags pebble ./...
+
+In builds without the tag, NewPebbleDB returns ErrNotAvailable.
+*/
+package pebbledb
@@ -0,0 +1,8 @@
+package pebbledb
+
+import "github.com/pkg/errors"
+
+// ErrNotAvailable is returned by NewPebbleDB when kaspad was built
+// without the "pebble" build tag
+var ErrNotAvailable = errors.New("the pebble database backend is not available in this build. " +
+	"Rebuild with `-tags pebble` to enable it")
@@ -0,0 +1,170 @@
+//go:build pebble
+// +build pebble
+
+package pebbledb
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// Available is true if kaspad was built with the Pebble backend
+const Available = true
+
+const mib = 1024 * 1024
+
+// PebbleDB defines a thin wrapper around pebble.
+type PebbleDB struct {
+	pebbleDB *pebble.DB
+
+	// openCursors and openSnapshots are closed by Close. Unlike leveldb, pebble
+	// refuses to close while iterators or snapshots are still open
+	openResourcesLock sync.Mutex
+	openCursors       map[*PebbleCursor]struct{}
+	openSnapshots     map[*PebbleSnapshot]struct{}
+}
+
+func newPebbleDB(pebbleDB *pebble.DB) *PebbleDB {
+	return &PebbleDB{
+		pebbleDB:      pebbleDB,
+		openCursors:   make(map[*PebbleCursor]struct{}),
+		openSnapshots: make(map[*PebbleSnapshot]struct{}),
+	}
+}
+
+// NewPebbleDB opens a pebble instance defined by the given path.
+// If it doesn't exist, it is created.
+func NewPebbleDB(path string, cacheSizeMiB int) (database.Database, error) {
+	cache := pebble.NewCache(int64(cacheSizeMiB) * mib)
+	defer cache.Unref()
+
+	options := &pebble.Options{
+		Cache:        cache,
+		MemTableSize: uint64(cacheSizeMiB) * mib / 2,
+	}
+	pebbleDB, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	log.Infof("Opened pebble database at %s", path)
+	return newPebbleDB(pebbleDB), nil
+}
+
+// NewReadOnlyPebbleDB opens an existing pebble instance defined by the given
+// path for reading only. All writes to it fail.
+func NewReadOnlyPebbleDB(path string, cacheSizeMiB int) (database.Database, error) {
+	cache := pebble.NewCache(int64(cacheSizeMiB) * mib)
+	defer cache.Unref()
+
+	options := &pebble.Options{
+		Cache:            cache,
+		ReadOnly:         true,
+		ErrorIfNotExists: true,
+	}
+	pebbleDB, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	log.Infof("Opened pebble database at %s for reading only", path)
+	return newPebbleDB(pebbleDB), nil
+}
+
+// Compact compacts the pebble instance.
+func (db *PebbleDB) Compact() error {
+	iterator, err := db.pebbleDB.NewIter(nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	var first, last []byte
+	if iterator.First() {
+		first = append([]byte{}, iterator.Key()...)
+	}
+	if iterator.Last() {
+		last = append([]byte{}, iterator.Key()...)
+	}
+	err = iterator.Close()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if first == nil {
+		return nil
+	}
+	// The end of the compacted range is exclusive, so it has to be just past the last key
+	return errors.WithStack(db.pebbleDB.Compact(first, append(last, 0), true))
+}
+
+// Close closes the pebble instance, along with any cursors and snapshots
+// that are still open.
+func (db *PebbleDB) Close() error {
+	db.openResourcesLock.Lock()
+	openCursors, openSnapshots := db.openCursors, db.openSnapshots
+	db.openCursors = make(map[*PebbleCursor]struct{})
+	db.openSnapshots = make(map[*PebbleSnapshot]struct{})
+	db.openResourcesLock.Unlock()
+
+	// Cursors are closed first, since some of them may iterate over the snapshots
+	for cursor := range openCursors {
+		err := cursor.Close()
+		if err != nil {
+			return err
+		}
+	}
+	for snapshot := range openSnapshots {
+		err := snapshot.Release()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := db.pebbleDB.Close()
+	return errors.WithStack(err)
+}
+
+// Put sets the value for the given key. It overwrites
+// any previous value for that key.
+func (db *PebbleDB) Put(key *database.Key, value []byte) error {
+	err := db.pebbleDB.Set(key.Bytes(), value, pebble.NoSync)
+	return errors.WithStack(err)
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (db *PebbleDB) Get(key *database.Key) ([]byte, error) {
+	data, closer, err := db.pebbleDB.Get(key.Bytes())
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, errors.Wrapf(database.ErrNotFound,
+				"key %s not found", key)
+		}
+		return nil, errors.WithStack(err)
+	}
+	defer closer.Close()
+
+	// The returned slice is only valid until the closer is closed, so it must be copied
+	return append([]byte{}, data...), nil
+}
+
+// Has returns true if the database does contains the
+// given key.
+func (db *PebbleDB) Has(key *database.Key) (bool, error) {
+	_, closer, err := db.pebbleDB.Get(key.Bytes())
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return false, nil
+		}
+		return false, errors.WithStack(err)
+	}
+	return true, errors.WithStack(closer.Close())
+}
+
+// Delete deletes the value for the given key. Will not
+// return an error if the key doesn't exist.
+func (db *PebbleDB) Delete(key *database.Key) error {
+	err := db.pebbleDB.Delete(key.Bytes(), pebble.NoSync)
+	return errors.WithStack(err)
+}
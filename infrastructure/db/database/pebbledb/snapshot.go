@@ -0,0 +1,94 @@
+//go:build pebble
+// +build pebble
+
+package pebbledb
+
+import (
+	"github.com/cockroachdb/pebble"
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// PebbleSnapshot is a thin wrapper around native pebble snapshots.
+type PebbleSnapshot struct {
+	db         *PebbleDB
+	snapshot   *pebble.Snapshot
+	isReleased bool
+}
+
+// Snapshot takes a read-only, point-in-time snapshot of the database.
+func (db *PebbleDB) Snapshot() (database.Snapshot, error) {
+	snapshot := &PebbleSnapshot{
+		db:         db,
+		snapshot:   db.pebbleDB.NewSnapshot(),
+		isReleased: false,
+	}
+
+	db.openResourcesLock.Lock()
+	defer db.openResourcesLock.Unlock()
+	db.openSnapshots[snapshot] = struct{}{}
+
+	return snapshot, nil
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (s *PebbleSnapshot) Get(key *database.Key) ([]byte, error) {
+	if s.isReleased {
+		return nil, errors.New("cannot get from a released snapshot")
+	}
+	data, closer, err := s.snapshot.Get(key.Bytes())
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, errors.Wrapf(database.ErrNotFound,
+				"key %s not found", key)
+		}
+		return nil, errors.WithStack(err)
+	}
+	defer closer.Close()
+
+	// The returned slice is only valid until the closer is closed, so it must be copied
+	return append([]byte{}, data...), nil
+}
+
+// Has returns true if the snapshot does contains the
+// given key.
+func (s *PebbleSnapshot) Has(key *database.Key) (bool, error) {
+	if s.isReleased {
+		return false, errors.New("cannot has from a released snapshot")
+	}
+	_, closer, err := s.snapshot.Get(key.Bytes())
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return false, nil
+		}
+		return false, errors.WithStack(err)
+	}
+	return true, errors.WithStack(closer.Close())
+}
+
+// Cursor begins a new cursor over the given bucket.
+func (s *PebbleSnapshot) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	if s.isReleased {
+		return nil, errors.New("cannot open a cursor from a released snapshot")
+	}
+	pebbleIterator, err := s.snapshot.NewIter(bucketIterOptions(bucket))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return newPebbleCursor(s.db, pebbleIterator, bucket), nil
+}
+
+// Release releases the snapshot.
+func (s *PebbleSnapshot) Release() error {
+	if s.isReleased {
+		return errors.New("cannot release an already released snapshot")
+	}
+	s.isReleased = true
+
+	s.db.openResourcesLock.Lock()
+	delete(s.db.openSnapshots, s)
+	s.db.openResourcesLock.Unlock()
+
+	return errors.WithStack(s.snapshot.Close())
+}
@@ -0,0 +1,21 @@
+package database
+
+// Snapshot is a read-only view of a database as it was at the time the
+// snapshot was taken. Changes that are made to the database after that
+// are not visible through the snapshot.
+type Snapshot interface {
+	// Get gets the value for the given key. It returns
+	// ErrNotFound if the given key does not exist.
+	Get(key *Key) ([]byte, error)
+
+	// Has returns true if the snapshot does contains the
+	// given key.
+	Has(key *Key) (bool, error)
+
+	// Cursor begins a new cursor over the given bucket.
+	Cursor(bucket *Bucket) (Cursor, error)
+
+	// Release releases the snapshot. It must be called once
+	// the snapshot is no longer needed.
+	Release() error
+}
@@ -0,0 +1,125 @@
+// All tests within this file should call testForAllDatabaseTypes
+// over the actual test. This is to make sure that all supported
+// database types adhere to the assumptions defined in the
+// interfaces in this package.
+
+package database_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+)
+
+func TestSnapshot(t *testing.T) {
+	testForAllDatabaseTypes(t, "TestSnapshot", testSnapshot)
+}
+
+func testSnapshot(t *testing.T, db database.Database, testName string) {
+	entries := populateDatabaseForTest(t, db, testName)
+
+	snapshot, err := db.Snapshot()
+	if err != nil {
+		t.Fatalf("%s: Snapshot "+
+			"unexpectedly failed: %s", testName, err)
+	}
+
+	// Change the database after the snapshot was taken
+	modifiedKey := entries[0].key
+	err = db.Put(modifiedKey, []byte("modified"))
+	if err != nil {
+		t.Fatalf("%s: Put "+
+			"unexpectedly failed: %s", testName, err)
+	}
+	deletedKey := entries[1].key
+	err = db.Delete(deletedKey)
+	if err != nil {
+		t.Fatalf("%s: Delete "+
+			"unexpectedly failed: %s", testName, err)
+	}
+	addedKey := database.MakeBucket(nil).Key([]byte("added"))
+	err = db.Put(addedKey, []byte("added"))
+	if err != nil {
+		t.Fatalf("%s: Put "+
+			"unexpectedly failed: %s", testName, err)
+	}
+
+	// Make sure that none of the changes are visible through the snapshot
+	value, err := snapshot.Get(modifiedKey)
+	if err != nil {
+		t.Fatalf("%s: Get "+
+			"unexpectedly failed: %s", testName, err)
+	}
+	if !bytes.Equal(value, entries[0].value) {
+		t.Fatalf("%s: Get "+
+			"returned wrong value. Want: %s, got: %s",
+			testName, string(entries[0].value), string(value))
+	}
+	exists, err := snapshot.Has(deletedKey)
+	if err != nil {
+		t.Fatalf("%s: Has "+
+			"unexpectedly failed: %s", testName, err)
+	}
+	if !exists {
+		t.Fatalf("%s: Has "+
+			"unexpectedly returned false for a key that was deleted after the snapshot", testName)
+	}
+	_, err = snapshot.Get(addedKey)
+	if !database.IsNotFoundError(err) {
+		t.Fatalf("%s: Get "+
+			"unexpectedly returned a key that was added after the snapshot: %s", testName, err)
+	}
+
+	// Make sure that a cursor over the snapshot returns exactly the original entries
+	cursor, err := snapshot.Cursor(database.MakeBucket(nil))
+	if err != nil {
+		t.Fatalf("%s: Cursor "+
+			"unexpectedly failed: %s", testName, err)
+	}
+	i := 0
+	for ; cursor.Next(); i++ {
+		key, err := cursor.Key()
+		if err != nil {
+			t.Fatalf("%s: Key "+
+				"unexpectedly failed: %s", testName, err)
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			t.Fatalf("%s: Value "+
+				"unexpectedly failed: %s", testName, err)
+		}
+		if i >= len(entries) {
+			t.Fatalf("%s: cursor returned more entries than expected", testName)
+		}
+		if !bytes.Equal(key.Bytes(), entries[i].key.Bytes()) || !bytes.Equal(value, entries[i].value) {
+			t.Fatalf("%s: cursor returned wrong entry. Want: %s, got: %s",
+				testName, entries[i].key, key)
+		}
+	}
+	if i != len(entries) {
+		t.Fatalf("%s: cursor returned %d entries, but want %d", testName, i, len(entries))
+	}
+	err = cursor.Close()
+	if err != nil {
+		t.Fatalf("%s: Close "+
+			"unexpectedly failed: %s", testName, err)
+	}
+
+	// Make sure that the snapshot can't be used after it's released
+	err = snapshot.Release()
+	if err != nil {
+		t.Fatalf("%s: Release "+
+			"unexpectedly failed: %s", testName, err)
+	}
+	_, err = snapshot.Get(modifiedKey)
+	if err == nil {
+		t.Fatalf("%s: Get "+
+			"unexpectedly succeeded on a released snapshot", testName)
+	}
+	err = snapshot.Release()
+	if err == nil {
+		t.Fatalf("%s: Release "+
+			"unexpectedly succeeded on a released snapshot", testName)
+	}
+}
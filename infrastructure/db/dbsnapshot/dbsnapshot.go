@@ -0,0 +1,380 @@
+package dbsnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"math"
+	"os"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+var magic = []byte("kaspasnp")
+
+// fileVersion is the version of the snapshot file format
+const fileVersion uint32 = 1
+
+// endOfEntriesMarker takes the place of a key length after the last entry
+const endOfEntriesMarker uint32 = math.MaxUint32
+
+// maximumHeaderLength and maximumEntryPartLength guard against allocating absurd
+// amounts of memory when reading a corrupted snapshot file
+const (
+	maximumHeaderLength    = 1 << 20
+	maximumEntryPartLength = 1 << 30
+)
+
+// restoreBatchSize is the number of entries that are written in a single
+// transaction when restoring a snapshot
+const restoreBatchSize = 10_000
+
+// ErrChecksumMismatch indicates that the contents of a snapshot file don't match its checksum
+var ErrChecksumMismatch = errors.New("snapshot checksum mismatch")
+
+// Header describes the state of the database a snapshot was taken from
+type Header struct {
+	Network         string `json:"network"`
+	PruningPoint    string `json:"pruningPoint"`
+	VirtualDAAScore uint64 `json:"virtualDaaScore"`
+	Timestamp       int64  `json:"timestamp"`
+}
+
+// Summary describes a snapshot that was written or read
+type Summary struct {
+	Header     *Header
+	EntryCount uint64
+	Checksum   string
+}
+
+// WriteFile writes all the entries of the given database snapshot into a new snapshot file
+// in the given path. An existing file is never overwritten.
+func WriteFile(path string, header *Header, snapshot database.Snapshot) (*Summary, error) {
+	_, err := os.Stat(path)
+	if err == nil {
+		return nil, errors.Errorf("%s already exists", path)
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.WithStack(err)
+	}
+
+	// Write to a temporary file first, so that a partially written snapshot
+	// is never mistaken for a complete one
+	temporaryPath := path + ".tmp"
+	summary, err := writeFile(temporaryPath, header, snapshot)
+	if err != nil {
+		_ = os.Remove(temporaryPath)
+		return nil, err
+	}
+	err = os.Rename(temporaryPath, path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return summary, nil
+}
+
+func writeFile(path string, header *Header, snapshot database.Snapshot) (*Summary, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	bufferedWriter := bufio.NewWriter(file)
+	summary, err := Write(bufferedWriter, header, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	err = bufferedWriter.Flush()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = file.Sync()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return summary, nil
+}
+
+// Write writes all the entries of the given database snapshot to the given writer
+func Write(writer io.Writer, header *Header, snapshot database.Snapshot) (*Summary, error) {
+	hasher := sha256.New()
+	hashedWriter := io.MultiWriter(writer, hasher)
+
+	err := writeHeader(hashedWriter, header)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := snapshot.Cursor(database.MakeBucket(nil))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	entryCount := uint64(0)
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		err = writeBytes(hashedWriter, key.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		err = writeBytes(hashedWriter, value)
+		if err != nil {
+			return nil, err
+		}
+		entryCount++
+	}
+
+	err = binary.Write(hashedWriter, binary.LittleEndian, endOfEntriesMarker)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = binary.Write(hashedWriter, binary.LittleEndian, entryCount)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	checksum := hasher.Sum(nil)
+	_, err = writer.Write(checksum)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &Summary{
+		Header:     header,
+		EntryCount: entryCount,
+		Checksum:   hex.EncodeToString(checksum),
+	}, nil
+}
+
+func writeHeader(writer io.Writer, header *Header) error {
+	serializedHeader, err := json.Marshal(header)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = writer.Write(magic)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	err = binary.Write(writer, binary.LittleEndian, fileVersion)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return writeBytes(writer, serializedHeader)
+}
+
+func writeBytes(writer io.Writer, data []byte) error {
+	err := binary.Write(writer, binary.LittleEndian, uint32(len(data)))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	_, err = writer.Write(data)
+	return errors.WithStack(err)
+}
+
+// ReadHeader reads only the header of the snapshot file in the given path.
+// It does not verify the snapshot's checksum.
+func ReadHeader(path string) (*Header, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	return readHeader(bufio.NewReader(file))
+}
+
+func readHeader(reader io.Reader) (*Header, error) {
+	fileMagic := make([]byte, len(magic))
+	_, err := io.ReadFull(reader, fileMagic)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading the snapshot magic")
+	}
+	if !bytes.Equal(fileMagic, magic) {
+		return nil, errors.Errorf("not a kaspad database snapshot")
+	}
+	var version uint32
+	err = binary.Read(reader, binary.LittleEndian, &version)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if version != fileVersion {
+		return nil, errors.Errorf("unsupported snapshot file version %d", version)
+	}
+
+	serializedHeader, err := readBytes(reader, maximumHeaderLength)
+	if err != nil {
+		return nil, err
+	}
+	header := &Header{}
+	err = json.Unmarshal(serializedHeader, header)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return header, nil
+}
+
+func readLength(reader io.Reader) (uint32, error) {
+	var length uint32
+	err := binary.Read(reader, binary.LittleEndian, &length)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return length, nil
+}
+
+func readBytes(reader io.Reader, maximumLength uint32) ([]byte, error) {
+	length, err := readLength(reader)
+	if err != nil {
+		return nil, err
+	}
+	return readBytesOfLength(reader, length, maximumLength)
+}
+
+func readBytesOfLength(reader io.Reader, length uint32, maximumLength uint32) ([]byte, error) {
+	if length > maximumLength {
+		return nil, errors.Errorf("snapshot entry is %d bytes long, while the maximum is %d",
+			length, maximumLength)
+	}
+	data := make([]byte, length)
+	_, err := io.ReadFull(reader, data)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return data, nil
+}
+
+// Verify reads the entire snapshot file in the given path and verifies its checksum,
+// without writing its entries anywhere
+func Verify(path string) (*Summary, error) {
+	return readFile(path, func(key []byte, value []byte) error { return nil })
+}
+
+// Restore writes all the entries of the snapshot file in the given path into the
+// given database, and verifies the snapshot's checksum.
+// If an error is returned, the database might contain some of the snapshot's entries.
+func Restore(path string, destination database.Database) (*Summary, error) {
+	dbTx, err := destination.Begin()
+	if err != nil {
+		return nil, err
+	}
+	// dbTx is replaced after every batch, so it must be evaluated only when returning
+	defer func() {
+		_ = dbTx.RollbackUnlessClosed()
+	}()
+
+	pendingCount := 0
+	summary, err := readFile(path, func(key []byte, value []byte) error {
+		err := dbTx.Put(database.MakeBucket(nil).Key(key), value)
+		if err != nil {
+			return err
+		}
+		pendingCount++
+		if pendingCount < restoreBatchSize {
+			return nil
+		}
+
+		err = dbTx.Commit()
+		if err != nil {
+			return err
+		}
+		pendingCount = 0
+		dbTx, err = destination.Begin()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = dbTx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func readFile(path string, handleEntry func(key []byte, value []byte) error) (*Summary, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer file.Close()
+
+	return read(bufio.NewReader(file), handleEntry)
+}
+
+func read(reader io.Reader, handleEntry func(key []byte, value []byte) error) (*Summary, error) {
+	hasher := sha256.New()
+	hashedReader := io.TeeReader(reader, hasher)
+
+	header, err := readHeader(hashedReader)
+	if err != nil {
+		return nil, err
+	}
+
+	entryCount := uint64(0)
+	for {
+		keyLength, err := readLength(hashedReader)
+		if err != nil {
+			return nil, err
+		}
+		if keyLength == endOfEntriesMarker {
+			break
+		}
+		key, err := readBytesOfLength(hashedReader, keyLength, maximumEntryPartLength)
+		if err != nil {
+			return nil, err
+		}
+		value, err := readBytes(hashedReader, maximumEntryPartLength)
+		if err != nil {
+			return nil, err
+		}
+		err = handleEntry(key, value)
+		if err != nil {
+			return nil, err
+		}
+		entryCount++
+	}
+
+	var expectedEntryCount uint64
+	err = binary.Read(hashedReader, binary.LittleEndian, &expectedEntryCount)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if entryCount != expectedEntryCount {
+		return nil, errors.Errorf("snapshot has %d entries, but its trailer says %d", entryCount, expectedEntryCount)
+	}
+
+	return verifyChecksum(reader, hasher, header, entryCount)
+}
+
+func verifyChecksum(reader io.Reader, hasher hash.Hash, header *Header, entryCount uint64) (*Summary, error) {
+	calculatedChecksum := hasher.Sum(nil)
+	checksum := make([]byte, len(calculatedChecksum))
+	_, err := io.ReadFull(reader, checksum)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading the snapshot checksum")
+	}
+	if !bytes.Equal(checksum, calculatedChecksum) {
+		return nil, errors.Wrapf(ErrChecksumMismatch, "snapshot checksum is %x, but its contents hash to %x",
+			checksum, calculatedChecksum)
+	}
+	return &Summary{
+		Header:     header,
+		EntryCount: entryCount,
+		Checksum:   hex.EncodeToString(checksum),
+	}, nil
+}
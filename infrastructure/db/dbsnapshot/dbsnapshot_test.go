@@ -0,0 +1,124 @@
+package dbsnapshot
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/database"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/pkg/errors"
+)
+
+func TestWriteAndRestore(t *testing.T) {
+	source, err := ldb.NewInMemoryLevelDB(8)
+	if err != nil {
+		t.Fatalf("NewInMemoryLevelDB: %s", err)
+	}
+	defer source.Close()
+
+	const entryCount = restoreBatchSize + 1
+	bucket := database.MakeBucket([]byte("bucket"))
+	for i := 0; i < entryCount; i++ {
+		err := source.Put(bucket.Key([]byte(fmt.Sprintf("key%d", i))), []byte(fmt.Sprintf("value%d", i)))
+		if err != nil {
+			t.Fatalf("Put: %s", err)
+		}
+	}
+
+	snapshot, err := source.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot: %s", err)
+	}
+	defer snapshot.Release()
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	header := &Header{Network: "kaspa-simnet", PruningPoint: "abcd", VirtualDAAScore: 1234, Timestamp: 5678}
+	writtenSummary, err := WriteFile(path, header, snapshot)
+	if err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+	if writtenSummary.EntryCount != entryCount {
+		t.Fatalf("Unexpected entry count. Want: %d, got: %d", entryCount, writtenSummary.EntryCount)
+	}
+
+	_, err = WriteFile(path, header, snapshot)
+	if err == nil {
+		t.Fatalf("WriteFile unexpectedly overwrote an existing file")
+	}
+
+	readHeader, err := ReadHeader(path)
+	if err != nil {
+		t.Fatalf("ReadHeader: %s", err)
+	}
+	if *readHeader != *header {
+		t.Fatalf("Unexpected header. Want: %+v, got: %+v", header, readHeader)
+	}
+
+	destination, err := ldb.NewInMemoryLevelDB(8)
+	if err != nil {
+		t.Fatalf("NewInMemoryLevelDB: %s", err)
+	}
+	defer destination.Close()
+	restoredSummary, err := Restore(path, destination)
+	if err != nil {
+		t.Fatalf("Restore: %s", err)
+	}
+	if restoredSummary.EntryCount != entryCount || restoredSummary.Checksum != writtenSummary.Checksum {
+		t.Fatalf("Unexpected restored summary. Want: %+v, got: %+v", writtenSummary, restoredSummary)
+	}
+	for i := 0; i < entryCount; i++ {
+		value, err := destination.Get(bucket.Key([]byte(fmt.Sprintf("key%d", i))))
+		if err != nil {
+			t.Fatalf("Get: %s", err)
+		}
+		if !bytes.Equal(value, []byte(fmt.Sprintf("value%d", i))) {
+			t.Fatalf("Unexpected value for key%d: %s", i, value)
+		}
+	}
+}
+
+func TestVerifyCorruptedSnapshot(t *testing.T) {
+	source, err := ldb.NewInMemoryLevelDB(8)
+	if err != nil {
+		t.Fatalf("NewInMemoryLevelDB: %s", err)
+	}
+	defer source.Close()
+	err = source.Put(database.MakeBucket(nil).Key([]byte("key")), []byte("value"))
+	if err != nil {
+		t.Fatalf("Put: %s", err)
+	}
+	snapshot, err := source.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot: %s", err)
+	}
+	defer snapshot.Release()
+
+	path := filepath.Join(t.TempDir(), "snapshot")
+	_, err = WriteFile(path, &Header{Network: "kaspa-simnet"}, snapshot)
+	if err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+	_, err = Verify(path)
+	if err != nil {
+		t.Fatalf("Verify: %s", err)
+	}
+
+	// Flip a byte of the value
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %s", err)
+	}
+	valueIndex := bytes.Index(content, []byte("value"))
+	content[valueIndex] ^= 0xff
+	err = os.WriteFile(path, content, 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+	_, err = Verify(path)
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("Unexpected error verifying a corrupted snapshot: %v", err)
+	}
+}
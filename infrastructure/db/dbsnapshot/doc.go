@@ -0,0 +1,19 @@
+/*
+Package dbsnapshot reads and writes kaspad database snapshot files.
+
+A snapshot file holds every entry of a database as it was at a single point in
+time, so that a new node can be bootstrapped from it instead of syncing from
+scratch. Its layout is:
+
+	magic            8 bytes, "kaspasnp"
+	version          uint32
+	header length    uint32
+	header           JSON-encoded Header
+	entries          repeated: key length (uint32), key, value length (uint32), value
+	end marker       uint32, 0xffffffff
+	entry count      uint64
+	checksum         SHA-256 of everything above
+
+All integers are little-endian.
+*/
+package dbsnapshot
@@ -54,6 +54,7 @@ const logsBuffer = 0
 // subsystems.
 type Backend struct {
 	flag      uint32
+	format    Format // atomic
 	isRunning uint32
 	writers   []logWriter
 	writeChan chan logEntry
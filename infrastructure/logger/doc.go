@@ -19,5 +19,9 @@ are recognized:
 
   shortfile: Include the filename and line number in all log messages.
   Overrides longfile.
+
+Records are written as lines of text by default. A Backend set to FormatJSON
+writes every record as a single-line JSON object instead, which also carries
+the structured fields attached with Logger.WithFields.
 */
 package logger
@@ -0,0 +1,127 @@
+package logger
+
+// Fields are structured key-value pairs attached to a log record. They're written only
+// in FormatJSON, since the text format already includes them in the message
+type Fields map[string]interface{}
+
+// Keys of fields that are common to many call sites. Using these keys makes it possible to
+// correlate records from different subsystems
+const (
+	// FieldBlockHash is the hash of the block a record refers to
+	FieldBlockHash = "blockHash"
+
+	// FieldTransactionID is the ID of the transaction a record refers to
+	FieldTransactionID = "transactionID"
+
+	// FieldPeer is the address of the peer a record refers to
+	FieldPeer = "peer"
+
+	// FieldDAAScore is the DAA score a record refers to
+	FieldDAAScore = "daaScore"
+)
+
+// FieldLogger is a subsystem logger that attaches structured fields to every record it writes
+type FieldLogger struct {
+	logger *Logger
+	fields Fields
+}
+
+// WithFields returns a logger that writes to the same subsystem as l, and attaches the
+// given fields to every record
+func (l *Logger) WithFields(fields Fields) *FieldLogger {
+	return &FieldLogger{logger: l, fields: fields}
+}
+
+// WithFields returns a logger that attaches the given fields in addition to the fields of fl
+func (fl *FieldLogger) WithFields(fields Fields) *FieldLogger {
+	mergedFields := make(Fields, len(fl.fields)+len(fields))
+	for key, value := range fl.fields {
+		mergedFields[key] = value
+	}
+	for key, value := range fields {
+		mergedFields[key] = value
+	}
+	return &FieldLogger{logger: fl.logger, fields: mergedFields}
+}
+
+// Trace formats message using the default formats for its operands, and writes
+// to log with LevelTrace.
+func (fl *FieldLogger) Trace(args ...interface{}) {
+	fl.Write(LevelTrace, args...)
+}
+
+// Tracef formats message according to format specifier, and writes to log with LevelTrace.
+func (fl *FieldLogger) Tracef(format string, args ...interface{}) {
+	fl.Writef(LevelTrace, format, args...)
+}
+
+// Debug formats message using the default formats for its operands, and writes
+// to log with LevelDebug.
+func (fl *FieldLogger) Debug(args ...interface{}) {
+	fl.Write(LevelDebug, args...)
+}
+
+// Debugf formats message according to format specifier, and writes to log with LevelDebug.
+func (fl *FieldLogger) Debugf(format string, args ...interface{}) {
+	fl.Writef(LevelDebug, format, args...)
+}
+
+// Info formats message using the default formats for its operands, and writes
+// to log with LevelInfo.
+func (fl *FieldLogger) Info(args ...interface{}) {
+	fl.Write(LevelInfo, args...)
+}
+
+// Infof formats message according to format specifier, and writes to log with LevelInfo.
+func (fl *FieldLogger) Infof(format string, args ...interface{}) {
+	fl.Writef(LevelInfo, format, args...)
+}
+
+// Warn formats message using the default formats for its operands, and writes
+// to log with LevelWarn.
+func (fl *FieldLogger) Warn(args ...interface{}) {
+	fl.Write(LevelWarn, args...)
+}
+
+// Warnf formats message according to format specifier, and writes to log with LevelWarn.
+func (fl *FieldLogger) Warnf(format string, args ...interface{}) {
+	fl.Writef(LevelWarn, format, args...)
+}
+
+// Error formats message using the default formats for its operands, and writes
+// to log with LevelError.
+func (fl *FieldLogger) Error(args ...interface{}) {
+	fl.Write(LevelError, args...)
+}
+
+// Errorf formats message according to format specifier, and writes to log with LevelError.
+func (fl *FieldLogger) Errorf(format string, args ...interface{}) {
+	fl.Writef(LevelError, format, args...)
+}
+
+// Critical formats message using the default formats for its operands, and writes
+// to log with LevelCritical.
+func (fl *FieldLogger) Critical(args ...interface{}) {
+	fl.Write(LevelCritical, args...)
+}
+
+// Criticalf formats message according to format specifier, and writes to log with LevelCritical.
+func (fl *FieldLogger) Criticalf(format string, args ...interface{}) {
+	fl.Writef(LevelCritical, format, args...)
+}
+
+// Write formats message using the default formats for its operands, and writes
+// to log with the given logLevel.
+func (fl *FieldLogger) Write(logLevel Level, args ...interface{}) {
+	if fl.logger.Level() <= logLevel {
+		fl.logger.print(logLevel, fl.logger.tag, fl.fields, args...)
+	}
+}
+
+// Writef formats message according to format specifier, and writes to log with
+// the given logLevel.
+func (fl *FieldLogger) Writef(logLevel Level, format string, args ...interface{}) {
+	if fl.logger.Level() <= logLevel {
+		fl.logger.printf(logLevel, fl.logger.tag, fl.fields, format, args...)
+	}
+}
@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+// Format is the format in which a Backend writes log records
+type Format uint32
+
+// Format constants.
+const (
+	// FormatText writes every record as a single line of human-readable text
+	FormatText Format = iota
+
+	// FormatJSON writes every record as a single-line JSON object
+	FormatJSON
+)
+
+var formatStrs = [...]string{"text", "json"}
+
+// FormatFromString returns a format based on the input string s
+func FormatFromString(s string) (Format, error) {
+	for format, formatStr := range formatStrs {
+		if strings.ToLower(s) == formatStr {
+			return Format(format), nil
+		}
+	}
+	return FormatText, errors.Errorf("'%s' Isn't a valid log format. Supported formats: %s",
+		s, strings.Join(formatStrs[:], ", "))
+}
+
+// String returns the name of the format
+func (f Format) String() string {
+	if int(f) >= len(formatStrs) {
+		return "unknown"
+	}
+	return formatStrs[f]
+}
+
+// SetFormat changes the format in which the backend writes log records
+func (b *Backend) SetFormat(format Format) {
+	atomic.StoreUint32((*uint32)(&b.format), uint32(format))
+}
+
+// Format returns the format in which the backend writes log records
+func (b *Backend) Format() Format {
+	return Format(atomic.LoadUint32((*uint32)(&b.format)))
+}
+
+// SetLogFormat sets the format in which all subsystem loggers write log records
+func SetLogFormat(format string) error {
+	parsedFormat, err := FormatFromString(format)
+	if err != nil {
+		return err
+	}
+	BackendLog.SetFormat(parsedFormat)
+	return nil
+}
+
+// jsonRecord is a log record as it's written in FormatJSON
+type jsonRecord struct {
+	Timestamp string                 `json:"timestamp"`
+	Level     string                 `json:"level"`
+	Subsystem string                 `json:"subsystem"`
+	Caller    string                 `json:"caller,omitempty"`
+	Message   string                 `json:"message"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// formatJSONRecord formats a single log record as a line of JSON
+func formatJSONRecord(t mstime.Time, lvl Level, tag string, file string, line int, message string,
+	fields Fields) []byte {
+
+	record := &jsonRecord{
+		Timestamp: t.ToNativeTime().UTC().Format("2006-01-02T15:04:05.000Z07:00"),
+		Level:     lvl.String(),
+		Subsystem: tag,
+		Message:   strings.TrimSuffix(message, "\n"),
+	}
+	if file != "" {
+		record.Caller = fmt.Sprintf("%s:%d", file, line)
+	}
+	if len(fields) > 0 {
+		record.Fields = make(map[string]interface{}, len(fields))
+		for key, value := range fields {
+			record.Fields[key] = jsonFieldValue(value)
+		}
+	}
+
+	serializedRecord, err := json.Marshal(record)
+	if err != nil {
+		// This can only happen if a field value can't be serialized, so fall back to
+		// the record without its fields
+		record.Fields = nil
+		serializedRecord, _ = json.Marshal(record)
+	}
+	return append(serializedRecord, '\n')
+}
+
+// jsonFieldValue converts a field value to a value that's serialized meaningfully into JSON.
+// Values that have a string representation, such as hashes and addresses, are serialized
+// as that string
+func jsonFieldValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case nil, string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return value
+	case error:
+		return value.Error()
+	case fmt.Stringer:
+		return value.String()
+	default:
+		return fmt.Sprintf("%+v", value)
+	}
+}
@@ -0,0 +1,99 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+type bufferWriteCloser struct {
+	bytes.Buffer
+}
+
+func (*bufferWriteCloser) Close() error {
+	return nil
+}
+
+type testStringer struct{}
+
+func (testStringer) String() string {
+	return "stringer"
+}
+
+func TestJSONFormat(t *testing.T) {
+	backend := NewBackendWithFlags(0)
+	backend.SetFormat(FormatJSON)
+	buffer := &bufferWriteCloser{}
+	err := backend.AddLogWriter(buffer, LevelTrace)
+	if err != nil {
+		t.Fatalf("AddLogWriter: %s", err)
+	}
+	err = backend.Run()
+	if err != nil {
+		t.Fatalf("Run: %s", err)
+	}
+
+	log := backend.Logger("TEST")
+	log.SetLevel(LevelInfo)
+	log.Debugf("Filtered out by the subsystem level")
+	log.Infof("Hello %s", "world")
+	log.WithFields(Fields{FieldBlockHash: testStringer{}, FieldDAAScore: uint64(5)}).Warn("With", "fields")
+	backend.Close()
+
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Unexpected amount of records. Want: 2, got: %d:\n%s", len(lines), buffer.String())
+	}
+
+	records := make([]*jsonRecord, len(lines))
+	for i, line := range lines {
+		records[i] = &jsonRecord{}
+		err := json.Unmarshal([]byte(line), records[i])
+		if err != nil {
+			t.Fatalf("Record %d is not valid JSON: %s: %s", i, err, line)
+		}
+		if records[i].Timestamp == "" {
+			t.Fatalf("Record %d unexpectedly has no timestamp", i)
+		}
+		if records[i].Subsystem != "TEST" {
+			t.Fatalf("Unexpected subsystem of record %d. Want: TEST, got: %s", i, records[i].Subsystem)
+		}
+	}
+
+	if records[0].Level != "INF" || records[0].Message != "Hello world" || records[0].Fields != nil {
+		t.Fatalf("Unexpected first record: %+v", records[0])
+	}
+	if records[1].Level != "WRN" || records[1].Message != "With fields" {
+		t.Fatalf("Unexpected second record: %+v", records[1])
+	}
+	if records[1].Fields[FieldBlockHash] != "stringer" || records[1].Fields[FieldDAAScore] != float64(5) {
+		t.Fatalf("Unexpected fields of the second record: %+v", records[1].Fields)
+	}
+}
+
+func TestFormatFromString(t *testing.T) {
+	for _, test := range []struct {
+		formatString   string
+		expectedFormat Format
+		expectsError   bool
+	}{
+		{formatString: "text", expectedFormat: FormatText},
+		{formatString: "JSON", expectedFormat: FormatJSON},
+		{formatString: "xml", expectsError: true},
+	} {
+		format, err := FormatFromString(test.formatString)
+		if test.expectsError {
+			if err == nil {
+				t.Fatalf("FormatFromString(%s) unexpectedly succeeded", test.formatString)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("FormatFromString(%s): %s", test.formatString, err)
+		}
+		if format != test.expectedFormat {
+			t.Fatalf("Unexpected format for %s. Want: %s, got: %s", test.formatString, test.expectedFormat, format)
+		}
+	}
+}
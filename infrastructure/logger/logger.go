@@ -135,6 +135,17 @@ func SupportedSubsystems() []string {
 	return subsystems
 }
 
+// SubsystemLogLevels returns the current log level of every subsystem
+func SubsystemLogLevels() map[string]Level {
+	subsystemLoggersMutex.Lock()
+	defer subsystemLoggersMutex.Unlock()
+	logLevels := make(map[string]Level, len(subsystemLoggers))
+	for subsysID, logger := range subsystemLoggers {
+		logLevels[subsysID] = logger.Level()
+	}
+	return logLevels
+}
+
 func getSubsystem(tag string) (logger *Logger, ok bool) {
 	subsystemLoggersMutex.Lock()
 	defer subsystemLoggersMutex.Unlock()
@@ -154,7 +165,8 @@ func ParseAndSetLogLevels(logLevel string) error {
 	}
 
 	// Split the specified string into subsystem/level pairs while detecting
-	// issues and update the log levels accordingly.
+	// issues, and update the log levels only once all of them are valid.
+	levelsBySubsystem := make(map[string]Level)
 	for _, logLevelPair := range strings.Split(logLevel, ",") {
 		if !strings.Contains(logLevelPair, "=") {
 			str := "The specified debug level contains an invalid " +
@@ -173,10 +185,16 @@ func ParseAndSetLogLevels(logLevel string) error {
 			return errors.Errorf(str, subsysID, strings.Join(SupportedSubsystems(), ", "))
 		}
 
-		err := SetLogLevel(subsysID, logLevel)
-		if err != nil {
-			return err
+		level, ok := LevelFromString(logLevel)
+		if !ok {
+			return errors.Errorf("'%s' Isn't a valid log level", logLevel)
 		}
+		levelsBySubsystem[subsysID] = level
+	}
+
+	for subsysID, level := range levelsBySubsystem {
+		logger, _ := getSubsystem(subsysID)
+		logger.SetLevel(level)
 	}
 	return nil
 }
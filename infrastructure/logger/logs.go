@@ -131,7 +131,7 @@ func (l *Logger) Criticalf(format string, args ...interface{}) {
 func (l *Logger) Write(logLevel Level, args ...interface{}) {
 	lvl := l.Level()
 	if lvl <= logLevel {
-		l.print(logLevel, l.tag, args...)
+		l.print(logLevel, l.tag, nil, args...)
 	}
 }
 
@@ -140,7 +140,7 @@ func (l *Logger) Write(logLevel Level, args ...interface{}) {
 func (l *Logger) Writef(logLevel Level, format string, args ...interface{}) {
 	lvl := l.Level()
 	if lvl <= logLevel {
-		l.printf(logLevel, l.tag, format, args...)
+		l.printf(logLevel, l.tag, nil, format, args...)
 	}
 }
 
@@ -162,8 +162,9 @@ func (l *Logger) Backend() *Backend {
 // printf outputs a log message to the writer associated with the backend after
 // creating a prefix for the given level and tag according to the formatHeader
 // function and formatting the provided arguments according to the given format
-// specifier.
-func (l *Logger) printf(lvl Level, tag string, format string, args ...interface{}) {
+// specifier. In FormatJSON the message is instead written as a JSON record that
+// includes the given fields.
+func (l *Logger) printf(lvl Level, tag string, fields Fields, format string, args ...interface{}) {
 	t := mstime.Now() // get as early as possible
 
 	var file string
@@ -172,25 +173,32 @@ func (l *Logger) printf(lvl Level, tag string, format string, args ...interface{
 		file, line = callsite(l.b.flag)
 	}
 
-	buf := make([]byte, 0, normalLogSize)
-
-	formatHeader(&buf, t, lvl.String(), tag, file, line)
-	bytesBuf := bytes.NewBuffer(buf)
-	_, _ = fmt.Fprintf(bytesBuf, format, args...)
-	bytesBuf.WriteByte('\n')
+	var log []byte
+	if l.b.Format() == FormatJSON {
+		log = formatJSONRecord(t, lvl, tag, file, line, fmt.Sprintf(format, args...), fields)
+	} else {
+		buf := make([]byte, 0, normalLogSize)
+
+		formatHeader(&buf, t, lvl.String(), tag, file, line)
+		bytesBuf := bytes.NewBuffer(buf)
+		_, _ = fmt.Fprintf(bytesBuf, format, args...)
+		bytesBuf.WriteByte('\n')
+		log = bytesBuf.Bytes()
+	}
 
 	if !l.b.IsRunning() {
-		_, _ = fmt.Fprintf(os.Stderr, bytesBuf.String())
+		_, _ = fmt.Fprintf(os.Stderr, string(log))
 		panic("Writing to the logger when it's not running")
 	}
-	l.writeChan <- logEntry{bytesBuf.Bytes(), lvl}
+	l.writeChan <- logEntry{log, lvl}
 }
 
 // print outputs a log message to the writer associated with the backend after
 // creating a prefix for the given level and tag according to the formatHeader
 // function and formatting the provided arguments using the default formatting
-// rules.
-func (l *Logger) print(lvl Level, tag string, args ...interface{}) {
+// rules. In FormatJSON the message is instead written as a JSON record that
+// includes the given fields.
+func (l *Logger) print(lvl Level, tag string, fields Fields, args ...interface{}) {
 	if atomic.LoadUint32(&l.b.isRunning) == 0 {
 		panic("printing log without initializing")
 	}
@@ -202,15 +210,21 @@ func (l *Logger) print(lvl Level, tag string, args ...interface{}) {
 		file, line = callsite(l.b.flag)
 	}
 
-	buf := make([]byte, 0, normalLogSize)
-	formatHeader(&buf, t, lvl.String(), tag, file, line)
-	bytesBuf := bytes.NewBuffer(buf)
-	_, _ = fmt.Fprintln(bytesBuf, args...)
+	var log []byte
+	if l.b.Format() == FormatJSON {
+		log = formatJSONRecord(t, lvl, tag, file, line, fmt.Sprintln(args...), fields)
+	} else {
+		buf := make([]byte, 0, normalLogSize)
+		formatHeader(&buf, t, lvl.String(), tag, file, line)
+		bytesBuf := bytes.NewBuffer(buf)
+		_, _ = fmt.Fprintln(bytesBuf, args...)
+		log = bytesBuf.Bytes()
+	}
 
 	if !l.b.IsRunning() {
 		panic("Writing to the logger when it's not running")
 	}
-	l.writeChan <- logEntry{bytesBuf.Bytes(), lvl}
+	l.writeChan <- logEntry{log, lvl}
 }
 
 // From stdlib log package.
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"bufio"
+	"sync/atomic"
+)
+
+// Counter is a metric whose value only ever increases
+type Counter struct {
+	value uint64
+}
+
+// Inc increments the counter by 1
+func (c *Counter) Inc() {
+	atomic.AddUint64(&c.value, 1)
+}
+
+// Add increments the counter by the given delta
+func (c *Counter) Add(delta uint64) {
+	atomic.AddUint64(&c.value, delta)
+}
+
+// Value returns the current value of the counter
+func (c *Counter) Value() uint64 {
+	return atomic.LoadUint64(&c.value)
+}
+
+// CounterVec is a set of counters that share a name and are distinguished by their label values
+type CounterVec struct {
+	metricName string
+	help       string
+	children   *vecChildren
+}
+
+func newCounterVec(registry *Registry, name string, help string, labelNames ...string) *CounterVec {
+	counterVec := &CounterVec{
+		metricName: name,
+		help:       help,
+		children:   newVecChildren(labelNames),
+	}
+	registry.register(counterVec)
+	return counterVec
+}
+
+func newCounter(registry *Registry, name string, help string) *Counter {
+	return newCounterVec(registry, name, help).WithLabelValues()
+}
+
+// WithLabelValues returns the counter with the given label values, creating it if needed
+func (cv *CounterVec) WithLabelValues(labelValues ...string) *Counter {
+	return cv.children.get(labelValues, func() interface{} { return &Counter{} }).(*Counter)
+}
+
+func (cv *CounterVec) name() string {
+	return cv.metricName
+}
+
+func (cv *CounterVec) write(writer *bufio.Writer) error {
+	err := writeHeader(writer, cv.metricName, cv.help, "counter")
+	if err != nil {
+		return err
+	}
+	for _, child := range cv.children.sorted() {
+		err := writeSample(writer, cv.metricName, cv.children.labelNames, child.labelValues,
+			"", "", float64(child.value.(*Counter).Value()))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
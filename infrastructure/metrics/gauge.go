@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"bufio"
+	"math"
+	"sync/atomic"
+)
+
+// Gauge is a metric whose value can go up and down
+type Gauge struct {
+	valueBits uint64
+}
+
+// Set sets the gauge to the given value
+func (g *Gauge) Set(value float64) {
+	atomic.StoreUint64(&g.valueBits, math.Float64bits(value))
+}
+
+// Add adds the given delta, which may be negative, to the gauge
+func (g *Gauge) Add(delta float64) {
+	for {
+		oldBits := atomic.LoadUint64(&g.valueBits)
+		newBits := math.Float64bits(math.Float64frombits(oldBits) + delta)
+		if atomic.CompareAndSwapUint64(&g.valueBits, oldBits, newBits) {
+			return
+		}
+	}
+}
+
+// Value returns the current value of the gauge
+func (g *Gauge) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&g.valueBits))
+}
+
+// GaugeVec is a set of gauges that share a name and are distinguished by their label values
+type GaugeVec struct {
+	metricName string
+	help       string
+	children   *vecChildren
+}
+
+func newGaugeVec(registry *Registry, name string, help string, labelNames ...string) *GaugeVec {
+	gaugeVec := &GaugeVec{
+		metricName: name,
+		help:       help,
+		children:   newVecChildren(labelNames),
+	}
+	registry.register(gaugeVec)
+	return gaugeVec
+}
+
+func newGauge(registry *Registry, name string, help string) *Gauge {
+	return newGaugeVec(registry, name, help).WithLabelValues()
+}
+
+// WithLabelValues returns the gauge with the given label values, creating it if needed
+func (gv *GaugeVec) WithLabelValues(labelValues ...string) *Gauge {
+	return gv.children.get(labelValues, func() interface{} { return &Gauge{} }).(*Gauge)
+}
+
+func (gv *GaugeVec) name() string {
+	return gv.metricName
+}
+
+func (gv *GaugeVec) write(writer *bufio.Writer) error {
+	err := writeHeader(writer, gv.metricName, gv.help, "gauge")
+	if err != nil {
+		return err
+	}
+	for _, child := range gv.children.sorted() {
+		err := writeSample(writer, gv.metricName, gv.children.labelNames, child.labelValues,
+			"", "", child.value.(*Gauge).Value())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
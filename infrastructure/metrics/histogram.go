@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"bufio"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// durationBuckets are the default upper bounds, in seconds, of the buckets of duration histograms
+var durationBuckets = []float64{0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Histogram is a metric that counts observations in configurable buckets
+type Histogram struct {
+	lock         sync.Mutex
+	upperBounds  []float64
+	bucketCounts []uint64
+	sum          float64
+	count        uint64
+}
+
+func newHistogram(upperBounds []float64) *Histogram {
+	return &Histogram{
+		upperBounds:  upperBounds,
+		bucketCounts: make([]uint64, len(upperBounds)),
+	}
+}
+
+// Observe adds a single observation to the histogram
+func (h *Histogram) Observe(value float64) {
+	bucketIndex := sort.SearchFloat64s(h.upperBounds, value)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if bucketIndex < len(h.bucketCounts) {
+		h.bucketCounts[bucketIndex]++
+	}
+	h.sum += value
+	h.count++
+}
+
+// ObserveDuration adds the time that passed since the given start time, in seconds, to the histogram
+func (h *Histogram) ObserveDuration(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+// HistogramVec is a set of histograms that share a name and buckets, and are distinguished by
+// their label values
+type HistogramVec struct {
+	metricName  string
+	help        string
+	upperBounds []float64
+	children    *vecChildren
+}
+
+func newHistogramVec(registry *Registry, name string, help string, upperBounds []float64,
+	labelNames ...string) *HistogramVec {
+
+	histogramVec := &HistogramVec{
+		metricName:  name,
+		help:        help,
+		upperBounds: upperBounds,
+		children:    newVecChildren(labelNames),
+	}
+	registry.register(histogramVec)
+	return histogramVec
+}
+
+// WithLabelValues returns the histogram with the given label values, creating it if needed
+func (hv *HistogramVec) WithLabelValues(labelValues ...string) *Histogram {
+	return hv.children.get(labelValues, func() interface{} { return newHistogram(hv.upperBounds) }).(*Histogram)
+}
+
+func (hv *HistogramVec) name() string {
+	return hv.metricName
+}
+
+func (hv *HistogramVec) write(writer *bufio.Writer) error {
+	err := writeHeader(writer, hv.metricName, hv.help, "histogram")
+	if err != nil {
+		return err
+	}
+	labelNames := hv.children.labelNames
+	for _, child := range hv.children.sorted() {
+		histogram := child.value.(*Histogram)
+
+		histogram.lock.Lock()
+		bucketCounts := append([]uint64{}, histogram.bucketCounts...)
+		sum := histogram.sum
+		count := histogram.count
+		histogram.lock.Unlock()
+
+		cumulativeCount := uint64(0)
+		for i, upperBound := range hv.upperBounds {
+			cumulativeCount += bucketCounts[i]
+			err := writeSample(writer, hv.metricName+"_bucket", labelNames, child.labelValues,
+				"le", formatFloat(upperBound), float64(cumulativeCount))
+			if err != nil {
+				return err
+			}
+		}
+		err := writeSample(writer, hv.metricName+"_bucket", labelNames, child.labelValues,
+			"le", formatFloat(math.Inf(1)), float64(count))
+		if err != nil {
+			return err
+		}
+		err = writeSample(writer, hv.metricName+"_sum", labelNames, child.labelValues, "", "", sum)
+		if err != nil {
+			return err
+		}
+		err = writeSample(writer, hv.metricName+"_count", labelNames, child.labelValues, "", "", float64(count))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package metrics
+
+// This file defines all the metrics that kaspad exports. Metric names and labels are part
+// of kaspad's interface, so they must not be changed once released. See README.md for
+// their documentation.
+
+// Block processing
+var (
+	// BlockProcessingDuration measures the time it takes to process a block, by processing stage
+	BlockProcessingDuration = newHistogramVec(defaultRegistry, "kaspad_block_processing_duration_seconds",
+		"The time it takes to process a block, by processing stage", durationBuckets, "stage")
+
+	// VirtualDAAScore is the DAA score of the virtual block
+	VirtualDAAScore = newGauge(defaultRegistry, "kaspad_virtual_daa_score",
+		"The DAA score of the virtual block")
+)
+
+// Block processing stages, used as values of the stage label of BlockProcessingDuration
+const (
+	BlockProcessingStageValidate         = "validate"
+	BlockProcessingStageAddToVirtual     = "add_to_virtual"
+	BlockProcessingStageReachability     = "reachability"
+	BlockProcessingStagePruning          = "pruning"
+	BlockProcessingStageCommit           = "commit"
+	BlockProcessingStageReverseUTXODiffs = "reverse_utxo_diffs"
+	BlockProcessingStageTotal            = "total"
+)
+
+// Mempool
+var (
+	// MempoolTransactions is the number of transactions in the mempool, excluding orphans
+	MempoolTransactions = newGauge(defaultRegistry, "kaspad_mempool_transactions",
+		"The number of transactions in the mempool, excluding orphans")
+
+	// MempoolOrphanTransactions is the number of orphan transactions in the mempool
+	MempoolOrphanTransactions = newGauge(defaultRegistry, "kaspad_mempool_orphan_transactions",
+		"The number of orphan transactions in the mempool")
+
+	// MempoolMass is the total mass of the transactions in the mempool, excluding orphans
+	MempoolMass = newGauge(defaultRegistry, "kaspad_mempool_mass",
+		"The total mass of the transactions in the mempool, excluding orphans")
+)
+
+// Network
+var (
+	// Peers is the number of connected peers, by connection direction
+	Peers = newGaugeVec(defaultRegistry, "kaspad_peers",
+		"The number of connected peers, by connection direction", "direction")
+
+	// IBDRunning is 1 while the node is in IBD, and 0 otherwise
+	IBDRunning = newGauge(defaultRegistry, "kaspad_ibd_running",
+		"Whether the node is currently in IBD (1) or not (0)")
+
+	// IBDProgress is the progress of the last or current IBD, between 0 and 1, by IBD stage
+	IBDProgress = newGaugeVec(defaultRegistry, "kaspad_ibd_progress_ratio",
+		"The progress of the last or current IBD, between 0 and 1, by IBD stage", "stage")
+
+	// IBDProcessedObjects is the number of objects processed during the last or current IBD, by IBD stage
+	IBDProcessedObjects = newGaugeVec(defaultRegistry, "kaspad_ibd_processed_objects",
+		"The number of objects processed during the last or current IBD, by IBD stage", "stage")
+)
+
+// IBD stages, used as values of the stage label of IBDProgress and IBDProcessedObjects
+const (
+	IBDStageHeaders = "headers"
+	IBDStageBlocks  = "blocks"
+)
+
+// Direction values of the direction label of Peers
+const (
+	PeerDirectionInbound  = "inbound"
+	PeerDirectionOutbound = "outbound"
+)
+
+// RPC
+var (
+	// RPCRequests counts the handled RPC requests, by method
+	RPCRequests = newCounterVec(defaultRegistry, "kaspad_rpc_requests_total",
+		"The number of handled RPC requests, by method", "method")
+
+	// RPCRequestDuration measures the time it takes to handle RPC requests, by method
+	RPCRequestDuration = newHistogramVec(defaultRegistry, "kaspad_rpc_request_duration_seconds",
+		"The time it takes to handle an RPC request, by method", durationBuckets, "method")
+)
+
+// Database
+var (
+	// UTXOCacheHits counts lookups of the virtual UTXO set that were served from its cache
+	UTXOCacheHits = newCounter(defaultRegistry, "kaspad_utxo_cache_hits_total",
+		"The number of virtual UTXO set lookups that were served from the cache")
+
+	// UTXOCacheMisses counts lookups of the virtual UTXO set that had to read the database
+	UTXOCacheMisses = newCounter(defaultRegistry, "kaspad_utxo_cache_misses_total",
+		"The number of virtual UTXO set lookups that had to read the database")
+
+	// LevelDBReadBytes is the number of bytes LevelDB read from disk since the node started
+	LevelDBReadBytes = newGauge(defaultRegistry, "kaspad_leveldb_read_bytes",
+		"The number of bytes LevelDB read from disk since the node started")
+
+	// LevelDBWrittenBytes is the number of bytes LevelDB wrote to disk since the node started
+	LevelDBWrittenBytes = newGauge(defaultRegistry, "kaspad_leveldb_written_bytes",
+		"The number of bytes LevelDB wrote to disk since the node started")
+
+	// LevelDBBlockCacheBytes is the size of LevelDB's block cache
+	LevelDBBlockCacheBytes = newGauge(defaultRegistry, "kaspad_leveldb_block_cache_bytes",
+		"The size of LevelDB's block cache in bytes")
+
+	// LevelDBOpenTables is the number of LevelDB tables that are currently open
+	LevelDBOpenTables = newGauge(defaultRegistry, "kaspad_leveldb_open_tables",
+		"The number of LevelDB tables that are currently open")
+
+	// LevelDBWriteDelays is the number of times LevelDB delayed writes since the node started
+	LevelDBWriteDelays = newGauge(defaultRegistry, "kaspad_leveldb_write_delays",
+		"The number of times LevelDB delayed writes because of compaction since the node started")
+
+	// LevelDBLevelSizeBytes is the size of every LevelDB level
+	LevelDBLevelSizeBytes = newGaugeVec(defaultRegistry, "kaspad_leveldb_level_size_bytes",
+		"The size of a LevelDB level in bytes", "level")
+
+	// LevelDBLevelTables is the number of tables in every LevelDB level
+	LevelDBLevelTables = newGaugeVec(defaultRegistry, "kaspad_leveldb_level_tables",
+		"The number of tables in a LevelDB level", "level")
+)
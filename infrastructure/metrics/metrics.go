@@ -0,0 +1,143 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// metric is a single metric family that can be written in the Prometheus text format
+type metric interface {
+	name() string
+	write(writer *bufio.Writer) error
+}
+
+// Registry holds a set of metrics, and writes them in the Prometheus text format
+type Registry struct {
+	lock       sync.Mutex
+	metrics    map[string]metric
+	collectors []func()
+}
+
+// NewRegistry creates a new empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		metrics: make(map[string]metric),
+	}
+}
+
+// defaultRegistry is the registry that holds all of kaspad's metrics
+var defaultRegistry = NewRegistry()
+
+func (r *Registry) register(m metric) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.metrics[m.name()]; ok {
+		panic("metric " + m.name() + " is registered more than once")
+	}
+	r.metrics[m.name()] = m
+}
+
+// AddCollector adds a function that's called before every time the metrics are written.
+// Collectors are meant to update gauges that reflect state that's cheaper to sample than to track
+func (r *Registry) AddCollector(collector func()) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.collectors = append(r.collectors, collector)
+}
+
+// Write runs all the collectors and writes all the metrics in the Prometheus text format,
+// sorted by name
+func (r *Registry) Write(writer io.Writer) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, collector := range r.collectors {
+		collector()
+	}
+
+	names := make([]string, 0, len(r.metrics))
+	for name := range r.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bufferedWriter := bufio.NewWriter(writer)
+	for _, name := range names {
+		err := r.metrics[name].write(bufferedWriter)
+		if err != nil {
+			return err
+		}
+	}
+	return bufferedWriter.Flush()
+}
+
+// AddCollector adds a collector to the default registry. See Registry.AddCollector
+func AddCollector(collector func()) {
+	defaultRegistry.AddCollector(collector)
+}
+
+// Write writes the metrics in the default registry. See Registry.Write
+func Write(writer io.Writer) error {
+	return defaultRegistry.Write(writer)
+}
+
+func writeHeader(writer *bufio.Writer, name string, help string, metricType string) error {
+	_, err := writer.WriteString("# HELP " + name + " " + escapeHelp(help) + "\n# TYPE " + name + " " + metricType + "\n")
+	return err
+}
+
+func writeSample(writer *bufio.Writer, name string, labelNames []string, labelValues []string,
+	extraLabelName string, extraLabelValue string, value float64) error {
+
+	_, err := writer.WriteString(name)
+	if err != nil {
+		return err
+	}
+	if len(labelNames) > 0 || extraLabelName != "" {
+		pairs := make([]string, 0, len(labelNames)+1)
+		for i, labelName := range labelNames {
+			pairs = append(pairs, labelName+"=\""+escapeLabelValue(labelValues[i])+"\"")
+		}
+		if extraLabelName != "" {
+			pairs = append(pairs, extraLabelName+"=\""+escapeLabelValue(extraLabelValue)+"\"")
+		}
+		_, err = writer.WriteString("{" + strings.Join(pairs, ",") + "}")
+		if err != nil {
+			return err
+		}
+	}
+	_, err = writer.WriteString(" " + formatFloat(value) + "\n")
+	return err
+}
+
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+var helpReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func escapeHelp(help string) string {
+	return helpReplacer.Replace(help)
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeLabelValue(labelValue string) string {
+	return labelValueReplacer.Replace(labelValue)
+}
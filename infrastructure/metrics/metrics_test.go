@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	registry := NewRegistry()
+
+	counterVec := newCounterVec(registry, "test_requests_total", "The number of requests", "method")
+	counterVec.WithLabelValues("b").Add(2)
+	counterVec.WithLabelValues("a").Inc()
+
+	gauge := newGauge(registry, "test_size", "The size\nof things")
+	registry.AddCollector(func() {
+		gauge.Set(1.5)
+	})
+
+	histogramVec := newHistogramVec(registry, "test_duration_seconds", "The duration", []float64{0.1, 1}, "stage")
+	histogram := histogramVec.WithLabelValues(`a "quoted" stage`)
+	histogram.Observe(0.05)
+	histogram.Observe(0.5)
+	histogram.Observe(5)
+
+	buffer := &bytes.Buffer{}
+	err := registry.Write(buffer)
+	if err != nil {
+		t.Fatalf("Write: %s", err)
+	}
+
+	expected := `# HELP test_duration_seconds The duration
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{stage="a \"quoted\" stage",le="0.1"} 1
+test_duration_seconds_bucket{stage="a \"quoted\" stage",le="1"} 2
+test_duration_seconds_bucket{stage="a \"quoted\" stage",le="+Inf"} 3
+test_duration_seconds_sum{stage="a \"quoted\" stage"} 5.55
+test_duration_seconds_count{stage="a \"quoted\" stage"} 3
+# HELP test_requests_total The number of requests
+# TYPE test_requests_total counter
+test_requests_total{method="a"} 1
+test_requests_total{method="b"} 2
+# HELP test_size The size\nof things
+# TYPE test_size gauge
+test_size 1.5
+`
+	if buffer.String() != expected {
+		t.Fatalf("Unexpected metrics output. Want:\n%s\nGot:\n%s", expected, buffer.String())
+	}
+}
+
+func TestRegisterDuplicateName(t *testing.T) {
+	registry := NewRegistry()
+	newCounter(registry, "test_total", "A counter")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Registering a duplicate metric name unexpectedly didn't panic")
+		}
+	}()
+	newGauge(registry, "test_total", "A gauge")
+}
@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"net/http"
+)
+
+// metricsPath is the HTTP path under which the metrics are served
+const metricsPath = "/metrics"
+
+// Start starts an HTTP server that serves the metrics in the default registry, in the
+// Prometheus text format, under /metrics on the given listen address
+func Start(listenAddr string) {
+	serveMux := http.NewServeMux()
+	serveMux.HandleFunc(metricsPath, handleMetrics)
+
+	spawn("metrics.Start", func() {
+		log.Infof("Metrics server listening on %s", listenAddr)
+		log.Error(http.ListenAndServe(listenAddr, serveMux))
+	})
+}
+
+func handleMetrics(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	err := Write(writer)
+	if err != nil {
+		log.Warnf("Error writing metrics: %s", err)
+	}
+}
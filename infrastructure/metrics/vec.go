@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// labelValuesSeparator separates label values in the keys of vecChildren.
+// It can't appear in valid UTF-8 label values
+const labelValuesSeparator = "\xff"
+
+// vecChildren holds the children of a metric family, keyed by their label values
+type vecChildren struct {
+	lock       sync.RWMutex
+	labelNames []string
+	children   map[string]*vecChild
+}
+
+type vecChild struct {
+	labelValues []string
+	value       interface{}
+}
+
+func newVecChildren(labelNames []string) *vecChildren {
+	return &vecChildren{
+		labelNames: labelNames,
+		children:   make(map[string]*vecChild),
+	}
+}
+
+// get returns the child with the given label values, and creates it using newValue if it doesn't exist
+func (vc *vecChildren) get(labelValues []string, newValue func() interface{}) interface{} {
+	if len(labelValues) != len(vc.labelNames) {
+		panic("got " + strings.Join(labelValues, ",") + " as label values for labels " + strings.Join(vc.labelNames, ","))
+	}
+	key := strings.Join(labelValues, labelValuesSeparator)
+
+	vc.lock.RLock()
+	child, ok := vc.children[key]
+	vc.lock.RUnlock()
+	if ok {
+		return child.value
+	}
+
+	vc.lock.Lock()
+	defer vc.lock.Unlock()
+
+	if child, ok := vc.children[key]; ok {
+		return child.value
+	}
+	child = &vecChild{
+		labelValues: append([]string{}, labelValues...),
+		value:       newValue(),
+	}
+	vc.children[key] = child
+	return child.value
+}
+
+// sorted returns all the children, sorted by their label values
+func (vc *vecChildren) sorted() []*vecChild {
+	vc.lock.RLock()
+	defer vc.lock.RUnlock()
+
+	keys := make([]string, 0, len(vc.children))
+	for key := range vc.children {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	children := make([]*vecChild, len(keys))
+	for i, key := range keys {
+		children[i] = vc.children[key]
+	}
+	return children
+}
@@ -6,10 +6,8 @@ package addressmanager
 
 import (
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
-	"github.com/kaspanet/kaspad/util/mstime"
 	"net"
 	"sync"
-	"time"
 
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/pkg/errors"
@@ -25,10 +23,12 @@ type addressRandomizer interface {
 	RandomAddresses(addresses []*address, count int) []*appmessage.NetAddress
 }
 
-// addressKey represents a pair of IP and port, the IP is always in V6 representation
+// addressKey represents a pair of IP and port, the IP is always in V6 representation.
+// For onion addresses, onion is the public key of the onion service and the IP is zero
 type addressKey struct {
 	port    uint16
 	address ipv6
+	onion   onionPublicKey
 }
 
 type address struct {
@@ -38,6 +38,12 @@ type address struct {
 
 type ipv6 [net.IPv6len]byte
 
+type onionPublicKey [appmessage.OnionPublicKeySize]byte
+
+func (key addressKey) isOnion() bool {
+	return key.onion != onionPublicKey{}
+}
+
 func (i ipv6) equal(other ipv6) bool {
 	return i == other
 }
@@ -49,6 +55,10 @@ var ErrAddressNotFound = errors.New("address not found")
 // NetAddressKey returns a key of the ip address to use it in maps.
 func netAddressKey(netAddress *appmessage.NetAddress) addressKey {
 	key := addressKey{port: netAddress.Port}
+	if netAddress.IsOnion() {
+		copy(key.onion[:], netAddress.OnionPublicKey)
+		return key
+	}
 	// all IPv4 can be represented as IPv6.
 	copy(key.address[:], netAddress.IP.To16())
 	return key
@@ -87,6 +97,9 @@ func (am *AddressManager) addAddressNoLock(netAddress *appmessage.NetAddress) er
 	if !IsRoutable(netAddress, am.cfg.AcceptUnroutable) {
 		return nil
 	}
+	if am.isBannedNoLock(netAddress.IP) {
+		return nil
+	}
 
 	key := netAddressKey(netAddress)
 	// We mark `connectionFailedCount` as 0 only after first success
@@ -160,7 +173,7 @@ func (am *AddressManager) MarkConnectionFailure(address *appmessage.NetAddress)
 	key := netAddressKey(address)
 	entry, ok := am.store.getNotBanned(key)
 	if !ok {
-		return errors.Errorf("address %s is not registered with the address manager", address.TCPAddress())
+		return errors.Errorf("address %s is not registered with the address manager", address)
 	}
 	entry.connectionFailedCount = entry.connectionFailedCount + 1
 
@@ -181,7 +194,7 @@ func (am *AddressManager) MarkConnectionSuccess(address *appmessage.NetAddress)
 	key := netAddressKey(address)
 	entry, ok := am.store.getNotBanned(key)
 	if !ok {
-		return errors.Errorf("address %s is not registered with the address manager", address.TCPAddress())
+		return errors.Errorf("address %s is not registered with the address manager", address)
 	}
 	entry.connectionFailedCount = 0
 	return am.store.updateNotBanned(key, entry)
@@ -195,20 +208,24 @@ func (am *AddressManager) Addresses() []*appmessage.NetAddress {
 	return am.store.getAllNotBannedNetAddresses()
 }
 
-// BannedAddresses returns all banned addresses
-func (am *AddressManager) BannedAddresses() []*appmessage.NetAddress {
-	am.mutex.Lock()
-	defer am.mutex.Unlock()
-
-	return am.store.getAllBannedNetAddresses()
-}
-
 // notBannedAddressesWithException returns all not banned addresses with excpetion
 func (am *AddressManager) notBannedAddressesWithException(exceptions []*appmessage.NetAddress) []*address {
 	am.mutex.Lock()
 	defer am.mutex.Unlock()
 
-	return am.store.getAllNotBannedNetAddressesWithout(exceptions)
+	addresses := am.store.getAllNotBannedNetAddressesWithout(exceptions)
+	if am.cfg.OnionReachable {
+		return addresses
+	}
+
+	// Onion addresses are kept for relaying them to other peers, but they can't be connected to
+	reachableAddresses := make([]*address, 0, len(addresses))
+	for _, address := range addresses {
+		if !address.netAddress.IsOnion() {
+			reachableAddresses = append(reachableAddresses, address)
+		}
+	}
+	return reachableAddresses
 }
 
 // RandomAddresses returns count addresses at random that aren't banned and aren't in exceptions
@@ -222,78 +239,3 @@ func (am *AddressManager) RandomAddresses(count int, exceptions []*appmessage.Ne
 func (am *AddressManager) BestLocalAddress(remoteAddress *appmessage.NetAddress) *appmessage.NetAddress {
 	return am.localAddresses.bestLocalAddress(remoteAddress)
 }
-
-// Ban marks the given address as banned
-func (am *AddressManager) Ban(addressToBan *appmessage.NetAddress) error {
-	am.mutex.Lock()
-	defer am.mutex.Unlock()
-
-	keyToBan := netAddressKey(addressToBan)
-	keysToDelete := make([]addressKey, 0)
-	for _, address := range am.store.getAllNotBannedNetAddresses() {
-		key := netAddressKey(address)
-		if key.address.equal(keyToBan.address) {
-			keysToDelete = append(keysToDelete, key)
-		}
-	}
-	for _, key := range keysToDelete {
-		err := am.store.remove(key)
-		if err != nil {
-			return err
-		}
-	}
-
-	address := &address{netAddress: addressToBan}
-	return am.store.addBanned(keyToBan, address)
-}
-
-// Unban unmarks the given address as banned
-func (am *AddressManager) Unban(address *appmessage.NetAddress) error {
-	am.mutex.Lock()
-	defer am.mutex.Unlock()
-
-	key := netAddressKey(address)
-	if !am.store.isBanned(key) {
-		return errors.Wrapf(ErrAddressNotFound, "address %s "+
-			"is not registered with the address manager as banned", address.TCPAddress())
-	}
-
-	return am.store.removeBanned(key)
-}
-
-// IsBanned returns true if the given address is marked as banned
-func (am *AddressManager) IsBanned(address *appmessage.NetAddress) (bool, error) {
-	am.mutex.Lock()
-	defer am.mutex.Unlock()
-
-	key := netAddressKey(address)
-	err := am.unbanIfOldEnough(key)
-	if err != nil {
-		return false, err
-	}
-	if !am.store.isBanned(key) {
-		if !am.store.isNotBanned(key) {
-			return false, errors.Wrapf(ErrAddressNotFound, "address %s "+
-				"is not registered with the address manager", address.TCPAddress())
-		}
-		return false, nil
-	}
-
-	return true, nil
-}
-
-func (am *AddressManager) unbanIfOldEnough(key addressKey) error {
-	address, ok := am.store.getBanned(key)
-	if !ok {
-		return nil
-	}
-
-	const maxBanTime = 24 * time.Hour
-	if mstime.Since(address.netAddress.Timestamp) > maxBanTime {
-		err := am.store.removeBanned(key)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
@@ -0,0 +1,309 @@
+package addressmanager
+
+import (
+	"bytes"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+// Subnets broader than these prefix sizes can't be banned, since such a ban
+// would cut the node off from a large part of the network, if not all of it
+const (
+	minBannedIPv4SubnetPrefixSize = 8
+	minBannedIPv6SubnetPrefixSize = 16
+)
+
+// ipv4MappedPrefixSize is the size of the prefix of IPv4 addresses in V6 representation
+const ipv4MappedPrefixSize = (net.IPv6len - net.IPv4len) * 8
+
+// BanInfo describes the ban of a single IP or of a whole subnet
+type BanInfo struct {
+	Subnet    *net.IPNet
+	Reason    string
+	CreatedAt mstime.Time
+
+	// ExpiresAt is the zero time for bans that never expire
+	ExpiresAt mstime.Time
+}
+
+// banKey represents a banned subnet. The IP is always in V6 representation, and maskSize
+// is the size of the subnet's mask in that representation
+type banKey struct {
+	ip       ipv6
+	maskSize uint8
+}
+
+type ban struct {
+	// netAddress is the address that was banned, or the first address of the banned subnet
+	netAddress *appmessage.NetAddress
+	// subnet is always in V6 representation
+	subnet    *net.IPNet
+	reason    string
+	createdAt mstime.Time
+	expiresAt mstime.Time
+}
+
+func (b *ban) key() banKey {
+	maskSize, _ := b.subnet.Mask.Size()
+	key := banKey{maskSize: uint8(maskSize)}
+	copy(key.ip[:], b.subnet.IP.To16())
+	return key
+}
+
+func (b *ban) isExpired(now mstime.Time) bool {
+	return !b.expiresAt.IsZero() && !now.Before(b.expiresAt)
+}
+
+func (b *ban) info() *BanInfo {
+	return &BanInfo{
+		Subnet:    b.subnet,
+		Reason:    b.reason,
+		CreatedAt: b.createdAt,
+		ExpiresAt: b.expiresAt,
+	}
+}
+
+// normalizeSubnet returns the given subnet in V6 representation
+func normalizeSubnet(subnet *net.IPNet) *net.IPNet {
+	maskSize, bits := subnet.Mask.Size()
+	if bits == net.IPv4len*8 {
+		maskSize += (net.IPv6len - net.IPv4len) * 8
+	}
+	mask := net.CIDRMask(maskSize, net.IPv6len*8)
+	return &net.IPNet{
+		IP:   subnet.IP.To16().Mask(mask),
+		Mask: mask,
+	}
+}
+
+func singleIPSubnet(ip net.IP) *net.IPNet {
+	return &net.IPNet{
+		IP:   ip.To16(),
+		Mask: net.CIDRMask(net.IPv6len*8, net.IPv6len*8),
+	}
+}
+
+func singleIPBanKey(ip net.IP) banKey {
+	key := banKey{maskSize: net.IPv6len * 8}
+	copy(key.ip[:], ip.To16())
+	return key
+}
+
+// ParseSubnet parses either a single IP or a subnet in CIDR notation, such as 192.0.2.0/24.
+// Subnets with prefixes shorter than /8 for IPv4 or /16 for IPv6 are rejected
+func ParseSubnet(subnetString string) (*net.IPNet, error) {
+	if !strings.Contains(subnetString, "/") {
+		ip := net.ParseIP(subnetString)
+		if ip == nil {
+			return nil, errors.Errorf("invalid IP %s", subnetString)
+		}
+		return singleIPSubnet(ip), nil
+	}
+
+	_, subnet, err := net.ParseCIDR(subnetString)
+	if err != nil {
+		return nil, errors.Errorf("invalid subnet %s", subnetString)
+	}
+	subnet = normalizeSubnet(subnet)
+	if isTooBroadToBan(subnet) {
+		return nil, errors.Errorf("subnet %s is too broad. Subnets must have a prefix of at least "+
+			"/%d for IPv4 and /%d for IPv6", subnetString, minBannedIPv4SubnetPrefixSize, minBannedIPv6SubnetPrefixSize)
+	}
+	return subnet, nil
+}
+
+// isTooBroadToBan returns whether the given subnet, in V6 representation, is broader than
+// an IPv4 subnet of minBannedIPv4SubnetPrefixSize or an IPv6 subnet of minBannedIPv6SubnetPrefixSize.
+// IPv6 subnets that contain the whole IPv4-mapped address space count as IPv4 subnets of size 0
+func isTooBroadToBan(subnet *net.IPNet) bool {
+	prefixSize, _ := subnet.Mask.Size()
+	// net.IPNet.Contains treats IPv4 addresses separately, so the IPv4-mapped prefix is compared directly
+	if prefixSize <= ipv4MappedPrefixSize && bytes.Equal(net.IPv4zero.To16().Mask(subnet.Mask), subnet.IP) {
+		return true
+	}
+	if prefixSize > ipv4MappedPrefixSize && subnet.IP.To4() != nil {
+		return prefixSize-ipv4MappedPrefixSize < minBannedIPv4SubnetPrefixSize
+	}
+	return prefixSize < minBannedIPv6SubnetPrefixSize
+}
+
+// SubnetString returns the given subnet in CIDR notation, or just its IP if the subnet
+// consists of a single IP. IPv4 subnets are returned in IPv4 notation
+func SubnetString(subnet *net.IPNet) string {
+	subnet = normalizeSubnet(subnet)
+	maskSize, _ := subnet.Mask.Size()
+	if maskSize == net.IPv6len*8 {
+		return subnet.IP.String()
+	}
+
+	if ip4 := subnet.IP.To4(); ip4 != nil && maskSize >= ipv4MappedPrefixSize {
+		return (&net.IPNet{IP: ip4, Mask: net.CIDRMask(maskSize-ipv4MappedPrefixSize, net.IPv4len*8)}).String()
+	}
+	return subnet.String()
+}
+
+// Ban marks the given address as banned for the duration given by --banduration
+func (am *AddressManager) Ban(addressToBan *appmessage.NetAddress) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	now := mstime.Now()
+	return am.banNoLock(&ban{
+		netAddress: addressToBan,
+		subnet:     singleIPSubnet(addressToBan.IP),
+		createdAt:  now,
+		expiresAt:  now.Add(am.cfg.BanDuration.Truncate(time.Millisecond)),
+	})
+}
+
+// BanSubnet bans all the addresses in the given ban's subnet, replacing any previous
+// ban of exactly the same subnet
+func (am *AddressManager) BanSubnet(banInfo *BanInfo) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	subnet := normalizeSubnet(banInfo.Subnet)
+	return am.banNoLock(&ban{
+		netAddress: &appmessage.NetAddress{IP: subnet.IP, Timestamp: banInfo.CreatedAt},
+		subnet:     subnet,
+		reason:     banInfo.Reason,
+		createdAt:  banInfo.CreatedAt,
+		expiresAt:  banInfo.ExpiresAt,
+	})
+}
+
+func (am *AddressManager) banNoLock(ban *ban) error {
+	keysToDelete := make([]addressKey, 0)
+	for _, address := range am.store.getAllNotBannedNetAddresses() {
+		if ban.subnet.Contains(address.IP) {
+			keysToDelete = append(keysToDelete, netAddressKey(address))
+		}
+	}
+	for _, key := range keysToDelete {
+		err := am.store.remove(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return am.store.addBan(ban)
+}
+
+// Unban unmarks the given address as banned
+func (am *AddressManager) Unban(address *appmessage.NetAddress) error {
+	return am.UnbanSubnet(singleIPSubnet(address.IP))
+}
+
+// UnbanSubnet removes the ban of exactly the given subnet. Bans of other subnets that
+// contain it or are contained in it are kept
+func (am *AddressManager) UnbanSubnet(subnet *net.IPNet) error {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	key := (&ban{subnet: normalizeSubnet(subnet)}).key()
+	if _, ok := am.store.getBan(key); !ok {
+		return errors.Wrapf(ErrAddressNotFound, "address %s "+
+			"is not registered with the address manager as banned", SubnetString(subnet))
+	}
+
+	return am.store.removeBan(key)
+}
+
+// IsBanned returns true if the given address is in a banned subnet
+func (am *AddressManager) IsBanned(address *appmessage.NetAddress) (bool, error) {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	err := am.removeExpiredBansNoLock()
+	if err != nil {
+		return false, err
+	}
+	if _, ok := am.store.findBan(address.IP, mstime.Now()); !ok {
+		if !am.store.isNotBanned(netAddressKey(address)) {
+			return false, errors.Wrapf(ErrAddressNotFound, "address %s "+
+				"is not registered with the address manager", address)
+		}
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// isBannedNoLock returns whether the given IP is in a banned subnet that hasn't expired yet
+func (am *AddressManager) isBannedNoLock(ip net.IP) bool {
+	_, ok := am.store.findBan(ip, mstime.Now())
+	return ok
+}
+
+// BannedAddresses returns all banned addresses. Of banned subnets, only their first address is returned
+func (am *AddressManager) BannedAddresses() []*appmessage.NetAddress {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	bans := am.unexpiredBansNoLock()
+	bannedAddresses := make([]*appmessage.NetAddress, len(bans))
+	for i, ban := range bans {
+		bannedAddresses[i] = ban.netAddress
+	}
+	return bannedAddresses
+}
+
+// Bans returns all the bans that haven't expired yet, ordered by their creation time
+func (am *AddressManager) Bans() []*BanInfo {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+
+	bans := am.unexpiredBansNoLock()
+	sort.Slice(bans, func(i, j int) bool {
+		if bans[i].createdAt.UnixMilliseconds() != bans[j].createdAt.UnixMilliseconds() {
+			return bans[i].createdAt.Before(bans[j].createdAt)
+		}
+		return SubnetString(bans[i].subnet) < SubnetString(bans[j].subnet)
+	})
+
+	banInfos := make([]*BanInfo, len(bans))
+	for i, ban := range bans {
+		banInfos[i] = ban.info()
+	}
+	return banInfos
+}
+
+func (am *AddressManager) unexpiredBansNoLock() []*ban {
+	now := mstime.Now()
+	bans := make([]*ban, 0)
+	for _, ban := range am.store.getAllBans() {
+		if !ban.isExpired(now) {
+			bans = append(bans, ban)
+		}
+	}
+	return bans
+}
+
+func (am *AddressManager) removeExpiredBansNoLock() error {
+	now := mstime.Now()
+	for _, ban := range am.store.getAllBans() {
+		if ban.isExpired(now) {
+			log.Debugf("The ban of %s has expired", SubnetString(ban.subnet))
+			err := am.store.removeBan(ban.key())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// BanExpiry returns the time at which a ban that's created now for the given duration expires.
+// A duration of 0 means the ban never expires
+func BanExpiry(duration time.Duration) mstime.Time {
+	if duration == 0 {
+		return mstime.Time{}
+	}
+	return mstime.Now().Add(duration.Truncate(time.Millisecond))
+}
@@ -0,0 +1,286 @@
+package addressmanager
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/util/mstime"
+	"github.com/pkg/errors"
+)
+
+func TestParseSubnet(t *testing.T) {
+	tests := []struct {
+		subnet         string
+		expectedString string
+		expectsError   bool
+	}{
+		{subnet: "1.2.3.4", expectedString: "1.2.3.4"},
+		{subnet: "1.2.3.4/32", expectedString: "1.2.3.4"},
+		{subnet: "1.2.3.4/24", expectedString: "1.2.3.0/24"},
+		{subnet: "10.0.0.0/8", expectedString: "10.0.0.0/8"},
+		{subnet: "2001:db8::1", expectedString: "2001:db8::1"},
+		{subnet: "2001:db8::/32", expectedString: "2001:db8::/32"},
+		{subnet: "1.2.3", expectsError: true},
+		{subnet: "1.2.3.4/33", expectsError: true},
+		{subnet: "[2001:db8::1]", expectsError: true},
+		{subnet: "2001::/16", expectedString: "2001::/16"},
+		{subnet: "0.0.0.0/0", expectsError: true},
+		{subnet: "10.0.0.0/7", expectsError: true},
+		{subnet: "::/0", expectsError: true},
+		{subnet: "2000::/15", expectsError: true},
+		{subnet: "::ffff:0:0/96", expectsError: true},
+		{subnet: "::fffe:0:0/95", expectsError: true},
+		{subnet: "::ffff:a00:0/104", expectedString: "10.0.0.0/8"},
+	}
+
+	for _, test := range tests {
+		subnet, err := ParseSubnet(test.subnet)
+		if test.expectsError {
+			if err == nil {
+				t.Errorf("ParseSubnet(%s): expected an error", test.subnet)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseSubnet(%s): unexpected error: %s", test.subnet, err)
+			continue
+		}
+		subnetString := SubnetString(subnet)
+		if subnetString != test.expectedString {
+			t.Errorf("ParseSubnet(%s): unexpected subnet. Want: %s, got: %s",
+				test.subnet, test.expectedString, subnetString)
+		}
+	}
+}
+
+func TestBanSubnet(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestBanSubnet")
+	defer teardown()
+
+	addressInSubnet1 := &appmessage.NetAddress{IP: net.ParseIP("1.2.3.4"), Timestamp: mstime.Now()}
+	addressInSubnet2 := &appmessage.NetAddress{IP: net.ParseIP("1.2.3.5"), Timestamp: mstime.Now()}
+	addressOutsideSubnet := &appmessage.NetAddress{IP: net.ParseIP("5.6.7.8"), Timestamp: mstime.Now()}
+	err := addressManager.AddAddresses(addressInSubnet1, addressInSubnet2, addressOutsideSubnet)
+	if err != nil {
+		t.Fatalf("AddAddresses() failed: %s", err)
+	}
+
+	subnet, err := ParseSubnet("1.2.3.0/24")
+	if err != nil {
+		t.Fatalf("ParseSubnet() failed: %s", err)
+	}
+	err = addressManager.BanSubnet(&BanInfo{
+		Subnet:    subnet,
+		Reason:    "hosting provider",
+		CreatedAt: mstime.Now(),
+	})
+	if err != nil {
+		t.Fatalf("BanSubnet() failed: %s", err)
+	}
+
+	// Only the address outside the subnet should remain
+	addresses := addressManager.Addresses()
+	if len(addresses) != 1 || !addresses[0].IP.Equal(addressOutsideSubnet.IP) {
+		t.Fatalf("Unexpected addresses after banning the subnet: %v", addresses)
+	}
+
+	// Addresses in the subnet that were never added are banned as well
+	for _, ip := range []string{"1.2.3.4", "1.2.3.200"} {
+		isBanned, err := addressManager.IsBanned(appmessage.NewNetAddressIPPort(net.ParseIP(ip), 0))
+		if err != nil {
+			t.Fatalf("IsBanned() failed: %s", err)
+		}
+		if !isBanned {
+			t.Fatalf("Address %s is unexpectedly not banned", ip)
+		}
+	}
+	isBanned, err := addressManager.IsBanned(addressOutsideSubnet)
+	if err != nil {
+		t.Fatalf("IsBanned() failed: %s", err)
+	}
+	if isBanned {
+		t.Fatalf("Address %s is unexpectedly banned", addressOutsideSubnet.IP)
+	}
+
+	// Adding an address in the subnet should be ignored
+	err = addressManager.AddAddresses(&appmessage.NetAddress{IP: net.ParseIP("1.2.3.6"), Timestamp: mstime.Now()})
+	if err != nil {
+		t.Fatalf("AddAddresses() failed: %s", err)
+	}
+	if len(addressManager.Addresses()) != 1 {
+		t.Fatalf("An address in a banned subnet was unexpectedly added")
+	}
+
+	// Unbanning a single IP within the subnet shouldn't unban the subnet
+	err = addressManager.Unban(addressInSubnet1)
+	if !errors.Is(err, ErrAddressNotFound) {
+		t.Fatalf("Unexpected error from Unban(). Want: %s, got: %v", ErrAddressNotFound, err)
+	}
+
+	bans := addressManager.Bans()
+	if len(bans) != 1 {
+		t.Fatalf("Unexpected amount of bans. Want: 1, got: %d", len(bans))
+	}
+	if SubnetString(bans[0].Subnet) != "1.2.3.0/24" || bans[0].Reason != "hosting provider" {
+		t.Fatalf("Unexpected ban of %s with reason %s", SubnetString(bans[0].Subnet), bans[0].Reason)
+	}
+
+	err = addressManager.UnbanSubnet(subnet)
+	if err != nil {
+		t.Fatalf("UnbanSubnet() failed: %s", err)
+	}
+	if len(addressManager.Bans()) != 0 {
+		t.Fatalf("The subnet is still banned after UnbanSubnet()")
+	}
+}
+
+func TestBanExpiry(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestBanExpiry")
+	defer teardown()
+
+	expiredSubnet, err := ParseSubnet("1.2.3.0/24")
+	if err != nil {
+		t.Fatalf("ParseSubnet() failed: %s", err)
+	}
+	err = addressManager.BanSubnet(&BanInfo{
+		Subnet:    expiredSubnet,
+		CreatedAt: mstime.Now().Add(-2 * time.Hour),
+		ExpiresAt: mstime.Now().Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("BanSubnet() failed: %s", err)
+	}
+
+	// A live ban of a single IP in the expired subnet
+	liveAddress := appmessage.NewNetAddressIPPort(net.ParseIP("1.2.3.4"), 0)
+	err = addressManager.BanSubnet(&BanInfo{
+		Subnet:    singleIPSubnet(liveAddress.IP),
+		CreatedAt: mstime.Now(),
+		ExpiresAt: BanExpiry(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("BanSubnet() failed: %s", err)
+	}
+
+	isBanned, err := addressManager.IsBanned(liveAddress)
+	if err != nil {
+		t.Fatalf("IsBanned() failed: %s", err)
+	}
+	if !isBanned {
+		t.Fatalf("Address %s is unexpectedly not banned", liveAddress.IP)
+	}
+
+	// The expired ban was removed by IsBanned, so this address is neither banned nor known
+	_, err = addressManager.IsBanned(appmessage.NewNetAddressIPPort(net.ParseIP("1.2.3.5"), 0))
+	if !errors.Is(err, ErrAddressNotFound) {
+		t.Fatalf("Unexpected error from IsBanned(). Want: %s, got: %v", ErrAddressNotFound, err)
+	}
+
+	bans := addressManager.Bans()
+	if len(bans) != 1 || SubnetString(bans[0].Subnet) != "1.2.3.4" {
+		t.Fatalf("Unexpected bans after the expiry of a ban: %v", bans)
+	}
+}
+
+func TestRestoreBans(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	datadir := t.TempDir()
+	database, err := ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+
+	addressManager, err := New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+
+	// Write a ban in the format used before bans had expiry times and reasons
+	legacyAddress := &address{
+		netAddress: &appmessage.NetAddress{IP: net.ParseIP("9.9.9.9"), Timestamp: mstime.Now()},
+	}
+	legacyKey := legacyBannedAddressBucket.Key(addressManager.store.serializeAddressKey(netAddressKey(legacyAddress.netAddress)))
+	err = database.Put(legacyKey, addressManager.store.serializeAddress(legacyAddress))
+	if err != nil {
+		t.Fatalf("Put() failed: %s", err)
+	}
+
+	permanentSubnet, err := ParseSubnet("2001:db8::/32")
+	if err != nil {
+		t.Fatalf("ParseSubnet() failed: %s", err)
+	}
+	permanentBan := &BanInfo{
+		Subnet:    permanentSubnet,
+		Reason:    "hosting provider",
+		CreatedAt: mstime.Now(),
+	}
+	err = addressManager.BanSubnet(permanentBan)
+	if err != nil {
+		t.Fatalf("BanSubnet() failed: %s", err)
+	}
+
+	err = database.Close()
+	if err != nil {
+		t.Fatalf("Close() failed: %s", err)
+	}
+	database, err = ldb.NewLevelDB(datadir, 8)
+	if err != nil {
+		t.Fatalf("Could not create a database: %s", err)
+	}
+	defer database.Close()
+
+	addressManager, err = New(NewConfig(cfg), database)
+	if err != nil {
+		t.Fatalf("Error creating address manager: %s", err)
+	}
+
+	bans := addressManager.Bans()
+	if len(bans) != 2 {
+		t.Fatalf("Unexpected amount of bans. Want: 2, got: %d", len(bans))
+	}
+
+	var restoredLegacyBan, restoredPermanentBan *BanInfo
+	for _, ban := range bans {
+		switch SubnetString(ban.Subnet) {
+		case "9.9.9.9":
+			restoredLegacyBan = ban
+		case "2001:db8::/32":
+			restoredPermanentBan = ban
+		}
+	}
+	if restoredLegacyBan == nil || restoredPermanentBan == nil {
+		t.Fatalf("Unexpected restored bans: %v", bans)
+	}
+
+	expectedLegacyExpiry := legacyAddress.netAddress.Timestamp.Add(legacyBanDuration)
+	if restoredLegacyBan.ExpiresAt.UnixMilliseconds() != expectedLegacyExpiry.UnixMilliseconds() {
+		t.Fatalf("Unexpected expiry of the migrated ban. Want: %s, got: %s",
+			expectedLegacyExpiry, restoredLegacyBan.ExpiresAt)
+	}
+	if restoredPermanentBan.Reason != permanentBan.Reason {
+		t.Fatalf("Unexpected reason of the restored ban. Want: %s, got: %s",
+			permanentBan.Reason, restoredPermanentBan.Reason)
+	}
+	if !restoredPermanentBan.ExpiresAt.IsZero() {
+		t.Fatalf("The restored permanent ban unexpectedly expires at %s", restoredPermanentBan.ExpiresAt)
+	}
+	if restoredPermanentBan.CreatedAt.UnixMilliseconds() != permanentBan.CreatedAt.UnixMilliseconds() {
+		t.Fatalf("Unexpected creation time of the restored ban. Want: %s, got: %s",
+			permanentBan.CreatedAt, restoredPermanentBan.CreatedAt)
+	}
+
+	// The legacy bucket should have been emptied by the migration
+	hasLegacyKey, err := database.Has(legacyKey)
+	if err != nil {
+		t.Fatalf("Has() failed: %s", err)
+	}
+	if hasLegacyKey {
+		t.Fatalf("The legacy ban was not removed by the migration")
+	}
+}
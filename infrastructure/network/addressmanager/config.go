@@ -2,6 +2,7 @@ package addressmanager
 
 import (
 	"net"
+	"time"
 
 	"github.com/kaspanet/kaspad/infrastructure/config"
 )
@@ -13,6 +14,15 @@ type Config struct {
 	ExternalIPs      []string
 	Listeners        []string
 	Lookup           func(string) ([]net.IP, error)
+	BanDuration      time.Duration
+
+	// Onion is the address of this node's onion service, if it has one. When it's set,
+	// it's the only local address that's advertised to peers
+	Onion string
+
+	// OnionReachable is whether outbound connections to onion addresses can be made,
+	// that is, whether they go through a proxy
+	OnionReachable bool
 }
 
 // NewConfig returns a new address manager Config.
@@ -23,5 +33,8 @@ func NewConfig(cfg *config.Config) *Config {
 		ExternalIPs:      cfg.ExternalIPs,
 		Listeners:        cfg.Listeners,
 		Lookup:           cfg.Lookup,
+		BanDuration:      cfg.BanDuration,
+		Onion:            cfg.Onion,
+		OnionReachable:   cfg.Proxy != "",
 	}
 }
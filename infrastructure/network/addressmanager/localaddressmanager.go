@@ -39,6 +39,7 @@ type localAddress struct {
 
 type localAddressManager struct {
 	localAddresses map[addressKey]*localAddress
+	onionAddress   *appmessage.NetAddress
 	lookupFunc     func(string) ([]net.IP, error)
 	cfg            *Config
 	mutex          sync.Mutex
@@ -51,6 +52,20 @@ func newLocalAddressManager(cfg *Config) (*localAddressManager, error) {
 		lookupFunc:     cfg.Lookup,
 	}
 
+	// A node with an onion service advertises only its onion address, so as not
+	// to expose its IP
+	if cfg.Onion != "" {
+		onionAddress, err := appmessage.NewNetAddressFromString(cfg.Onion)
+		if err != nil {
+			return nil, err
+		}
+		if !onionAddress.IsOnion() {
+			return nil, errors.Errorf("%s is not an onion address", cfg.Onion)
+		}
+		localAddressManager.onionAddress = onionAddress
+		return &localAddressManager, nil
+	}
+
 	err := localAddressManager.initListeners()
 	if err != nil {
 		return nil, err
@@ -87,6 +102,10 @@ func (lam *localAddressManager) addLocalNetAddress(netAddress *appmessage.NetAdd
 // bestLocalAddress returns the most appropriate local address to use
 // for the given remote address.
 func (lam *localAddressManager) bestLocalAddress(remoteAddress *appmessage.NetAddress) *appmessage.NetAddress {
+	if lam.onionAddress != nil {
+		return lam.onionAddress
+	}
+
 	lam.mutex.Lock()
 	defer lam.mutex.Unlock()
 
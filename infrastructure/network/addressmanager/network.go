@@ -5,6 +5,7 @@
 package addressmanager
 
 import (
+	"fmt"
 	"net"
 
 	"github.com/kaspanet/kaspad/app/appmessage"
@@ -206,8 +207,11 @@ func IsValid(na *appmessage.NetAddress) bool {
 
 // IsRoutable returns whether or not the passed address is routable over
 // the public internet. This is true as long as the address is valid and is not
-// in any reserved ranges.
+// in any reserved ranges. Onion addresses are always routable over Tor.
 func IsRoutable(na *appmessage.NetAddress, acceptUnroutable bool) bool {
+	if na.IsOnion() {
+		return true
+	}
 	if acceptUnroutable {
 		return !IsLocal(na)
 	}
@@ -219,10 +223,14 @@ func IsRoutable(na *appmessage.NetAddress, acceptUnroutable bool) bool {
 }
 
 // GroupKey returns a string representing the network group an address is part
-// of. This is the /16 for IPv4, the /32 (/36 for he.net) for IPv6, the string
+// of. This is the /16 for IPv4, the /32 (/36 for he.net) for IPv6, "tor:" followed
+// by the first four bits of the public key for onion addresses, the string
 // "local" for a local address, and the string "unroutable" for an unroutable
 // address.
 func (am *AddressManager) GroupKey(na *appmessage.NetAddress) string {
+	if na.IsOnion() {
+		return fmt.Sprintf("tor:%d", na.OnionPublicKey[0]>>4)
+	}
 	if IsLocal(na) {
 		return "local"
 	}
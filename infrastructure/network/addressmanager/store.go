@@ -7,34 +7,46 @@ import (
 	"github.com/kaspanet/kaspad/util/mstime"
 	"github.com/pkg/errors"
 	"net"
+	"time"
 )
 
 var notBannedAddressBucket = database.MakeBucket([]byte("not-banned-addresses"))
-var bannedAddressBucket = database.MakeBucket([]byte("banned-addresses"))
+var banBucket = database.MakeBucket([]byte("bans"))
+
+// legacyBannedAddressBucket holds the bans of single IPs that were made before bans had
+// subnets, reasons and expiry times. They're migrated to banBucket on startup
+var legacyBannedAddressBucket = database.MakeBucket([]byte("banned-addresses"))
+
+// legacyBanDuration is the duration of the bans in legacyBannedAddressBucket
+const legacyBanDuration = 24 * time.Hour
 
 type addressStore struct {
 	database           database.Database
 	notBannedAddresses map[addressKey]*address
-	bannedAddresses    map[ipv6]*address
+	bans               map[banKey]*ban
 }
 
 func newAddressStore(database database.Database) (*addressStore, error) {
 	addressStore := &addressStore{
 		database:           database,
 		notBannedAddresses: map[addressKey]*address{},
-		bannedAddresses:    map[ipv6]*address{},
+		bans:               map[banKey]*ban{},
 	}
 	err := addressStore.restoreNotBannedAddresses()
 	if err != nil {
 		return nil, err
 	}
-	err = addressStore.restoreBannedAddresses()
+	err = addressStore.restoreBans()
+	if err != nil {
+		return nil, err
+	}
+	err = addressStore.migrateLegacyBannedAddresses()
 	if err != nil {
 		return nil, err
 	}
 
-	log.Infof("Loaded %d addresses and %d banned addresses",
-		len(addressStore.notBannedAddresses), len(addressStore.bannedAddresses))
+	log.Infof("Loaded %d addresses and %d bans",
+		len(addressStore.notBannedAddresses), len(addressStore.bans))
 
 	return addressStore, nil
 }
@@ -63,26 +75,67 @@ func (as *addressStore) restoreNotBannedAddresses() error {
 	return nil
 }
 
-func (as *addressStore) restoreBannedAddresses() error {
-	cursor, err := as.database.Cursor(bannedAddressBucket)
+func (as *addressStore) restoreBans() error {
+	cursor, err := as.database.Cursor(banBucket)
 	if err != nil {
 		return err
 	}
 	defer cursor.Close()
 	for ok := cursor.First(); ok; ok = cursor.Next() {
-		databaseKey, err := cursor.Key()
+		serializedBan, err := cursor.Value()
+		if err != nil {
+			return err
+		}
+		ban, err := as.deserializeBan(serializedBan)
 		if err != nil {
 			return err
 		}
-		var ipv6 ipv6
-		copy(ipv6[:], databaseKey.Suffix())
+		as.bans[ban.key()] = ban
+	}
+	return nil
+}
 
+func (as *addressStore) migrateLegacyBannedAddresses() error {
+	cursor, err := as.database.Cursor(legacyBannedAddressBucket)
+	if err != nil {
+		return err
+	}
+	legacyKeys := make([]*database.Key, 0)
+	legacyBans := make([]*ban, 0)
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		databaseKey, err := cursor.Key()
+		if err != nil {
+			cursor.Close()
+			return err
+		}
 		serializedNetAddress, err := cursor.Value()
+		if err != nil {
+			cursor.Close()
+			return err
+		}
+		netAddress := as.deserializeAddress(serializedNetAddress).netAddress
+		legacyKeys = append(legacyKeys, databaseKey)
+		legacyBans = append(legacyBans, &ban{
+			netAddress: netAddress,
+			subnet:     singleIPSubnet(netAddress.IP),
+			createdAt:  netAddress.Timestamp,
+			expiresAt:  netAddress.Timestamp.Add(legacyBanDuration),
+		})
+	}
+	err = cursor.Close()
+	if err != nil {
+		return err
+	}
+
+	for i, legacyBan := range legacyBans {
+		err := as.addBan(legacyBan)
+		if err != nil {
+			return err
+		}
+		err = as.database.Delete(legacyKeys[i])
 		if err != nil {
 			return err
 		}
-		netAddress := as.deserializeAddress(serializedNetAddress)
-		as.bannedAddresses[ipv6] = netAddress
 	}
 	return nil
 }
@@ -106,7 +159,7 @@ func (as *addressStore) add(key addressKey, address *address) error {
 // updateNotBanned updates the not-banned address collection
 func (as *addressStore) updateNotBanned(key addressKey, address *address) error {
 	if _, ok := as.notBannedAddresses[key]; !ok {
-		return errors.Errorf("address %s is not in the store", address.netAddress.TCPAddress())
+		return errors.Errorf("address %s is not in the store", address.netAddress)
 	}
 
 	as.notBannedAddresses[key] = address
@@ -161,41 +214,46 @@ func (as *addressStore) isNotBanned(key addressKey) bool {
 	return ok
 }
 
-func (as *addressStore) addBanned(key addressKey, address *address) error {
-	if _, ok := as.bannedAddresses[key.address]; ok {
-		return nil
-	}
-
-	as.bannedAddresses[key.address] = address
+// addBan adds the given ban, replacing any previous ban of the same subnet
+func (as *addressStore) addBan(ban *ban) error {
+	key := ban.key()
+	as.bans[key] = ban
 
-	databaseKey := as.bannedDatabaseKey(key)
-	serializedAddress := as.serializeAddress(address)
-	return as.database.Put(databaseKey, serializedAddress)
+	databaseKey := as.banDatabaseKey(key)
+	return as.database.Put(databaseKey, as.serializeBan(ban))
 }
 
-func (as *addressStore) removeBanned(key addressKey) error {
-	delete(as.bannedAddresses, key.address)
+func (as *addressStore) removeBan(key banKey) error {
+	delete(as.bans, key)
 
-	databaseKey := as.bannedDatabaseKey(key)
+	databaseKey := as.banDatabaseKey(key)
 	return as.database.Delete(databaseKey)
 }
 
-func (as *addressStore) getAllBannedNetAddresses() []*appmessage.NetAddress {
-	bannedAddresses := make([]*appmessage.NetAddress, 0, len(as.bannedAddresses))
-	for _, bannedAddress := range as.bannedAddresses {
-		bannedAddresses = append(bannedAddresses, bannedAddress.netAddress)
-	}
-	return bannedAddresses
+func (as *addressStore) getBan(key banKey) (*ban, bool) {
+	ban, ok := as.bans[key]
+	return ban, ok
 }
 
-func (as *addressStore) isBanned(key addressKey) bool {
-	_, ok := as.bannedAddresses[key.address]
-	return ok
+func (as *addressStore) getAllBans() []*ban {
+	bans := make([]*ban, 0, len(as.bans))
+	for _, ban := range as.bans {
+		bans = append(bans, ban)
+	}
+	return bans
 }
 
-func (as *addressStore) getBanned(key addressKey) (*address, bool) {
-	bannedAddress, ok := as.bannedAddresses[key.address]
-	return bannedAddress, ok
+// findBan returns a ban of a subnet that contains the given IP, and that isn't expired at the given time
+func (as *addressStore) findBan(ip net.IP, now mstime.Time) (*ban, bool) {
+	if ban, ok := as.bans[singleIPBanKey(ip)]; ok && !ban.isExpired(now) {
+		return ban, true
+	}
+	for _, ban := range as.bans {
+		if ban.subnet.Contains(ip) && !ban.isExpired(now) {
+			return ban, true
+		}
+	}
+	return nil, false
 }
 
 // netAddressKeys returns a key of the ip address to use it in maps.
@@ -213,16 +271,33 @@ func (as *addressStore) notBannedDatabaseKey(key addressKey) *database.Key {
 	return notBannedAddressBucket.Key(serializedKey)
 }
 
-func (as *addressStore) bannedDatabaseKey(key addressKey) *database.Key {
-	return bannedAddressBucket.Key(key.address[:])
+func (as *addressStore) banDatabaseKey(key banKey) *database.Key {
+	serializedKey := make([]byte, net.IPv6len+1)
+	copy(serializedKey, key.ip[:])
+	serializedKey[net.IPv6len] = key.maskSize
+	return banBucket.Key(serializedKey)
 }
 
+// Onion addresses are serialized like IP addresses with a zero IP, followed by
+// the public key of the onion service. This keeps the serialization of IP
+// addresses the same as before onion addresses were supported
+const (
+	serializedAddressKeySize = 16 + 2         // ipv6 + port
+	serializedAddressSize    = 16 + 2 + 8 + 8 // ipv6 + port + timestamp + connectionFailedCount
+)
+
 func (as *addressStore) serializeAddressKey(key addressKey) []byte {
-	serializedSize := 16 + 2 // ipv6 + port
+	serializedSize := serializedAddressKeySize
+	if key.isOnion() {
+		serializedSize += appmessage.OnionPublicKeySize
+	}
 	serializedKey := make([]byte, serializedSize)
 
 	copy(serializedKey[:], key.address[:])
 	binary.LittleEndian.PutUint16(serializedKey[16:], key.port)
+	if key.isOnion() {
+		copy(serializedKey[serializedAddressKeySize:], key.onion[:])
+	}
 
 	return serializedKey
 }
@@ -233,20 +308,30 @@ func (as *addressStore) deserializeAddressKey(serializedKey []byte) addressKey {
 
 	port := binary.LittleEndian.Uint16(serializedKey[16:])
 
-	return addressKey{
+	key := addressKey{
 		port:    port,
 		address: ip,
 	}
+	if len(serializedKey) > serializedAddressKeySize {
+		copy(key.onion[:], serializedKey[serializedAddressKeySize:])
+	}
+	return key
 }
 
 func (as *addressStore) serializeAddress(address *address) []byte {
-	serializedSize := 16 + 2 + 8 + 8 // ipv6 + port + timestamp + connectionFailedCount
+	serializedSize := serializedAddressSize
+	if address.netAddress.IsOnion() {
+		serializedSize += appmessage.OnionPublicKeySize
+	}
 	serializedNetAddress := make([]byte, serializedSize)
 
 	copy(serializedNetAddress[:], address.netAddress.IP.To16()[:])
 	binary.LittleEndian.PutUint16(serializedNetAddress[16:], address.netAddress.Port)
 	binary.LittleEndian.PutUint64(serializedNetAddress[18:], uint64(address.netAddress.Timestamp.UnixMilliseconds()))
 	binary.LittleEndian.PutUint64(serializedNetAddress[26:], uint64(address.connectionFailedCount))
+	if address.netAddress.IsOnion() {
+		copy(serializedNetAddress[serializedAddressSize:], address.netAddress.OnionPublicKey)
+	}
 
 	return serializedNetAddress
 }
@@ -259,12 +344,78 @@ func (as *addressStore) deserializeAddress(serializedAddress []byte) *address {
 	timestamp := mstime.UnixMilliseconds(int64(binary.LittleEndian.Uint64(serializedAddress[18:])))
 	connectionFailedCount := binary.LittleEndian.Uint64(serializedAddress[26:])
 
+	netAddress := &appmessage.NetAddress{
+		IP:        ip,
+		Port:      port,
+		Timestamp: timestamp,
+	}
+	if len(serializedAddress) > serializedAddressSize {
+		netAddress.IP = nil
+		netAddress.OnionPublicKey = make([]byte, appmessage.OnionPublicKeySize)
+		copy(netAddress.OnionPublicKey, serializedAddress[serializedAddressSize:])
+	}
+
 	return &address{
+		netAddress:            netAddress,
+		connectionFailedCount: connectionFailedCount,
+	}
+}
+
+// serializedBanFixedSize is the size of a serialized ban without its reason:
+// ipv6 + mask size + port + address timestamp + created at + expires at
+const serializedBanFixedSize = 16 + 1 + 2 + 8 + 8 + 8
+
+func (as *addressStore) serializeBan(ban *ban) []byte {
+	serializedBan := make([]byte, serializedBanFixedSize+len(ban.reason))
+
+	maskSize, _ := ban.subnet.Mask.Size()
+	copy(serializedBan[:], ban.subnet.IP.To16())
+	serializedBan[16] = uint8(maskSize)
+	binary.LittleEndian.PutUint16(serializedBan[17:], ban.netAddress.Port)
+	binary.LittleEndian.PutUint64(serializedBan[19:], uint64(ban.netAddress.Timestamp.UnixMilliseconds()))
+	binary.LittleEndian.PutUint64(serializedBan[27:], uint64(ban.createdAt.UnixMilliseconds()))
+	expiresAt := int64(0)
+	if !ban.expiresAt.IsZero() {
+		expiresAt = ban.expiresAt.UnixMilliseconds()
+	}
+	binary.LittleEndian.PutUint64(serializedBan[35:], uint64(expiresAt))
+	copy(serializedBan[serializedBanFixedSize:], ban.reason)
+
+	return serializedBan
+}
+
+func (as *addressStore) deserializeBan(serializedBan []byte) (*ban, error) {
+	if len(serializedBan) < serializedBanFixedSize {
+		return nil, errors.Errorf("serialized ban is %d bytes long, while at least %d bytes are expected",
+			len(serializedBan), serializedBanFixedSize)
+	}
+
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, serializedBan[:])
+	maskSize := int(serializedBan[16])
+	if maskSize > net.IPv6len*8 {
+		return nil, errors.Errorf("serialized ban has an invalid mask size %d", maskSize)
+	}
+	port := binary.LittleEndian.Uint16(serializedBan[17:])
+	timestamp := mstime.UnixMilliseconds(int64(binary.LittleEndian.Uint64(serializedBan[19:])))
+	createdAt := mstime.UnixMilliseconds(int64(binary.LittleEndian.Uint64(serializedBan[27:])))
+	var expiresAt mstime.Time
+	if serializedExpiresAt := int64(binary.LittleEndian.Uint64(serializedBan[35:])); serializedExpiresAt != 0 {
+		expiresAt = mstime.UnixMilliseconds(serializedExpiresAt)
+	}
+
+	return &ban{
 		netAddress: &appmessage.NetAddress{
 			IP:        ip,
 			Port:      port,
 			Timestamp: timestamp,
 		},
-		connectionFailedCount: connectionFailedCount,
-	}
+		subnet: &net.IPNet{
+			IP:   ip.Mask(net.CIDRMask(maskSize, net.IPv6len*8)),
+			Mask: net.CIDRMask(maskSize, net.IPv6len*8),
+		},
+		reason:    string(serializedBan[serializedBanFixedSize:]),
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+	}, nil
 }
@@ -45,3 +45,33 @@ func TestAddressSerialization(t *testing.T) {
 			"testAddress:%+v\ndeserializedTestAddress:%+v", testAddress, deserializedTestAddress)
 	}
 }
+
+func TestOnionAddressSerialization(t *testing.T) {
+	addressManager, teardown := newAddressManagerForTest(t, "TestOnionAddressSerialization")
+	defer teardown()
+	addressStore := addressManager.store
+
+	onionPublicKey, err := appmessage.ParseOnionHost("duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion")
+	if err != nil {
+		t.Fatalf("ParseOnionHost: %s", err)
+	}
+	testAddress := &address{
+		netAddress:            appmessage.NewNetAddressOnion(onionPublicKey, 16111),
+		connectionFailedCount: 3,
+	}
+
+	testAddressKey := netAddressKey(testAddress.netAddress)
+	serializedTestAddressKey := addressStore.serializeAddressKey(testAddressKey)
+	deserializedTestAddressKey := addressStore.deserializeAddressKey(serializedTestAddressKey)
+	if !reflect.DeepEqual(testAddressKey, deserializedTestAddressKey) {
+		t.Fatalf("testAddressKey and deserializedTestAddressKey are not equal\n"+
+			"testAddressKey:%+v\ndeserializedTestAddressKey:%+v", testAddressKey, deserializedTestAddressKey)
+	}
+
+	serializedTestAddress := addressStore.serializeAddress(testAddress)
+	deserializedTestAddress := addressStore.deserializeAddress(serializedTestAddress)
+	if !reflect.DeepEqual(testAddress, deserializedTestAddress) {
+		t.Fatalf("testAddress and deserializedTestAddress are not equal\n"+
+			"testAddress:%+v\ndeserializedTestAddress:%+v", testAddress, deserializedTestAddress)
+	}
+}
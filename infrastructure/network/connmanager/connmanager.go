@@ -8,6 +8,7 @@ import (
 
 	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/network/dnsseed"
+	"github.com/kaspanet/kaspad/util/mstime"
 	"github.com/pkg/errors"
 
 	"github.com/kaspanet/kaspad/infrastructure/network/addressmanager"
@@ -25,6 +26,9 @@ type connectionRequest struct {
 	retryDuration time.Duration
 }
 
+// MisbehaviorScoreFunc returns the misbehavior score of the peer behind the given connection
+type MisbehaviorScoreFunc func(connection *netadapter.NetConnection) uint32
+
 // ConnectionManager monitors that the current active connections satisfy the requirements of
 // outgoing, requested and incoming connections
 type ConnectionManager struct {
@@ -42,6 +46,8 @@ type ConnectionManager struct {
 	stop                   uint32
 	connectionRequestsLock sync.RWMutex
 
+	misbehaviorScoreFunc MisbehaviorScoreFunc
+
 	resetLoopChan chan struct{}
 	loopTicker    *time.Ticker
 }
@@ -78,6 +84,13 @@ func New(cfg *config.Config, netAdapter *netadapter.NetAdapter, addressManager *
 	return c, nil
 }
 
+// SetMisbehaviorScoreFunc sets the function that scores the misbehavior of connections.
+// When there are too many incoming connections, the ones with the highest scores are
+// disconnected first. It must be called before Start
+func (c *ConnectionManager) SetMisbehaviorScoreFunc(misbehaviorScoreFunc MisbehaviorScoreFunc) {
+	c.misbehaviorScoreFunc = misbehaviorScoreFunc
+}
+
 // Start begins the operation of the ConnectionManager
 func (c *ConnectionManager) Start() {
 	spawn("ConnectionManager.connectionsLoop", c.connectionsLoop)
@@ -133,37 +146,62 @@ func (c *ConnectionManager) ConnectionCount() int {
 	return c.netAdapter.P2PConnectionCount()
 }
 
+// ConnectionCountByDirection returns the count of the connected inbound and outbound connections
+func (c *ConnectionManager) ConnectionCountByDirection() (inbound int, outbound int) {
+	for _, connection := range c.netAdapter.P2PConnections() {
+		if connection.IsOutbound() {
+			outbound++
+		} else {
+			inbound++
+		}
+	}
+	return inbound, outbound
+}
+
 // ErrCannotBanPermanent is the error returned when trying to ban a permanent peer.
 var ErrCannotBanPermanent = errors.New("ErrCannotBanPermanent")
 
-// Ban marks the given netConnection as banned
-func (c *ConnectionManager) Ban(netConnection *netadapter.NetConnection) error {
+// Ban bans the IP of the given netConnection for the duration given by --banduration
+func (c *ConnectionManager) Ban(netConnection *netadapter.NetConnection, reason string) error {
 	if c.isPermanent(netConnection.Address()) {
 		return errors.Wrapf(ErrCannotBanPermanent, "Cannot ban %s because it's a permanent connection", netConnection.Address())
 	}
 
-	return c.addressManager.Ban(netConnection.NetAddress())
+	// Onion peers have no IP to ban, and all inbound connections through our own onion
+	// service come from the local Tor daemon, so banning its IP would ban them all
+	netAddress := netConnection.NetAddress()
+	if netAddress.IsOnion() || (c.cfg.Onion != "" && netAddress.IP.IsLoopback()) {
+		log.Infof("Not banning %s because it's connected through Tor", netConnection)
+		return nil
+	}
+
+	return c.addressManager.BanSubnet(&addressmanager.BanInfo{
+		Subnet:    &net.IPNet{IP: netAddress.IP, Mask: net.CIDRMask(net.IPv6len*8, net.IPv6len*8)},
+		Reason:    reason,
+		CreatedAt: mstime.Now(),
+		ExpiresAt: addressmanager.BanExpiry(c.cfg.BanDuration),
+	})
 }
 
-// BanByIP bans the given IP and disconnects from all the connection with that IP.
-func (c *ConnectionManager) BanByIP(ip net.IP) error {
-	ipHasPermanentConnection, err := c.ipHasPermanentConnection(ip)
+// BanSubnet bans the subnet of the given ban and disconnects from all the connections within it.
+func (c *ConnectionManager) BanSubnet(ban *addressmanager.BanInfo) error {
+	subnetHasPermanentConnection, err := c.subnetHasPermanentConnection(ban.Subnet)
 	if err != nil {
 		return err
 	}
 
-	if ipHasPermanentConnection {
-		return errors.Wrapf(ErrCannotBanPermanent, "Cannot ban %s because it's a permanent connection", ip)
+	if subnetHasPermanentConnection {
+		return errors.Wrapf(ErrCannotBanPermanent, "Cannot ban %s because it contains a permanent connection", addressmanager.SubnetString(ban.Subnet))
 	}
 
 	connections := c.netAdapter.P2PConnections()
 	for _, conn := range connections {
-		if conn.NetAddress().IP.Equal(ip) {
+		if ban.Subnet.Contains(conn.NetAddress().IP) {
 			conn.Disconnect()
 		}
 	}
 
-	return c.addressManager.Ban(appmessage.NewNetAddressIPPort(ip, 0))
+	return c.addressManager.BanSubnet(ban)
 }
 
 // IsBanned returns whether the given netConnection is banned
@@ -198,7 +236,7 @@ func (c *ConnectionManager) isPermanent(addressString string) bool {
 	return false
 }
 
-func (c *ConnectionManager) ipHasPermanentConnection(ip net.IP) (bool, error) {
+func (c *ConnectionManager) subnetHasPermanentConnection(subnet *net.IPNet) (bool, error) {
 	c.connectionRequestsLock.RLock()
 	defer c.connectionRequestsLock.RUnlock()
 
@@ -213,7 +251,7 @@ func (c *ConnectionManager) ipHasPermanentConnection(ip net.IP) (bool, error) {
 		}
 
 		for _, extractedIP := range ips {
-			if extractedIP.Equal(ip) {
+			if subnet.Contains(extractedIP) {
 				return true, nil
 			}
 		}
@@ -230,7 +268,7 @@ func (c *ConnectionManager) ipHasPermanentConnection(ip net.IP) (bool, error) {
 		}
 
 		for _, extractedIP := range ips {
-			if extractedIP.Equal(ip) {
+			if subnet.Contains(extractedIP) {
 				return true, nil
 			}
 		}
@@ -1,7 +1,14 @@
 package connmanager
 
+import (
+	"sort"
+
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter"
+)
+
 // checkIncomingConnections makes sure there's no more than maxIncoming incoming connections
-// if there are - it randomly disconnects enough to go below that number
+// if there are - it disconnects enough to go below that number, starting from the connections
+// with the highest misbehavior scores
 func (c *ConnectionManager) checkIncomingConnections(incomingConnectionSet connectionSet) {
 	if len(incomingConnectionSet) <= c.maxIncoming {
 		return
@@ -11,14 +18,29 @@ func (c *ConnectionManager) checkIncomingConnections(incomingConnectionSet conne
 	log.Debugf("Got %d incoming connections while only %d are allowed. Disconnecting "+
 		"%d", len(incomingConnectionSet), c.maxIncoming, numConnectionsOverMax)
 
-	// randomly disconnect nodes until the number of incoming connections is smaller than maxIncoming
-	for _, connection := range incomingConnectionSet {
+	for _, connection := range c.connectionsByEvictionOrder(incomingConnectionSet)[:numConnectionsOverMax] {
 		log.Debugf("Disconnecting %s due to exceeding incoming connections", connection)
 		connection.Disconnect()
+	}
+}
+
+// connectionsByEvictionOrder returns the given connections ordered by their misbehavior scores,
+// from highest to lowest. Connections with equal scores are in random order
+func (c *ConnectionManager) connectionsByEvictionOrder(connSet connectionSet) []*netadapter.NetConnection {
+	connections := make([]*netadapter.NetConnection, 0, len(connSet))
+	for _, connection := range connSet {
+		connections = append(connections, connection)
+	}
+	if c.misbehaviorScoreFunc == nil {
+		return connections
+	}
 
-		numConnectionsOverMax--
-		if numConnectionsOverMax == 0 {
-			break
-		}
+	scores := make(map[*netadapter.NetConnection]uint32, len(connections))
+	for _, connection := range connections {
+		scores[connection] = c.misbehaviorScoreFunc(connection)
 	}
+	sort.SliceStable(connections, func(i, j int) bool {
+		return scores[connections[i]] > scores[connections[j]]
+	})
+	return connections
 }
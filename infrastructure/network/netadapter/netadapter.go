@@ -10,6 +10,7 @@ import (
 	routerpkg "github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/jsonrpcserver"
 	"github.com/pkg/errors"
 )
 
@@ -28,6 +29,7 @@ type NetAdapter struct {
 	p2pServer            server.P2PServer
 	p2pRouterInitializer RouterInitializer
 	rpcServer            server.Server
+	jsonRPCServer        server.Server
 	rpcRouterInitializer RouterInitializer
 	stop                 uint32
 
@@ -42,7 +44,11 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	if err != nil {
 		return nil, err
 	}
-	p2pServer, err := grpcserver.NewP2PServer(cfg.Listeners)
+	var proxyDial grpcserver.DialFunc
+	if cfg.Proxy != "" {
+		proxyDial = cfg.Dial
+	}
+	p2pServer, err := grpcserver.NewP2PServer(cfg.Listeners, proxyDial)
 	if err != nil {
 		return nil, err
 	}
@@ -50,17 +56,34 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The JSON-RPC server is optional, and is only created if it has anything to listen on
+	var jsonRPCServer server.Server
+	if len(cfg.JSONRPCListeners) > 0 {
+		var isAuthenticated func(authorization string) bool
+		if cfg.RPCAuthorization != nil {
+			isAuthenticated = cfg.RPCAuthorization.IsAuthenticated
+		}
+		jsonRPCServer, err = jsonrpcserver.NewJSONRPCServer(cfg.JSONRPCListeners, cfg.RPCMaxWebsockets,
+			cfg.RPCMaxConcurrentReqs, cfg.JSONRPCAllowedOrigins, isAuthenticated)
+		if err != nil {
+			return nil, err
+		}
+	}
 	adapter := NetAdapter{
-		cfg:       cfg,
-		id:        netAdapterID,
-		p2pServer: p2pServer,
-		rpcServer: rpcServer,
+		cfg:           cfg,
+		id:            netAdapterID,
+		p2pServer:     p2pServer,
+		rpcServer:     rpcServer,
+		jsonRPCServer: jsonRPCServer,
 
 		p2pConnections: make(map[*NetConnection]struct{}),
 	}
 
 	adapter.p2pServer.SetOnConnectedHandler(adapter.onP2PConnectedHandler)
 	adapter.rpcServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
+	if adapter.jsonRPCServer != nil {
+		adapter.jsonRPCServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
+	}
 
 	return &adapter, nil
 }
@@ -82,6 +105,12 @@ func (na *NetAdapter) Start() error {
 	if err != nil {
 		return err
 	}
+	if na.jsonRPCServer != nil {
+		err = na.jsonRPCServer.Start()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -95,6 +124,12 @@ func (na *NetAdapter) Stop() error {
 	if err != nil {
 		return err
 	}
+	if na.jsonRPCServer != nil {
+		err = na.jsonRPCServer.Stop()
+		if err != nil {
+			return err
+		}
+	}
 	return na.rpcServer.Stop()
 }
 
@@ -3,8 +3,10 @@ package netadapter
 import (
 	"fmt"
 	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
 	routerpkg "github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
+	"net"
 	"sync/atomic"
 
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/id"
@@ -29,7 +31,8 @@ func newNetConnection(connection server.Connection, routerInitializer RouterInit
 	}
 
 	netConnection.connection.SetOnDisconnectedHandler(func() {
-		log.Infof("Disconnected from %s", netConnection)
+		log.WithFields(logger.Fields{logger.FieldPeer: netConnection.Address()}).
+			Infof("Disconnected from %s", netConnection)
 		// If the disconnection came because of a network error and not because of the application layer, we
 		// need to close the router as well.
 		if atomic.AddUint32(&netConnection.isRouterClosed, 1) == 1 {
@@ -70,6 +73,12 @@ func (c *NetConnection) Address() string {
 	return c.connection.Address().String()
 }
 
+// Authorization returns the authorization the remote side presented when
+// connecting, in the format of an HTTP Authorization header value
+func (c *NetConnection) Authorization() string {
+	return c.connection.Authorization()
+}
+
 // IsOutbound returns whether the connection is outbound
 func (c *NetConnection) IsOutbound() bool {
 	return c.connection.IsOutbound()
@@ -77,7 +86,12 @@ func (c *NetConnection) IsOutbound() bool {
 
 // NetAddress returns the NetAddress associated with this connection
 func (c *NetConnection) NetAddress() *appmessage.NetAddress {
-	return appmessage.NewNetAddress(c.connection.Address())
+	// Outbound connections to onion services are addressed by their onion
+	// address, and all other connections by their TCP address
+	if netAddress, ok := c.connection.Address().(*appmessage.NetAddress); ok {
+		return netAddress
+	}
+	return appmessage.NewNetAddress(c.connection.Address().(*net.TCPAddr))
 }
 
 func (c *NetConnection) setOnDisconnectedHandler(onDisconnectedHandler server.OnDisconnectedHandler) {
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// AuthorizationKey is the gRPC metadata key, and the HTTP header, over which
+// RPC clients present their credentials
+const AuthorizationKey = "authorization"
+
+const (
+	basicAuthorizationScheme  = "Basic"
+	bearerAuthorizationScheme = "Bearer"
+)
+
+// Credentials are the credentials an RPC client presents to the RPC server.
+// A client presents either a token, or a username and password
+type Credentials struct {
+	Username string
+	Password string
+	Token    string
+}
+
+// Authorization returns the credentials in the format of an HTTP Authorization
+// header value, or an empty string if the credentials are empty
+func (c *Credentials) Authorization() string {
+	if c.Token != "" {
+		return bearerAuthorizationScheme + " " + c.Token
+	}
+	if c.Username != "" {
+		return basicAuthorizationScheme + " " + base64.StdEncoding.EncodeToString([]byte(c.Username+":"+c.Password))
+	}
+	return ""
+}
+
+// ParseAuthorization parses the given HTTP Authorization header value into
+// Credentials. An empty authorization is parsed into nil credentials
+func ParseAuthorization(authorization string) (*Credentials, error) {
+	if authorization == "" {
+		return nil, nil
+	}
+
+	parts := strings.SplitN(authorization, " ", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, errors.Errorf("malformed authorization")
+	}
+	scheme, value := parts[0], parts[1]
+
+	switch {
+	case strings.EqualFold(scheme, bearerAuthorizationScheme):
+		return &Credentials{Token: value}, nil
+	case strings.EqualFold(scheme, basicAuthorizationScheme):
+		decodedValue, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "malformed basic authorization")
+		}
+		usernameAndPassword := strings.SplitN(string(decodedValue), ":", 2)
+		if len(usernameAndPassword) != 2 || usernameAndPassword[0] == "" {
+			return nil, errors.Errorf("malformed basic authorization")
+		}
+		return &Credentials{Username: usernameAndPassword[0], Password: usernameAndPassword[1]}, nil
+	default:
+		return nil, errors.Errorf("unsupported authorization scheme '%s'", scheme)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kaspanet/kaspad/util/panics"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"net"
 	"sync"
@@ -110,6 +111,12 @@ func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStr
 	}
 
 	connection := newConnection(s, tcpAddress, stream, nil)
+	if incomingMetadata, ok := metadata.FromIncomingContext(ctx); ok {
+		authorization := incomingMetadata.Get(server.AuthorizationKey)
+		if len(authorization) > 0 {
+			connection.authorization = authorization[0]
+		}
+	}
 
 	err = s.onConnectedHandler(connection)
 	if err != nil {
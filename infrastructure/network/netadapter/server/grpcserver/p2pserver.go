@@ -2,6 +2,7 @@ package grpcserver
 
 import (
 	"context"
+	"github.com/kaspanet/kaspad/app/appmessage"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
 	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/kaspanet/kaspad/util/panics"
@@ -16,8 +17,13 @@ import (
 type p2pServer struct {
 	protowire.UnimplementedP2PServer
 	gRPCServer
+	proxyDial DialFunc
 }
 
+// DialFunc is a function that dials the given address, such as a function
+// that dials through a SOCKS5 proxy
+type DialFunc func(network string, address string, timeout time.Duration) (net.Conn, error)
+
 const p2pMaxMessageSize = 1024 * 1024 * 1024 // 1GB
 
 // p2pMaxInboundConnections is the max amount of inbound connections for the P2P server.
@@ -26,10 +32,11 @@ const p2pMaxMessageSize = 1024 * 1024 * 1024 // 1GB
 // is handled in the ConnectionManager instead.
 const p2pMaxInboundConnections = 0
 
-// NewP2PServer creates a new P2PServer
-func NewP2PServer(listeningAddresses []string) (server.P2PServer, error) {
+// NewP2PServer creates a new P2PServer. If proxyDial is not nil, all outbound
+// connections are made through it, which is also required to connect to onion addresses
+func NewP2PServer(listeningAddresses []string, proxyDial DialFunc) (server.P2PServer, error) {
 	gRPCServer := newGRPCServer(listeningAddresses, p2pMaxMessageSize, p2pMaxInboundConnections, "P2P")
-	p2pServer := &p2pServer{gRPCServer: *gRPCServer}
+	p2pServer := &p2pServer{gRPCServer: *gRPCServer, proxyDial: proxyDial}
 	protowire.RegisterP2PServer(gRPCServer.server, p2pServer)
 	return p2pServer, nil
 }
@@ -46,10 +53,22 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 	log.Debugf("%s Dialing to %s", p.name, address)
 
 	const dialTimeout = 1 * time.Second
-	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	// Connecting through a proxy, and especially through Tor, takes much longer
+	const proxyDialTimeout = 15 * time.Second
+
+	dialOptions := []grpc.DialOption{grpc.WithInsecure(), grpc.WithBlock()}
+	timeout := dialTimeout
+	if p.proxyDial != nil {
+		dialOptions = append(dialOptions, grpc.WithContextDialer(p.dialThroughProxy))
+		timeout = proxyDialTimeout
+	} else if isOnionAddress(address) {
+		return nil, errors.Errorf("%s cannot connect to the onion address %s without a proxy", p.name, address)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	gRPCClientConnection, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	gRPCClientConnection, err := grpc.DialContext(ctx, address, dialOptions...)
 	if err != nil {
 		return nil, errors.Wrapf(err, "%s error connecting to %s", p.name, address)
 	}
@@ -65,12 +84,13 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 	if !ok {
 		return nil, errors.Errorf("%s error getting stream peer info from context for %s", p.name, address)
 	}
-	tcpAddress, ok := peerInfo.Addr.(*net.TCPAddr)
-	if !ok {
-		return nil, errors.Errorf("non-tcp addresses are not supported")
+	remoteAddress, err := connectionAddress(peerInfo.Addr, address)
+	if err != nil {
+		gRPCClientConnection.Close()
+		return nil, err
 	}
 
-	connection := newConnection(&p.gRPCServer, tcpAddress, stream, gRPCClientConnection)
+	connection := newConnection(&p.gRPCServer, remoteAddress, stream, gRPCClientConnection)
 
 	err = p.onConnectedHandler(connection)
 	if err != nil {
@@ -81,3 +101,34 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 
 	return connection, nil
 }
+
+func (p *p2pServer) dialThroughProxy(ctx context.Context, address string) (net.Conn, error) {
+	timeout := time.Duration(0)
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	return p.proxyDial("tcp", address, timeout)
+}
+
+func isOnionAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	return err == nil && appmessage.IsOnionHost(host)
+}
+
+// connectionAddress returns the address of an outbound connection to dialedAddress.
+// Connections through a proxy don't expose the TCP address of the peer, so they are
+// addressed by the address that was dialed, which must be either an IP or an onion address.
+func connectionAddress(remoteAddress net.Addr, dialedAddress string) (net.Addr, error) {
+	if tcpAddress, ok := remoteAddress.(*net.TCPAddr); ok {
+		return tcpAddress, nil
+	}
+
+	netAddress, err := appmessage.NewNetAddressFromString(dialedAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, "only IP and onion addresses are supported through a proxy")
+	}
+	if netAddress.IsOnion() {
+		return netAddress, nil
+	}
+	return netAddress.TCPAddress(), nil
+}
@@ -17,12 +17,20 @@ func (x *BanRequestMessage) toAppMessage() (appmessage.Message, error) {
 		return nil, errors.Wrapf(errorNil, "BanRequestMessage is nil")
 	}
 	return &appmessage.BanRequestMessage{
-		IP: x.Ip,
+		IP:              x.Ip,
+		Reason:          x.Reason,
+		DurationSeconds: x.DurationSeconds,
+		IsPermanent:     x.IsPermanent,
 	}, nil
 }
 
 func (x *KaspadMessage_BanRequest) fromAppMessage(message *appmessage.BanRequestMessage) error {
-	x.BanRequest = &BanRequestMessage{Ip: message.IP}
+	x.BanRequest = &BanRequestMessage{
+		Ip:              message.IP,
+		Reason:          message.Reason,
+		DurationSeconds: message.DurationSeconds,
+		IsPermanent:     message.IsPermanent,
+	}
 	return nil
 }
 
@@ -0,0 +1,69 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_CreateSnapshotRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_CreateSnapshotRequest is nil")
+	}
+	return x.CreateSnapshotRequest.toAppMessage()
+}
+
+func (x *KaspadMessage_CreateSnapshotRequest) fromAppMessage(message *appmessage.CreateSnapshotRequestMessage) error {
+	x.CreateSnapshotRequest = &CreateSnapshotRequestMessage{
+		Path: message.Path,
+	}
+	return nil
+}
+
+func (x *CreateSnapshotRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CreateSnapshotRequestMessage is nil")
+	}
+	return &appmessage.CreateSnapshotRequestMessage{
+		Path: x.Path,
+	}, nil
+}
+
+func (x *KaspadMessage_CreateSnapshotResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_CreateSnapshotResponse is nil")
+	}
+	return x.CreateSnapshotResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_CreateSnapshotResponse) fromAppMessage(message *appmessage.CreateSnapshotResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.CreateSnapshotResponse = &CreateSnapshotResponseMessage{
+		PruningPointHash: message.PruningPointHash,
+		VirtualDaaScore:  message.VirtualDAAScore,
+		EntryCount:       message.EntryCount,
+		Checksum:         message.Checksum,
+		Error:            err,
+	}
+	return nil
+}
+
+func (x *CreateSnapshotResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CreateSnapshotResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.CreateSnapshotResponseMessage{
+		PruningPointHash: x.PruningPointHash,
+		VirtualDAAScore:  x.VirtualDaaScore,
+		EntryCount:       x.EntryCount,
+		Checksum:         x.Checksum,
+		Error:            rpcErr,
+	}, nil
+}
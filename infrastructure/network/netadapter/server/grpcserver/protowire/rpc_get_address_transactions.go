@@ -0,0 +1,112 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetAddressTransactionsRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetAddressTransactionsRequest is nil")
+	}
+	return x.GetAddressTransactionsRequest.toAppMessage()
+}
+
+func (x *KaspadMessage_GetAddressTransactionsRequest) fromAppMessage(message *appmessage.GetAddressTransactionsRequestMessage) error {
+	x.GetAddressTransactionsRequest = &GetAddressTransactionsRequestMessage{
+		Addresses:     message.Addresses,
+		StartDaaScore: message.StartDAAScore,
+		Limit:         message.Limit,
+	}
+	return nil
+}
+
+func (x *GetAddressTransactionsRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAddressTransactionsRequestMessage is nil")
+	}
+	return &appmessage.GetAddressTransactionsRequestMessage{
+		Addresses:     x.Addresses,
+		StartDAAScore: x.StartDaaScore,
+		Limit:         x.Limit,
+	}, nil
+}
+
+func (x *KaspadMessage_GetAddressTransactionsResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetAddressTransactionsResponse is nil")
+	}
+	return x.GetAddressTransactionsResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetAddressTransactionsResponse) fromAppMessage(message *appmessage.GetAddressTransactionsResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	entries := make([]*RpcAddressTransaction, len(message.Entries))
+	for i, entry := range message.Entries {
+		entries[i] = &RpcAddressTransaction{}
+		entries[i].fromAppMessage(entry)
+	}
+	x.GetAddressTransactionsResponse = &GetAddressTransactionsResponseMessage{
+		Entries:      entries,
+		NextDaaScore: message.NextDAAScore,
+		Error:        rpcErr,
+	}
+	return nil
+}
+
+func (x *GetAddressTransactionsResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAddressTransactionsResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Entries) != 0 {
+		return nil, errors.New("GetAddressTransactionsResponseMessage contains both an error and a response")
+	}
+
+	entries := make([]*appmessage.AddressTransaction, len(x.Entries))
+	for i, entry := range x.Entries {
+		entries[i], err = entry.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetAddressTransactionsResponseMessage{
+		Entries:      entries,
+		NextDAAScore: x.NextDaaScore,
+		Error:        rpcErr,
+	}, nil
+}
+
+func (x *RpcAddressTransaction) toAppMessage() (*appmessage.AddressTransaction, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcAddressTransaction is nil")
+	}
+	return &appmessage.AddressTransaction{
+		Address:                x.Address,
+		TransactionID:          x.TransactionId,
+		AcceptingBlockHash:     x.AcceptingBlockHash,
+		AcceptingBlockDAAScore: x.AcceptingBlockDaaScore,
+		CreatedOutputs:         x.CreatedOutputs,
+		SpentOutputs:           x.SpentOutputs,
+	}, nil
+}
+
+func (x *RpcAddressTransaction) fromAppMessage(message *appmessage.AddressTransaction) {
+	*x = RpcAddressTransaction{
+		Address:                message.Address,
+		TransactionId:          message.TransactionID,
+		AcceptingBlockHash:     message.AcceptingBlockHash,
+		AcceptingBlockDaaScore: message.AcceptingBlockDAAScore,
+		CreatedOutputs:         message.CreatedOutputs,
+		SpentOutputs:           message.SpentOutputs,
+	}
+}
@@ -27,6 +27,13 @@ func (x *KaspadMessage_GetConnectedPeerInfoResponse) fromAppMessage(message *app
 	}
 	infos := make([]*GetConnectedPeerInfoMessage, len(message.Infos))
 	for i, info := range message.Infos {
+		misbehaviorCategoryScores := make([]*MisbehaviorCategoryScore, len(info.MisbehaviorCategoryScores))
+		for j, categoryScore := range info.MisbehaviorCategoryScores {
+			misbehaviorCategoryScores[j] = &MisbehaviorCategoryScore{
+				Category: categoryScore.Category,
+				Score:    categoryScore.Score,
+			}
+		}
 		infos[i] = &GetConnectedPeerInfoMessage{
 			Id:                        info.ID,
 			Address:                   info.Address,
@@ -37,6 +44,8 @@ func (x *KaspadMessage_GetConnectedPeerInfoResponse) fromAppMessage(message *app
 			AdvertisedProtocolVersion: info.AdvertisedProtocolVersion,
 			TimeConnected:             info.TimeOffset,
 			IsIbdPeer:                 info.IsIBDPeer,
+			MisbehaviorScore:          info.MisbehaviorScore,
+			MisbehaviorCategoryScores: misbehaviorCategoryScores,
 		}
 	}
 	x.GetConnectedPeerInfoResponse = &GetConnectedPeerInfoResponseMessage{
@@ -78,6 +87,16 @@ func (x *GetConnectedPeerInfoMessage) toAppMessage() (*appmessage.GetConnectedPe
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "GetConnectedPeerInfoMessage is nil")
 	}
+	misbehaviorCategoryScores := make([]*appmessage.MisbehaviorCategoryScore, len(x.MisbehaviorCategoryScores))
+	for i, categoryScore := range x.MisbehaviorCategoryScores {
+		if categoryScore == nil {
+			return nil, errors.Wrapf(errorNil, "MisbehaviorCategoryScore is nil")
+		}
+		misbehaviorCategoryScores[i] = &appmessage.MisbehaviorCategoryScore{
+			Category: categoryScore.Category,
+			Score:    categoryScore.Score,
+		}
+	}
 	return &appmessage.GetConnectedPeerInfoMessage{
 		ID:                        x.Id,
 		Address:                   x.Address,
@@ -88,5 +107,7 @@ func (x *GetConnectedPeerInfoMessage) toAppMessage() (*appmessage.GetConnectedPe
 		AdvertisedProtocolVersion: x.AdvertisedProtocolVersion,
 		TimeConnected:             x.TimeOffset,
 		IsIBDPeer:                 x.IsIbdPeer,
+		MisbehaviorScore:          x.MisbehaviorScore,
+		MisbehaviorCategoryScores: misbehaviorCategoryScores,
 	}, nil
 }
@@ -0,0 +1,138 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetFeeEstimateRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetFeeEstimateRequest is nil")
+	}
+	return &appmessage.GetFeeEstimateRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_GetFeeEstimateRequest) fromAppMessage(_ *appmessage.GetFeeEstimateRequestMessage) error {
+	x.GetFeeEstimateRequest = &GetFeeEstimateRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_GetFeeEstimateResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetFeeEstimateResponse is nil")
+	}
+	return x.GetFeeEstimateResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetFeeEstimateResponse) fromAppMessage(message *appmessage.GetFeeEstimateResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	estimate := &RpcFeeEstimate{}
+	estimate.fromAppMessage(&message.Estimate)
+	x.GetFeeEstimateResponse = &GetFeeEstimateResponseMessage{
+		Estimate: estimate,
+		Error:    rpcErr,
+	}
+	return nil
+}
+
+func (x *GetFeeEstimateResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetFeeEstimateResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && x.Estimate != nil {
+		return nil, errors.New("GetFeeEstimateResponseMessage contains both an error and a response")
+	}
+
+	var estimate appmessage.RPCFeeEstimate
+	if rpcErr == nil {
+		estimate, err = x.Estimate.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetFeeEstimateResponseMessage{
+		Estimate: estimate,
+		Error:    rpcErr,
+	}, nil
+}
+
+func (x *RpcFeeEstimate) toAppMessage() (appmessage.RPCFeeEstimate, error) {
+	if x == nil {
+		return appmessage.RPCFeeEstimate{}, errors.Wrapf(errorNil, "RpcFeeEstimate is nil")
+	}
+	priorityBucket, err := x.PriorityBucket.toAppMessage()
+	if err != nil {
+		return appmessage.RPCFeeEstimate{}, err
+	}
+	normalBuckets, err := feerateBucketsToAppMessage(x.NormalBuckets)
+	if err != nil {
+		return appmessage.RPCFeeEstimate{}, err
+	}
+	lowBuckets, err := feerateBucketsToAppMessage(x.LowBuckets)
+	if err != nil {
+		return appmessage.RPCFeeEstimate{}, err
+	}
+	return appmessage.RPCFeeEstimate{
+		PriorityBucket: priorityBucket,
+		NormalBuckets:  normalBuckets,
+		LowBuckets:     lowBuckets,
+	}, nil
+}
+
+func (x *RpcFeeEstimate) fromAppMessage(message *appmessage.RPCFeeEstimate) {
+	priorityBucket := &RpcFeerateBucket{}
+	priorityBucket.fromAppMessage(&message.PriorityBucket)
+	*x = RpcFeeEstimate{
+		PriorityBucket: priorityBucket,
+		NormalBuckets:  feerateBucketsFromAppMessage(message.NormalBuckets),
+		LowBuckets:     feerateBucketsFromAppMessage(message.LowBuckets),
+	}
+}
+
+func (x *RpcFeerateBucket) toAppMessage() (appmessage.RPCFeeRateBucket, error) {
+	if x == nil {
+		return appmessage.RPCFeeRateBucket{}, errors.Wrapf(errorNil, "RpcFeerateBucket is nil")
+	}
+	return appmessage.RPCFeeRateBucket{
+		FeeRate:          x.Feerate,
+		EstimatedSeconds: x.EstimatedSeconds,
+	}, nil
+}
+
+func (x *RpcFeerateBucket) fromAppMessage(message *appmessage.RPCFeeRateBucket) {
+	*x = RpcFeerateBucket{
+		Feerate:          message.FeeRate,
+		EstimatedSeconds: message.EstimatedSeconds,
+	}
+}
+
+func feerateBucketsToAppMessage(buckets []*RpcFeerateBucket) ([]appmessage.RPCFeeRateBucket, error) {
+	appMessageBuckets := make([]appmessage.RPCFeeRateBucket, len(buckets))
+	for i, bucket := range buckets {
+		var err error
+		appMessageBuckets[i], err = bucket.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return appMessageBuckets, nil
+}
+
+func feerateBucketsFromAppMessage(buckets []appmessage.RPCFeeRateBucket) []*RpcFeerateBucket {
+	protoBuckets := make([]*RpcFeerateBucket, len(buckets))
+	for i := range buckets {
+		protoBuckets[i] = &RpcFeerateBucket{}
+		protoBuckets[i].fromAppMessage(&buckets[i])
+	}
+	return protoBuckets
+}
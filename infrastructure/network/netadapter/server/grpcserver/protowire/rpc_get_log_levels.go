@@ -0,0 +1,74 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetLogLevelsRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetLogLevelsRequest is nil")
+	}
+	return &appmessage.GetLogLevelsRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_GetLogLevelsRequest) fromAppMessage(_ *appmessage.GetLogLevelsRequestMessage) error {
+	x.GetLogLevelsRequest = &GetLogLevelsRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_GetLogLevelsResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetLogLevelsResponse is nil")
+	}
+	return x.GetLogLevelsResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetLogLevelsResponse) fromAppMessage(message *appmessage.GetLogLevelsResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	subsystemLogLevels := make([]*RpcSubsystemLogLevel, len(message.SubsystemLogLevels))
+	for i, subsystemLogLevel := range message.SubsystemLogLevels {
+		subsystemLogLevels[i] = &RpcSubsystemLogLevel{
+			Subsystem: subsystemLogLevel.Subsystem,
+			LogLevel:  subsystemLogLevel.LogLevel,
+		}
+	}
+	x.GetLogLevelsResponse = &GetLogLevelsResponseMessage{
+		SubsystemLogLevels: subsystemLogLevels,
+		Error:              err,
+	}
+	return nil
+}
+
+func (x *GetLogLevelsResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetLogLevelsResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.SubsystemLogLevels) != 0 {
+		return nil, errors.New("GetLogLevelsResponseMessage contains both an error and a response")
+	}
+
+	subsystemLogLevels := make([]*appmessage.SubsystemLogLevel, len(x.SubsystemLogLevels))
+	for i, subsystemLogLevel := range x.SubsystemLogLevels {
+		if subsystemLogLevel == nil {
+			return nil, errors.Wrapf(errorNil, "RpcSubsystemLogLevel is nil")
+		}
+		subsystemLogLevels[i] = &appmessage.SubsystemLogLevel{
+			Subsystem: subsystemLogLevel.Subsystem,
+			LogLevel:  subsystemLogLevel.LogLevel,
+		}
+	}
+	return &appmessage.GetLogLevelsResponseMessage{
+		SubsystemLogLevels: subsystemLogLevels,
+		Error:              rpcErr,
+	}, nil
+}
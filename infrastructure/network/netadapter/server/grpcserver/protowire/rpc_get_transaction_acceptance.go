@@ -0,0 +1,106 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_GetTransactionAcceptanceRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetTransactionAcceptanceRequest is nil")
+	}
+	return x.GetTransactionAcceptanceRequest.toAppMessage()
+}
+
+func (x *KaspadMessage_GetTransactionAcceptanceRequest) fromAppMessage(message *appmessage.GetTransactionAcceptanceRequestMessage) error {
+	x.GetTransactionAcceptanceRequest = &GetTransactionAcceptanceRequestMessage{
+		TransactionIds: message.TransactionIDs,
+	}
+	return nil
+}
+
+func (x *GetTransactionAcceptanceRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionAcceptanceRequestMessage is nil")
+	}
+	return &appmessage.GetTransactionAcceptanceRequestMessage{
+		TransactionIDs: x.TransactionIds,
+	}, nil
+}
+
+func (x *KaspadMessage_GetTransactionAcceptanceResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_GetTransactionAcceptanceResponse is nil")
+	}
+	return x.GetTransactionAcceptanceResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_GetTransactionAcceptanceResponse) fromAppMessage(message *appmessage.GetTransactionAcceptanceResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = &RPCError{Message: message.Error.Message}
+	}
+	transactionAcceptances := make([]*RpcTransactionAcceptance, len(message.TransactionAcceptances))
+	for i, transactionAcceptance := range message.TransactionAcceptances {
+		transactionAcceptances[i] = &RpcTransactionAcceptance{}
+		transactionAcceptances[i].fromAppMessage(transactionAcceptance)
+	}
+	x.GetTransactionAcceptanceResponse = &GetTransactionAcceptanceResponseMessage{
+		TransactionAcceptances: transactionAcceptances,
+		Error:                  rpcErr,
+	}
+	return nil
+}
+
+func (x *GetTransactionAcceptanceResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionAcceptanceResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.TransactionAcceptances) != 0 {
+		return nil, errors.New("GetTransactionAcceptanceResponseMessage contains both an error and a response")
+	}
+
+	transactionAcceptances := make([]*appmessage.TransactionAcceptance, len(x.TransactionAcceptances))
+	for i, transactionAcceptance := range x.TransactionAcceptances {
+		transactionAcceptances[i], err = transactionAcceptance.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetTransactionAcceptanceResponseMessage{
+		TransactionAcceptances: transactionAcceptances,
+		Error:                  rpcErr,
+	}, nil
+}
+
+func (x *RpcTransactionAcceptance) toAppMessage() (*appmessage.TransactionAcceptance, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcTransactionAcceptance is nil")
+	}
+	return &appmessage.TransactionAcceptance{
+		TransactionID:           x.TransactionId,
+		IsAccepted:              x.IsAccepted,
+		IncludingBlockHash:      x.IncludingBlockHash,
+		AcceptingBlockHash:      x.AcceptingBlockHash,
+		AcceptingBlockBlueScore: x.AcceptingBlockBlueScore,
+		Confirmations:           x.Confirmations,
+	}, nil
+}
+
+func (x *RpcTransactionAcceptance) fromAppMessage(message *appmessage.TransactionAcceptance) {
+	*x = RpcTransactionAcceptance{
+		TransactionId:           message.TransactionID,
+		IsAccepted:              message.IsAccepted,
+		IncludingBlockHash:      message.IncludingBlockHash,
+		AcceptingBlockHash:      message.AcceptingBlockHash,
+		AcceptingBlockBlueScore: message.AcceptingBlockBlueScore,
+		Confirmations:           message.Confirmations,
+	}
+}
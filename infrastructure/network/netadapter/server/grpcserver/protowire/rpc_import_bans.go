@@ -0,0 +1,69 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_ImportBansRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_ImportBansRequest is nil")
+	}
+	return x.ImportBansRequest.toAppMessage()
+}
+
+func (x *ImportBansRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ImportBansRequestMessage is nil")
+	}
+	bans, err := rpcBansToAppMessage(x.Bans)
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.ImportBansRequestMessage{
+		Bans: bans,
+	}, nil
+}
+
+func (x *KaspadMessage_ImportBansRequest) fromAppMessage(message *appmessage.ImportBansRequestMessage) error {
+	x.ImportBansRequest = &ImportBansRequestMessage{
+		Bans: rpcBansFromAppMessage(message.Bans),
+	}
+	return nil
+}
+
+func (x *KaspadMessage_ImportBansResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_ImportBansResponse is nil")
+	}
+	return x.ImportBansResponse.toAppMessage()
+}
+
+func (x *ImportBansResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ImportBansResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.ImportBansResponseMessage{
+		ImportedCount:  x.ImportedCount,
+		SkippedSubnets: x.SkippedSubnets,
+		Error:          rpcErr,
+	}, nil
+}
+
+func (x *KaspadMessage_ImportBansResponse) fromAppMessage(message *appmessage.ImportBansResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.ImportBansResponse = &ImportBansResponseMessage{
+		ImportedCount:  message.ImportedCount,
+		SkippedSubnets: message.SkippedSubnets,
+		Error:          err,
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_ListBannedRequest) toAppMessage() (appmessage.Message, error) {
+	return &appmessage.ListBannedRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_ListBannedRequest) fromAppMessage(_ *appmessage.ListBannedRequestMessage) error {
+	x.ListBannedRequest = &ListBannedRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_ListBannedResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_ListBannedResponse is nil")
+	}
+	return x.ListBannedResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_ListBannedResponse) fromAppMessage(message *appmessage.ListBannedResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.ListBannedResponse = &ListBannedResponseMessage{
+		Bans:  rpcBansFromAppMessage(message.Bans),
+		Error: err,
+	}
+	return nil
+}
+
+func (x *ListBannedResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ListBannedResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	if rpcErr != nil && len(x.Bans) != 0 {
+		return nil, errors.New("ListBannedResponseMessage contains both an error and a response")
+	}
+	bans, err := rpcBansToAppMessage(x.Bans)
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.ListBannedResponseMessage{
+		Bans:  bans,
+		Error: rpcErr,
+	}, nil
+}
+
+func rpcBansFromAppMessage(bans []*appmessage.RPCBan) []*RpcBan {
+	rpcBans := make([]*RpcBan, len(bans))
+	for i, ban := range bans {
+		rpcBans[i] = &RpcBan{
+			Subnet:    ban.Subnet,
+			Reason:    ban.Reason,
+			CreatedAt: ban.CreatedAt,
+			ExpiresAt: ban.ExpiresAt,
+		}
+	}
+	return rpcBans
+}
+
+func rpcBansToAppMessage(rpcBans []*RpcBan) ([]*appmessage.RPCBan, error) {
+	bans := make([]*appmessage.RPCBan, len(rpcBans))
+	for i, rpcBan := range rpcBans {
+		ban, err := rpcBan.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		bans[i] = ban
+	}
+	return bans, nil
+}
+
+func (x *RpcBan) toAppMessage() (*appmessage.RPCBan, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcBan is nil")
+	}
+	return &appmessage.RPCBan{
+		Subnet:    x.Subnet,
+		Reason:    x.Reason,
+		CreatedAt: x.CreatedAt,
+		ExpiresAt: x.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_NotifyVirtualChainChangedV2Request) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_NotifyVirtualChainChangedV2Request is nil")
+	}
+	return x.NotifyVirtualChainChangedV2Request.toAppMessage()
+}
+
+func (x *KaspadMessage_NotifyVirtualChainChangedV2Request) fromAppMessage(message *appmessage.NotifyVirtualChainChangedV2RequestMessage) error {
+	x.NotifyVirtualChainChangedV2Request = &NotifyVirtualChainChangedV2RequestMessage{
+		StartHash: message.StartHash,
+	}
+	return nil
+}
+
+func (x *NotifyVirtualChainChangedV2RequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifyVirtualChainChangedV2RequestMessage is nil")
+	}
+	return &appmessage.NotifyVirtualChainChangedV2RequestMessage{
+		StartHash: x.StartHash,
+	}, nil
+}
+
+func (x *KaspadMessage_NotifyVirtualChainChangedV2Response) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_NotifyVirtualChainChangedV2Response is nil")
+	}
+	return x.NotifyVirtualChainChangedV2Response.toAppMessage()
+}
+
+func (x *KaspadMessage_NotifyVirtualChainChangedV2Response) fromAppMessage(message *appmessage.NotifyVirtualChainChangedV2ResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.NotifyVirtualChainChangedV2Response = &NotifyVirtualChainChangedV2ResponseMessage{
+		StartHash: message.StartHash,
+		Error:     err,
+	}
+	return nil
+}
+
+func (x *NotifyVirtualChainChangedV2ResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifyVirtualChainChangedV2ResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.NotifyVirtualChainChangedV2ResponseMessage{
+		StartHash: x.StartHash,
+		Error:     rpcErr,
+	}, nil
+}
+
+func (x *KaspadMessage_VirtualChainChangedV2Notification) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_VirtualChainChangedV2Notification is nil")
+	}
+	return x.VirtualChainChangedV2Notification.toAppMessage()
+}
+
+func (x *KaspadMessage_VirtualChainChangedV2Notification) fromAppMessage(message *appmessage.VirtualChainChangedV2NotificationMessage) error {
+	removedChainBlocks := make([]*RpcRemovedChainBlock, len(message.RemovedChainBlocks))
+	for i, removedChainBlock := range message.RemovedChainBlocks {
+		removedChainBlocks[i] = &RpcRemovedChainBlock{
+			Hash:                     removedChainBlock.Hash,
+			UnacceptedTransactionIds: removedChainBlock.UnacceptedTransactionIDs,
+		}
+	}
+
+	addedChainBlocks := make([]*RpcAddedChainBlock, len(message.AddedChainBlocks))
+	for i, addedChainBlock := range message.AddedChainBlocks {
+		addedChainBlocks[i] = &RpcAddedChainBlock{}
+		addedChainBlocks[i].fromAppMessage(addedChainBlock)
+	}
+
+	x.VirtualChainChangedV2Notification = &VirtualChainChangedV2NotificationMessage{
+		RemovedChainBlocks: removedChainBlocks,
+		AddedChainBlocks:   addedChainBlocks,
+	}
+	return nil
+}
+
+func (x *VirtualChainChangedV2NotificationMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "VirtualChainChangedV2NotificationMessage is nil")
+	}
+	removedChainBlocks := make([]*appmessage.RPCRemovedChainBlock, len(x.RemovedChainBlocks))
+	for i, removedChainBlock := range x.RemovedChainBlocks {
+		if removedChainBlock == nil {
+			return nil, errors.Wrapf(errorNil, "RpcRemovedChainBlock is nil")
+		}
+		removedChainBlocks[i] = &appmessage.RPCRemovedChainBlock{
+			Hash:                     removedChainBlock.Hash,
+			UnacceptedTransactionIDs: removedChainBlock.UnacceptedTransactionIds,
+		}
+	}
+
+	addedChainBlocks := make([]*appmessage.RPCAddedChainBlock, len(x.AddedChainBlocks))
+	for i, addedChainBlock := range x.AddedChainBlocks {
+		appAddedChainBlock, err := addedChainBlock.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		addedChainBlocks[i] = appAddedChainBlock
+	}
+
+	return &appmessage.VirtualChainChangedV2NotificationMessage{
+		RemovedChainBlocks: removedChainBlocks,
+		AddedChainBlocks:   addedChainBlocks,
+	}, nil
+}
+
+func (x *RpcAddedChainBlock) toAppMessage() (*appmessage.RPCAddedChainBlock, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcAddedChainBlock is nil")
+	}
+	acceptedTransactions := make([]*appmessage.RPCChainAcceptedTransaction, len(x.AcceptedTransactions))
+	for i, acceptedTransaction := range x.AcceptedTransactions {
+		if acceptedTransaction == nil {
+			return nil, errors.Wrapf(errorNil, "RpcChainAcceptedTransaction is nil")
+		}
+		inputPreviousOutpoints := make([]*appmessage.RPCOutpoint, len(acceptedTransaction.InputPreviousOutpoints))
+		for j, outpoint := range acceptedTransaction.InputPreviousOutpoints {
+			appOutpoint, err := outpoint.toAppMessage()
+			if err != nil {
+				return nil, err
+			}
+			inputPreviousOutpoints[j] = appOutpoint
+		}
+		acceptedTransactions[i] = &appmessage.RPCChainAcceptedTransaction{
+			TransactionID:          acceptedTransaction.TransactionId,
+			InputPreviousOutpoints: inputPreviousOutpoints,
+			Fee:                    acceptedTransaction.Fee,
+		}
+	}
+	return &appmessage.RPCAddedChainBlock{
+		Hash:                 x.Hash,
+		AcceptedTransactions: acceptedTransactions,
+	}, nil
+}
+
+func (x *RpcAddedChainBlock) fromAppMessage(message *appmessage.RPCAddedChainBlock) {
+	acceptedTransactions := make([]*RpcChainAcceptedTransaction, len(message.AcceptedTransactions))
+	for i, acceptedTransaction := range message.AcceptedTransactions {
+		inputPreviousOutpoints := make([]*RpcOutpoint, len(acceptedTransaction.InputPreviousOutpoints))
+		for j, outpoint := range acceptedTransaction.InputPreviousOutpoints {
+			inputPreviousOutpoints[j] = &RpcOutpoint{}
+			inputPreviousOutpoints[j].fromAppMessage(outpoint)
+		}
+		acceptedTransactions[i] = &RpcChainAcceptedTransaction{
+			TransactionId:          acceptedTransaction.TransactionID,
+			InputPreviousOutpoints: inputPreviousOutpoints,
+			Fee:                    acceptedTransaction.Fee,
+		}
+	}
+	*x = RpcAddedChainBlock{
+		Hash:                 message.Hash,
+		AcceptedTransactions: acceptedTransactions,
+	}
+}
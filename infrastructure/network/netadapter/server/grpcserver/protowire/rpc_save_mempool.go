@@ -0,0 +1,52 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_SaveMempoolRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_SaveMempoolRequest is nil")
+	}
+	return &appmessage.SaveMempoolRequestMessage{}, nil
+}
+
+func (x *KaspadMessage_SaveMempoolRequest) fromAppMessage(_ *appmessage.SaveMempoolRequestMessage) error {
+	x.SaveMempoolRequest = &SaveMempoolRequestMessage{}
+	return nil
+}
+
+func (x *KaspadMessage_SaveMempoolResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_SaveMempoolResponse is nil")
+	}
+	return x.SaveMempoolResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_SaveMempoolResponse) fromAppMessage(message *appmessage.SaveMempoolResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.SaveMempoolResponse = &SaveMempoolResponseMessage{
+		TransactionCount: message.TransactionCount,
+		Error:            err,
+	}
+	return nil
+}
+
+func (x *SaveMempoolResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SaveMempoolResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.SaveMempoolResponseMessage{
+		TransactionCount: x.TransactionCount,
+		Error:            rpcErr,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package protowire
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *KaspadMessage_SetLogLevelRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_SetLogLevelRequest is nil")
+	}
+	return x.SetLogLevelRequest.toAppMessage()
+}
+
+func (x *KaspadMessage_SetLogLevelRequest) fromAppMessage(message *appmessage.SetLogLevelRequestMessage) error {
+	x.SetLogLevelRequest = &SetLogLevelRequestMessage{
+		LogLevel: message.LogLevel,
+	}
+	return nil
+}
+
+func (x *SetLogLevelRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SetLogLevelRequestMessage is nil")
+	}
+	return &appmessage.SetLogLevelRequestMessage{
+		LogLevel: x.LogLevel,
+	}, nil
+}
+
+func (x *KaspadMessage_SetLogLevelResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "KaspadMessage_SetLogLevelResponse is nil")
+	}
+	return x.SetLogLevelResponse.toAppMessage()
+}
+
+func (x *KaspadMessage_SetLogLevelResponse) fromAppMessage(message *appmessage.SetLogLevelResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = &RPCError{Message: message.Error.Message}
+	}
+	x.SetLogLevelResponse = &SetLogLevelResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *SetLogLevelResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SetLogLevelResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.SetLogLevelResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
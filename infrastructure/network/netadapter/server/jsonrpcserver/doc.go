@@ -0,0 +1,28 @@
+/*
+Package jsonrpcserver implements a JSON-RPC 2.0 server for kaspad's RPC.
+
+The server exposes the same RPC methods as the gRPC server, and dispatches
+them to the same handlers, so the two transports behave identically.
+
+Methods are named after the payload fields of protowire.KaspadMessage,
+without their "Request" suffix. For example, the method "getBlockDagInfo"
+maps to GetBlockDagInfoRequestMessage. Params are given as a JSON object
+whose fields are those of the respective request message in their proto3
+JSON representation, and so is the result. Note that in that representation
+64-bit integers are encoded as strings. An RPCError returned by a handler is
+converted to a JSON-RPC error with code -32000.
+
+Requests may be sent either over HTTP POST or over a WebSocket. Every HTTP
+request is handled over a short-lived connection of its own, so the Notify*
+methods are only available over WebSocket, where notifications are sent as
+JSON-RPC notifications named after their payload field, e.g.
+"blockAddedNotification". Batch requests are supported over both transports.
+
+When RPC authorization is enabled, clients present their credentials in the
+Authorization header of the HTTP request, or of the request that opens the
+WebSocket, using either the Basic or the Bearer scheme. Clients with invalid
+credentials are rejected with HTTP status 401 before their requests are read.
+
+HTTP request bodies and WebSocket frames are limited to MaxMessageSize.
+*/
+package jsonrpcserver
@@ -0,0 +1,66 @@
+package jsonrpcserver
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const jsonRPCVersion = "2.0"
+
+// Error codes as defined by the JSON-RPC 2.0 specification
+const (
+	parseErrorCode     = -32700
+	invalidRequestCode = -32600
+	methodNotFoundCode = -32601
+	invalidParamsCode  = -32602
+	internalErrorCode  = -32603
+
+	// rpcErrorCode is used when an RPC handler responds with an RPCError
+	rpcErrorCode = -32000
+)
+
+type jsonRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// isNotification returns whether the request is a JSON-RPC notification,
+// which is a request that the client doesn't expect a response to
+func (request *jsonRPCRequest) isNotification() bool {
+	return request.ID == nil
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type jsonRPCNotification struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func newJSONRPCError(code int, format string, args ...interface{}) *jsonRPCError {
+	return &jsonRPCError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func newJSONRPCErrorResponse(id json.RawMessage, jsonRPCErr *jsonRPCError) *jsonRPCResponse {
+	return &jsonRPCResponse{
+		JSONRPC: jsonRPCVersion,
+		Error:   jsonRPCErr,
+		ID:      id,
+	}
+}
@@ -0,0 +1,365 @@
+package jsonrpcserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/logger"
+	routerpkg "github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+)
+
+var errDisconnected = errors.New("connection disconnected")
+
+// jsonRPCConnection is a connection over which JSON-RPC requests are received.
+// It's either a WebSocket connection, or a short-lived connection serving a
+// single HTTP request, in which case webSocket is nil.
+type jsonRPCConnection struct {
+	address       *net.TCPAddr
+	authorization string
+	webSocket     *websocket.Conn
+	router        *routerpkg.Router
+
+	// requestLock makes requests run one at a time. The RPC handlers respond
+	// to requests in the order they were received, so this is what allows
+	// matching every response to its request.
+	requestLock   sync.Mutex
+	responseChan  chan appmessage.Message
+	messageNumber uint64
+
+	// writeLock protects concurrent writes to webSocket, since
+	// notifications are written independently of responses
+	writeLock sync.Mutex
+
+	stopChan              chan struct{}
+	onDisconnectedHandler server.OnDisconnectedHandler
+
+	isConnected uint32
+}
+
+func newConnection(address *net.TCPAddr, authorization string, webSocket *websocket.Conn) *jsonRPCConnection {
+	return &jsonRPCConnection{
+		address:       address,
+		authorization: authorization,
+		webSocket:     webSocket,
+		responseChan:  make(chan appmessage.Message),
+		stopChan:      make(chan struct{}),
+		isConnected:   1,
+	}
+}
+
+// Start starts the connection's send loop
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) Start(router *routerpkg.Router) {
+	if c.onDisconnectedHandler == nil {
+		panic(errors.New("onDisconnectedHandler is nil"))
+	}
+	c.router = router
+	spawn("jsonRPCConnection.Start-sendLoop", func() {
+		err := c.sendLoop()
+		if err != nil {
+			log.Errorf("error from sendLoop for %s: %s", c, err)
+		}
+		c.Disconnect()
+	})
+}
+
+func (c *jsonRPCConnection) String() string {
+	return c.Address().String()
+}
+
+// IsConnected returns whether the connection is connected
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) IsConnected() bool {
+	return atomic.LoadUint32(&c.isConnected) != 0
+}
+
+// IsOutbound returns false, since JSON-RPC connections are always inbound
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) IsOutbound() bool {
+	return false
+}
+
+// Address returns the remote address of the connection
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) Address() net.Addr {
+	return c.address
+}
+
+// Authorization returns the value of the Authorization header of the HTTP
+// request that opened the connection
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) Authorization() string {
+	return c.authorization
+}
+
+// SetOnDisconnectedHandler sets the handler to be called once the connection disconnects
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) SetOnDisconnectedHandler(onDisconnectedHandler server.OnDisconnectedHandler) {
+	c.onDisconnectedHandler = onDisconnectedHandler
+}
+
+// SetOnInvalidMessageHandler does nothing, since invalid JSON-RPC
+// requests are responded to with a JSON-RPC error instead
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) SetOnInvalidMessageHandler(_ server.OnInvalidMessageHandler) {
+}
+
+// Disconnect disconnects the connection
+// Calling this function a second time doesn't do anything
+//
+// This is part of the Connection interface
+func (c *jsonRPCConnection) Disconnect() {
+	if !atomic.CompareAndSwapUint32(&c.isConnected, 1, 0) {
+		return
+	}
+	close(c.stopChan)
+	if c.webSocket != nil {
+		// Ignore the error because we don't really know what's the status of the connection
+		_ = c.webSocket.Close()
+	}
+
+	log.Debugf("Disconnecting from %s", c)
+	if c.onDisconnectedHandler != nil {
+		c.onDisconnectedHandler()
+	}
+}
+
+func (c *jsonRPCConnection) isWebSocket() bool {
+	return c.webSocket != nil
+}
+
+func (c *jsonRPCConnection) sendLoop() error {
+	outgoingRoute := c.router.OutgoingRoute()
+	for c.IsConnected() {
+		message, err := outgoingRoute.Dequeue()
+		if err != nil {
+			if errors.Is(err, routerpkg.ErrRouteClosed) {
+				return nil
+			}
+			return err
+		}
+
+		log.Debugf("outgoing '%s' message to %s", message.Command(), c)
+		log.Tracef("outgoing '%s' message to %s: %s", message.Command(), c, logger.NewLogClosure(func() string {
+			return spew.Sdump(message)
+		}))
+
+		if isNotificationMessage(message) {
+			err := c.sendNotification(message)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		select {
+		case c.responseChan <- message:
+		case <-c.stopChan:
+			return nil
+		}
+	}
+	return nil
+}
+
+func (c *jsonRPCConnection) sendNotification(notification appmessage.Message) error {
+	if !c.isWebSocket() {
+		log.Debugf("Dropping '%s' message to %s: notifications are only sent over WebSocket",
+			notification.Command(), c)
+		return nil
+	}
+
+	jsonRPCNotification, err := notificationToJSONRPC(notification)
+	if err != nil {
+		return err
+	}
+	serializedNotification, err := json.Marshal(jsonRPCNotification)
+	if err != nil {
+		return err
+	}
+	return c.write(serializedNotification)
+}
+
+// receiveLoop receives JSON-RPC payloads from the connection's WebSocket
+// and writes back their responses, until the WebSocket is closed
+func (c *jsonRPCConnection) receiveLoop() error {
+	for c.IsConnected() {
+		var payload []byte
+		err := websocket.Message.Receive(c.webSocket, &payload)
+		if err != nil {
+			if err == io.EOF || !c.IsConnected() {
+				return nil
+			}
+			return err
+		}
+
+		response := c.handlePayload(payload)
+		if response == nil {
+			continue
+		}
+		err = c.write(response)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *jsonRPCConnection) write(payload []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	return websocket.Message.Send(c.webSocket, string(payload))
+}
+
+// handlePayload handles the given JSON-RPC payload, which is either a single request or
+// a batch of requests, and returns the serialized response. A nil response means that
+// there's nothing to respond with, which is the case when all the requests are notifications.
+func (c *jsonRPCConnection) handlePayload(payload []byte) []byte {
+	if !json.Valid(payload) {
+		return c.serializeResponse(newJSONRPCErrorResponse(nil,
+			newJSONRPCError(parseErrorCode, "Parse error: payload is not valid JSON")))
+	}
+
+	trimmedPayload := bytes.TrimLeft(payload, " \t\r\n")
+	if trimmedPayload[0] != '[' {
+		response := c.handleRequest(payload)
+		if response == nil {
+			return nil
+		}
+		return c.serializeResponse(response)
+	}
+
+	var batch []json.RawMessage
+	err := json.Unmarshal(payload, &batch)
+	if err != nil {
+		return c.serializeResponse(newJSONRPCErrorResponse(nil,
+			newJSONRPCError(parseErrorCode, "Parse error: %s", err)))
+	}
+	if len(batch) == 0 {
+		return c.serializeResponse(newJSONRPCErrorResponse(nil,
+			newJSONRPCError(invalidRequestCode, "Invalid request: empty batch")))
+	}
+
+	responses := make([]*jsonRPCResponse, 0, len(batch))
+	for _, request := range batch {
+		response := c.handleRequest(request)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		return nil
+	}
+	return c.serializeResponse(responses)
+}
+
+func (c *jsonRPCConnection) serializeResponse(response interface{}) []byte {
+	serializedResponse, err := json.Marshal(response)
+	if err != nil {
+		// This should never happen, since responses are built from types that always marshal
+		log.Errorf("Could not serialize JSON-RPC response to %s: %s", c, err)
+		serializedResponse, _ = json.Marshal(newJSONRPCErrorResponse(nil,
+			newJSONRPCError(internalErrorCode, "Internal error")))
+	}
+	return serializedResponse
+}
+
+// handleRequest handles a single JSON-RPC request, given as valid JSON. A nil
+// response means that the request is a notification and should not be responded to.
+func (c *jsonRPCConnection) handleRequest(serializedRequest []byte) *jsonRPCResponse {
+	request := &jsonRPCRequest{}
+	err := json.Unmarshal(serializedRequest, request)
+	if err != nil {
+		return newJSONRPCErrorResponse(nil, newJSONRPCError(invalidRequestCode, "Invalid request: %s", err))
+	}
+	if request.JSONRPC != jsonRPCVersion || request.Method == "" {
+		return newJSONRPCErrorResponse(request.ID, newJSONRPCError(invalidRequestCode,
+			"Invalid request: expected a 'jsonrpc' of '%s' and a non-empty 'method'", jsonRPCVersion))
+	}
+
+	result, jsonRPCErr := c.call(request.Method, request.Params)
+	if request.isNotification() {
+		return nil
+	}
+	if jsonRPCErr != nil {
+		return newJSONRPCErrorResponse(request.ID, jsonRPCErr)
+	}
+	return &jsonRPCResponse{
+		JSONRPC: jsonRPCVersion,
+		Result:  result,
+		ID:      request.ID,
+	}
+}
+
+func (c *jsonRPCConnection) call(method string, params json.RawMessage) (json.RawMessage, *jsonRPCError) {
+	if !c.isWebSocket() && isSubscriptionMethod(method) {
+		return nil, newJSONRPCError(invalidRequestCode, "Method '%s' is only available over WebSocket", method)
+	}
+
+	request, jsonRPCErr := requestToAppMessage(method, params)
+	if jsonRPCErr != nil {
+		return nil, jsonRPCErr
+	}
+
+	response, err := c.request(request)
+	if err != nil {
+		if errors.Is(err, errDisconnected) || errors.Is(err, routerpkg.ErrRouteClosed) {
+			return nil, newJSONRPCError(internalErrorCode, "Connection closed while handling method '%s'", method)
+		}
+		// The request is a valid message that isn't routed to any RPC handler
+		return nil, newJSONRPCError(methodNotFoundCode, "Method '%s' not found", method)
+	}
+
+	result, jsonRPCErr, err := responseToResult(response)
+	if err != nil {
+		log.Errorf("Could not convert '%s' message to %s to JSON-RPC: %s", response.Command(), c, err)
+		return nil, newJSONRPCError(internalErrorCode, "Internal error")
+	}
+	return result, jsonRPCErr
+}
+
+// request passes the given request to the RPC handlers and waits for their response
+func (c *jsonRPCConnection) request(request appmessage.Message) (appmessage.Message, error) {
+	c.requestLock.Lock()
+	defer c.requestLock.Unlock()
+
+	c.messageNumber++
+	request.SetMessageNumber(c.messageNumber)
+	request.SetReceivedAt(time.Now())
+
+	log.Debugf("incoming '%s' message from %s (message number %d)", request.Command(), c,
+		request.MessageNumber())
+	log.Tracef("incoming '%s' message from %s  (message number %d): %s", request.Command(),
+		c, request.MessageNumber(), logger.NewLogClosure(func() string {
+			return spew.Sdump(request)
+		}))
+
+	err := c.router.EnqueueIncomingMessage(request)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case response := <-c.responseChan:
+		return response, nil
+	case <-c.stopChan:
+		return nil, errDisconnected
+	}
+}
@@ -0,0 +1,285 @@
+package jsonrpcserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/kaspanet/kaspad/util/panics"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+)
+
+// MaxMessageSize is the max size of a JSON-RPC payload the server receives, either as the
+// body of an HTTP request or as a WebSocket frame. It's plenty for submitting a full block
+const MaxMessageSize = 8 * 1024 * 1024 // 8 MB
+
+type jsonRPCServer struct {
+	onConnectedHandler server.OnConnectedHandler
+	listeningAddresses []string
+	allowedOrigins     []string
+	isAuthenticated    func(authorization string) bool
+	httpServers        []*http.Server
+	webSocketServer    websocket.Server
+
+	maxWebSockets      int
+	webSocketCount     int
+	webSocketCountLock sync.Mutex
+
+	// httpRequestSemaphore limits the amount of HTTP requests handled
+	// concurrently. A nil semaphore means there's no limit.
+	httpRequestSemaphore chan struct{}
+}
+
+// NewJSONRPCServer creates a new JSON-RPC server, serving both HTTP and WebSocket
+// connections on the given listening addresses.
+// allowedOrigins is the list of browser origins that are allowed to connect to the server.
+// isAuthenticated checks the authorization clients present, so that clients with invalid
+// credentials are rejected before their requests are read. A nil isAuthenticated accepts all clients.
+// A limit of 0 means no limit.
+func NewJSONRPCServer(listeningAddresses []string, maxWebSockets int, maxConcurrentHTTPRequests int,
+	allowedOrigins []string, isAuthenticated func(authorization string) bool) (server.Server, error) {
+
+	jsonRPCServer := &jsonRPCServer{
+		listeningAddresses: listeningAddresses,
+		allowedOrigins:     allowedOrigins,
+		isAuthenticated:    isAuthenticated,
+		maxWebSockets:      maxWebSockets,
+	}
+	if maxConcurrentHTTPRequests > 0 {
+		jsonRPCServer.httpRequestSemaphore = make(chan struct{}, maxConcurrentHTTPRequests)
+	}
+	// Origins are checked by ServeHTTP before upgrading to WebSocket,
+	// so the WebSocket server doesn't need a handshake that checks them again
+	jsonRPCServer.webSocketServer = websocket.Server{Handler: jsonRPCServer.handleWebSocket}
+	return jsonRPCServer, nil
+}
+
+func (s *jsonRPCServer) Start() error {
+	if s.onConnectedHandler == nil {
+		return errors.New("onConnectedHandler is nil")
+	}
+
+	for _, listenAddress := range s.listeningAddresses {
+		err := s.listenOn(listenAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *jsonRPCServer) listenOn(listenAddress string) error {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return errors.Wrapf(err, "JSON-RPC error listening on %s", listenAddress)
+	}
+
+	httpServer := &http.Server{Handler: s}
+	s.httpServers = append(s.httpServers, httpServer)
+
+	spawn("jsonRPCServer.listenOn-Serve", func() {
+		err := httpServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panics.Exit(log, fmt.Sprintf("error serving JSON-RPC on %s: %+v", listenAddress, err))
+		}
+	})
+
+	log.Infof("JSON-RPC Server listening on %s", listener.Addr())
+	return nil
+}
+
+func (s *jsonRPCServer) Stop() error {
+	const stopTimeout = 2 * time.Second
+
+	for _, httpServer := range s.httpServers {
+		ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+		err := httpServer.Shutdown(ctx)
+		cancel()
+		if err != nil {
+			log.Warnf("Could not gracefully stop the JSON-RPC server: %s", err)
+			err = httpServer.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SetOnConnectedHandler sets the peer connected handler
+// function for the server
+func (s *jsonRPCServer) SetOnConnectedHandler(onConnectedHandler server.OnConnectedHandler) {
+	s.onConnectedHandler = onConnectedHandler
+}
+
+// ServeHTTP handles HTTP requests, upgrading them to WebSocket connections when asked to
+func (s *jsonRPCServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	defer panics.HandlePanic(log, "jsonRPCServer.ServeHTTP", nil)
+
+	origin := request.Header.Get("Origin")
+	if !s.isOriginAllowed(origin) {
+		log.Debugf("Rejecting JSON-RPC request from %s: origin '%s' is not allowed", request.RemoteAddr, origin)
+		http.Error(writer, "Origin not allowed", http.StatusForbidden)
+		return
+	}
+
+	if strings.EqualFold(request.Header.Get("Upgrade"), "websocket") {
+		if !s.authenticate(writer, request) {
+			return
+		}
+		s.webSocketServer.ServeHTTP(writer, request)
+		return
+	}
+
+	if origin != "" {
+		writer.Header().Set("Access-Control-Allow-Origin", origin)
+		writer.Header().Set("Vary", "Origin")
+	}
+	if request.Method == http.MethodOptions {
+		writer.Header().Set("Access-Control-Allow-Methods", http.MethodPost)
+		writer.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if request.Method != http.MethodPost {
+		writer.Header().Set("Allow", http.MethodPost)
+		http.Error(writer, "JSON-RPC requests must use POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.authenticate(writer, request) {
+		return
+	}
+
+	if s.httpRequestSemaphore != nil {
+		select {
+		case s.httpRequestSemaphore <- struct{}{}:
+			defer func() { <-s.httpRequestSemaphore }()
+		default:
+			log.Warnf("Limit of %d concurrent JSON-RPC HTTP requests has been exceeded", cap(s.httpRequestSemaphore))
+			http.Error(writer, "Too many concurrent requests", http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	s.handleHTTPRequest(writer, request)
+}
+
+func (s *jsonRPCServer) handleHTTPRequest(writer http.ResponseWriter, request *http.Request) {
+	if request.ContentLength > MaxMessageSize {
+		http.Error(writer, "Request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	// Bodies of unknown length are cut off by MaxBytesReader, which also closes the connection
+	payload, err := io.ReadAll(http.MaxBytesReader(writer, request.Body, MaxMessageSize))
+	if err != nil {
+		http.Error(writer, fmt.Sprintf("Could not read request body: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	address, err := net.ResolveTCPAddr("tcp", request.RemoteAddr)
+	if err != nil {
+		http.Error(writer, "Could not resolve remote address", http.StatusBadRequest)
+		return
+	}
+
+	connection := newConnection(address, request.Header.Get(server.AuthorizationKey), nil)
+	err = s.onConnectedHandler(connection)
+	if err != nil {
+		log.Errorf("Could not handle JSON-RPC HTTP request from %s: %s", address, err)
+		http.Error(writer, "Internal error", http.StatusInternalServerError)
+		return
+	}
+	defer connection.Disconnect()
+
+	response := connection.handlePayload(payload)
+	if response == nil {
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	_, err = writer.Write(response)
+	if err != nil {
+		log.Debugf("Could not write JSON-RPC HTTP response to %s: %s", address, err)
+	}
+}
+
+func (s *jsonRPCServer) handleWebSocket(webSocket *websocket.Conn) {
+	err := s.incrementWebSocketCountAndLimitIfRequired()
+	if err != nil {
+		return
+	}
+	defer s.decrementWebSocketCount()
+
+	address, err := net.ResolveTCPAddr("tcp", webSocket.Request().RemoteAddr)
+	if err != nil {
+		log.Errorf("Could not resolve JSON-RPC WebSocket remote address: %s", err)
+		return
+	}
+	webSocket.MaxPayloadBytes = MaxMessageSize
+
+	connection := newConnection(address, webSocket.Request().Header.Get(server.AuthorizationKey), webSocket)
+	err = s.onConnectedHandler(connection)
+	if err != nil {
+		log.Errorf("Could not handle JSON-RPC WebSocket connection from %s: %s", address, err)
+		return
+	}
+	log.Infof("JSON-RPC Incoming WebSocket connection from %s", address)
+
+	err = connection.receiveLoop()
+	if err != nil {
+		log.Errorf("error from receiveLoop for %s: %s", connection, err)
+	}
+	connection.Disconnect()
+}
+
+// authenticate rejects the request if the server checks credentials, and the ones
+// the client presented are invalid. It returns whether the request may proceed
+func (s *jsonRPCServer) authenticate(writer http.ResponseWriter, request *http.Request) bool {
+	if s.isAuthenticated == nil || s.isAuthenticated(request.Header.Get(server.AuthorizationKey)) {
+		return true
+	}
+	log.Warnf("Rejecting JSON-RPC request from %s: invalid credentials", request.RemoteAddr)
+	http.Error(writer, "Invalid credentials", http.StatusUnauthorized)
+	return false
+}
+
+func (s *jsonRPCServer) isOriginAllowed(origin string) bool {
+	// Requests that don't come from browsers carry no origin
+	if origin == "" {
+		return true
+	}
+	for _, allowedOrigin := range s.allowedOrigins {
+		if allowedOrigin == "*" || allowedOrigin == origin {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *jsonRPCServer) incrementWebSocketCountAndLimitIfRequired() error {
+	s.webSocketCountLock.Lock()
+	defer s.webSocketCountLock.Unlock()
+
+	if s.maxWebSockets > 0 && s.webSocketCount >= s.maxWebSockets {
+		log.Warnf("Limit of %d JSON-RPC WebSocket connections has been exceeded", s.maxWebSockets)
+		return errors.Errorf("limit of %d JSON-RPC WebSocket connections has been exceeded", s.maxWebSockets)
+	}
+
+	s.webSocketCount++
+	return nil
+}
+
+func (s *jsonRPCServer) decrementWebSocketCount() {
+	s.webSocketCountLock.Lock()
+	defer s.webSocketCountLock.Unlock()
+
+	s.webSocketCount--
+}
@@ -0,0 +1,135 @@
+package jsonrpcserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	requestSuffix      = "Request"
+	notificationSuffix = "Notification"
+)
+
+var payloadOneofDescriptor = (&protowire.KaspadMessage{}).ProtoReflect().Descriptor().Oneofs().ByName("payload")
+
+// requestFieldsByMethod maps every JSON-RPC method to its respective
+// KaspadMessage payload field
+var requestFieldsByMethod = buildRequestFieldsByMethod()
+
+var marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+
+func buildRequestFieldsByMethod() map[string]protoreflect.FieldDescriptor {
+	fields := payloadOneofDescriptor.Fields()
+	requestFieldsByMethod := make(map[string]protoreflect.FieldDescriptor)
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := field.JSONName()
+		if !strings.HasSuffix(name, requestSuffix) {
+			continue
+		}
+		requestFieldsByMethod[strings.TrimSuffix(name, requestSuffix)] = field
+	}
+	return requestFieldsByMethod
+}
+
+// isSubscriptionMethod returns whether the given method changes the
+// notifications the client is subscribed to
+func isSubscriptionMethod(method string) bool {
+	return strings.HasPrefix(method, "notify") || strings.HasPrefix(method, "stopNotifying")
+}
+
+// isNotificationMessage returns whether the given message is a notification
+// rather than a response to a request
+func isNotificationMessage(message appmessage.Message) bool {
+	return strings.HasSuffix(appmessage.RPCMessageCommandToString[message.Command()], notificationSuffix)
+}
+
+// requestToAppMessage converts the given JSON-RPC method and params
+// to their respective appmessage
+func requestToAppMessage(method string, params json.RawMessage) (appmessage.Message, *jsonRPCError) {
+	field, ok := requestFieldsByMethod[method]
+	if !ok {
+		return nil, newJSONRPCError(methodNotFoundCode, "Method '%s' not found", method)
+	}
+
+	kaspadMessage := &protowire.KaspadMessage{}
+	reflectedKaspadMessage := kaspadMessage.ProtoReflect()
+	request := reflectedKaspadMessage.NewField(field).Message()
+	if len(params) > 0 && !bytes.Equal(params, []byte("null")) {
+		err := protojson.Unmarshal(params, request.Interface())
+		if err != nil {
+			return nil, newJSONRPCError(invalidParamsCode, "Invalid params for method '%s': %s", method, err)
+		}
+	}
+	reflectedKaspadMessage.Set(field, protoreflect.ValueOfMessage(request))
+
+	message, err := kaspadMessage.ToAppMessage()
+	if err != nil {
+		return nil, newJSONRPCError(invalidParamsCode, "Invalid params for method '%s': %s", method, err)
+	}
+	return message, nil
+}
+
+// appMessageToPayload converts the given appmessage to the name and
+// the contents of its respective KaspadMessage payload field
+func appMessageToPayload(message appmessage.Message) (name string, payload protoreflect.Message, err error) {
+	kaspadMessage, err := protowire.FromAppMessage(message)
+	if err != nil {
+		return "", nil, err
+	}
+
+	reflectedKaspadMessage := kaspadMessage.ProtoReflect()
+	field := reflectedKaspadMessage.WhichOneof(payloadOneofDescriptor)
+	if field == nil {
+		return "", nil, errors.Errorf("message '%s' has no payload", message.Command())
+	}
+	return field.JSONName(), reflectedKaspadMessage.Get(field).Message(), nil
+}
+
+// responseToResult converts the given RPC response to either a JSON-RPC
+// result or a JSON-RPC error, depending on whether it carries an RPCError
+func responseToResult(response appmessage.Message) (json.RawMessage, *jsonRPCError, error) {
+	_, payload, err := appMessageToPayload(response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errorField := payload.Descriptor().Fields().ByName("error")
+	if errorField != nil && payload.Has(errorField) {
+		rpcError, ok := payload.Get(errorField).Message().Interface().(*protowire.RPCError)
+		if ok {
+			return nil, newJSONRPCError(rpcErrorCode, "%s", rpcError.Message), nil
+		}
+	}
+
+	result, err := marshalOptions.Marshal(payload.Interface())
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, nil, nil
+}
+
+// notificationToJSONRPC converts the given RPC notification to a JSON-RPC notification
+func notificationToJSONRPC(notification appmessage.Message) (*jsonRPCNotification, error) {
+	name, payload, err := appMessageToPayload(notification)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := marshalOptions.Marshal(payload.Interface())
+	if err != nil {
+		return nil, err
+	}
+	return &jsonRPCNotification{
+		JSONRPC: jsonRPCVersion,
+		Method:  name,
+		Params:  params,
+	}, nil
+}
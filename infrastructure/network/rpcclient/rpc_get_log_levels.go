@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// GetLogLevels sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetLogLevels() (*appmessage.GetLogLevelsResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetLogLevelsRequestMessage())
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetLogLevelsResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getLogLevelsResponse := response.(*appmessage.GetLogLevelsResponseMessage)
+	if getLogLevelsResponse.Error != nil {
+		return nil, c.convertRPCError(getLogLevelsResponse.Error)
+	}
+	return getLogLevelsResponse, nil
+}
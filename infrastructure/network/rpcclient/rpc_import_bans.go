@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// ImportBans sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) ImportBans(bans []*appmessage.RPCBan) (*appmessage.ImportBansResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewImportBansRequestMessage(bans))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdImportBansResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	importBansResponse := response.(*appmessage.ImportBansResponseMessage)
+	if importBansResponse.Error != nil {
+		return nil, c.convertRPCError(importBansResponse.Error)
+	}
+	return importBansResponse, nil
+}
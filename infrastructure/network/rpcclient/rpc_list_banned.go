@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/kaspanet/kaspad/app/appmessage"
+
+// ListBanned sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) ListBanned() (*appmessage.ListBannedResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewListBannedRequestMessage())
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdListBannedResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	listBannedResponse := response.(*appmessage.ListBannedResponseMessage)
+	if listBannedResponse.Error != nil {
+		return nil, c.convertRPCError(listBannedResponse.Error)
+	}
+	return listBannedResponse, nil
+}
@@ -0,0 +1,42 @@
+package rpcclient
+
+import (
+	"github.com/kaspanet/kaspad/app/appmessage"
+	routerpkg "github.com/kaspanet/kaspad/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// RegisterForVirtualChainChangedV2Notifications sends an RPC request respective to the function's name and returns
+// the chain block from which notifications start. Pass an empty startHash to start from the current virtual
+// selected parent.
+// Additionally, it starts listening for the appropriate notification using the given handler function
+func (c *RPCClient) RegisterForVirtualChainChangedV2Notifications(startHash string,
+	onVirtualChainChanged func(notification *appmessage.VirtualChainChangedV2NotificationMessage)) (string, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyVirtualChainChangedV2RequestMessage(startHash))
+	if err != nil {
+		return "", err
+	}
+	response, err := c.route(appmessage.CmdNotifyVirtualChainChangedV2ResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return "", err
+	}
+	notifyVirtualChainChangedV2Response := response.(*appmessage.NotifyVirtualChainChangedV2ResponseMessage)
+	if notifyVirtualChainChangedV2Response.Error != nil {
+		return "", c.convertRPCError(notifyVirtualChainChangedV2Response.Error)
+	}
+	spawn("RegisterForVirtualChainChangedV2Notifications", func() {
+		for {
+			notification, err := c.route(appmessage.CmdVirtualChainChangedV2NotificationMessage).Dequeue()
+			if err != nil {
+				if errors.Is(err, routerpkg.ErrRouteClosed) {
+					break
+				}
+				panic(err)
+			}
+			virtualChainChangedNotification := notification.(*appmessage.VirtualChainChangedV2NotificationMessage)
+			onVirtualChainChanged(virtualChainChangedNotification)
+		}
+	})
+	return notifyVirtualChainChangedV2Response.StartHash, nil
+}
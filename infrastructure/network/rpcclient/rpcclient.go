@@ -20,20 +20,29 @@ type RPCClient struct {
 	*grpcclient.GRPCClient
 
 	rpcAddress           string
+	authorization        string
 	rpcRouter            *rpcRouter
 	isConnected          uint32
 	isClosed             uint32
 	isReconnecting       uint32
 	lastDisconnectedTime time.Time
+	onReconnectedHandler func()
 
 	timeout time.Duration
 }
 
 // NewRPCClient сreates a new RPC client with a default call timeout value
 func NewRPCClient(rpcAddress string) (*RPCClient, error) {
+	return NewRPCClientWithAuthorization(rpcAddress, "")
+}
+
+// NewRPCClientWithAuthorization creates a new RPC client with a default call timeout value,
+// which presents the RPC server with the given authorization. See server.Credentials
+func NewRPCClientWithAuthorization(rpcAddress string, authorization string) (*RPCClient, error) {
 	rpcClient := &RPCClient{
-		rpcAddress: rpcAddress,
-		timeout:    defaultTimeout,
+		rpcAddress:    rpcAddress,
+		authorization: authorization,
+		timeout:       defaultTimeout,
 	}
 	err := rpcClient.connect()
 	if err != nil {
@@ -44,7 +53,7 @@ func NewRPCClient(rpcAddress string) (*RPCClient, error) {
 }
 
 func (c *RPCClient) connect() error {
-	rpcClient, err := grpcclient.Connect(c.rpcAddress)
+	rpcClient, err := grpcclient.ConnectWithAuthorization(c.rpcAddress, c.authorization)
 	if err != nil {
 		return errors.Wrapf(err, "error connecting to address %s", c.rpcAddress)
 	}
@@ -118,6 +127,9 @@ func (c *RPCClient) Reconnect() error {
 		if time.Since(c.lastDisconnectedTime) > retryDelay {
 			err := c.connect()
 			if err == nil {
+				if c.onReconnectedHandler != nil {
+					spawn("RPCClient.onReconnectedHandler", c.onReconnectedHandler)
+				}
 				return nil
 			}
 			log.Warnf("Could not automatically reconnect to %s: %s", c.rpcAddress, err)
@@ -150,6 +162,12 @@ func (c *RPCClient) handleClientError(err error) {
 	c.handleClientDisconnected()
 }
 
+// SetOnReconnectedHandler sets a handler that's called whenever the client reconnects.
+// Notification registrations don't survive reconnection, so this is the place to renew them
+func (c *RPCClient) SetOnReconnectedHandler(onReconnectedHandler func()) {
+	c.onReconnectedHandler = onReconnectedHandler
+}
+
 // SetTimeout sets the timeout by which to wait for RPC responses
 func (c *RPCClient) SetTimeout(timeout time.Duration) {
 	c.timeout = timeout
@@ -0,0 +1,193 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+)
+
+func TestAddressIndex(t *testing.T) {
+	// Setup a single kaspad instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		addressIndex:            true,
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// Mine some blocks so that coinbase transactions paying to
+	// the mining address get accepted
+	const blockAmountToMine = 20
+	for i := 0; i < blockAmountToMine; i++ {
+		mineNextBlock(t, kaspad)
+	}
+
+	getAllResponse, err := kaspad.rpcClient.GetAddressTransactions([]string{miningAddress1}, 0, 0)
+	if err != nil {
+		t.Fatalf("Error getting address transactions: %s", err)
+	}
+	allEntries := getAllResponse.Entries
+	if len(allEntries) == 0 {
+		t.Fatalf("Expected the mining address to have transactions")
+	}
+	for i, entry := range allEntries {
+		if entry.Address != miningAddress1 {
+			t.Fatalf("Unexpected address. Want: %s, got: %s", miningAddress1, entry.Address)
+		}
+		if !entry.CreatedOutputs || entry.SpentOutputs {
+			t.Fatalf("Expected transaction %s to only create outputs to the mining address", entry.TransactionID)
+		}
+		if i > 0 && entry.AcceptingBlockDAAScore < allEntries[i-1].AcceptingBlockDAAScore {
+			t.Fatalf("Expected address transactions to be ordered by DAA score")
+		}
+	}
+
+	// Paginating through the history should yield exactly the same transactions
+	const limit = 3
+	pagedEntries := make([]*appmessage.AddressTransaction, 0, len(allEntries))
+	startDAAScore := uint64(0)
+	for {
+		getPageResponse, err := kaspad.rpcClient.GetAddressTransactions([]string{miningAddress1}, startDAAScore, limit)
+		if err != nil {
+			t.Fatalf("Error getting address transactions: %s", err)
+		}
+		if len(getPageResponse.Entries) == 0 {
+			break
+		}
+		if getPageResponse.NextDAAScore <= startDAAScore {
+			t.Fatalf("Expected the next DAA score to advance beyond %d, got: %d",
+				startDAAScore, getPageResponse.NextDAAScore)
+		}
+		pagedEntries = append(pagedEntries, getPageResponse.Entries...)
+		startDAAScore = getPageResponse.NextDAAScore
+	}
+	if len(pagedEntries) != len(allEntries) {
+		t.Fatalf("Unexpected amount of paged address transactions. Want: %d, got: %d",
+			len(allEntries), len(pagedEntries))
+	}
+	for i, entry := range pagedEntries {
+		if *entry != *allEntries[i] {
+			t.Fatalf("Unexpected paged address transaction at index %d. Want: %+v, got: %+v",
+				i, allEntries[i], entry)
+		}
+	}
+
+	// An address with no history should have no transactions
+	getEmptyResponse, err := kaspad.rpcClient.GetAddressTransactions([]string{miningAddress3}, 0, 0)
+	if err != nil {
+		t.Fatalf("Error getting address transactions: %s", err)
+	}
+	if len(getEmptyResponse.Entries) != 0 {
+		t.Fatalf("Expected an address with no history to have no transactions, got: %d",
+			len(getEmptyResponse.Entries))
+	}
+}
+
+func TestAddressIndexReorg(t *testing.T) {
+	// Setup a couple of kaspad instances, the first of which has an address index
+	harnesses, teardown := setupHarnesses(t, []*harnessParams{
+		{
+			p2pAddress:              p2pAddress1,
+			rpcAddress:              rpcAddress1,
+			miningAddress:           miningAddress1,
+			miningAddressPrivateKey: miningAddress1PrivateKey,
+			addressIndex:            true,
+		},
+		{
+			p2pAddress:              p2pAddress2,
+			rpcAddress:              rpcAddress2,
+			miningAddress:           miningAddress3,
+			miningAddressPrivateKey: miningAddress3PrivateKey,
+		},
+	})
+	defer teardown()
+	kaspad1, kaspad2 := harnesses[0], harnesses[1]
+
+	// The address index is updated before chain changed notifications are sent,
+	// so once a notification arrives the index already reflects it
+	onChainChangedChan := make(chan *appmessage.VirtualSelectedParentChainChangedNotificationMessage, 100)
+	err := kaspad1.rpcClient.RegisterForVirtualSelectedParentChainChangedNotifications(false,
+		func(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage) {
+			onChainChangedChan <- notification
+		})
+	if err != nil {
+		t.Fatalf("Failed to register for virtual selected parent chain change notifications: %s", err)
+	}
+
+	// In kaspad1, mine a chain over the genesis
+	const blockAmountToMine = 10
+	chain1BlockHashes := make(map[string]struct{}, blockAmountToMine)
+	for i := 0; i < blockAmountToMine; i++ {
+		minedBlock := mineNextBlock(t, kaspad1)
+		chain1BlockHashes[consensushashing.BlockHash(minedBlock).String()] = struct{}{}
+		receiveChainChangedNotification(t, onChainChangedChan)
+	}
+
+	getResponse, err := kaspad1.rpcClient.GetAddressTransactions([]string{miningAddress1}, 0, 0)
+	if err != nil {
+		t.Fatalf("Error getting address transactions: %s", err)
+	}
+	acceptedByChain1Count := 0
+	for _, entry := range getResponse.Entries {
+		if _, ok := chain1BlockHashes[entry.AcceptingBlockHash]; ok {
+			acceptedByChain1Count++
+		}
+	}
+	if acceptedByChain1Count == 0 {
+		t.Fatalf("Expected the mining address to have transactions accepted by the chain of kaspad1")
+	}
+
+	// In kaspad2, mine a longer chain over the genesis and connect the two kaspads.
+	// kaspad1 should then reorg to the chain of kaspad2, removing all of its own blocks
+	for i := 0; i < blockAmountToMine+1; i++ {
+		mineNextBlock(t, kaspad2)
+	}
+	connect(t, kaspad1, kaspad2)
+
+	reorgNotification := receiveChainChangedNotification(t, onChainChangedChan)
+	for len(reorgNotification.RemovedChainBlockHashes) == 0 {
+		reorgNotification = receiveChainChangedNotification(t, onChainChangedChan)
+	}
+	if len(reorgNotification.RemovedChainBlockHashes) != blockAmountToMine {
+		t.Fatalf("Unexpected length of RemovedChainBlockHashes. Want: %d, got: %d",
+			blockAmountToMine, len(reorgNotification.RemovedChainBlockHashes))
+	}
+
+	// The transactions accepted by the removed chain blocks should disappear from the history
+	getResponse, err = kaspad1.rpcClient.GetAddressTransactions([]string{miningAddress1}, 0, 0)
+	if err != nil {
+		t.Fatalf("Error getting address transactions: %s", err)
+	}
+	for _, entry := range getResponse.Entries {
+		if _, ok := chain1BlockHashes[entry.AcceptingBlockHash]; ok {
+			t.Fatalf("Transaction %s is still accepted by the removed chain block %s",
+				entry.TransactionID, entry.AcceptingBlockHash)
+		}
+	}
+
+	// And the transactions accepted by the added chain blocks should appear in it
+	getResponse, err = kaspad1.rpcClient.GetAddressTransactions([]string{miningAddress3}, 0, 0)
+	if err != nil {
+		t.Fatalf("Error getting address transactions: %s", err)
+	}
+	if len(getResponse.Entries) == 0 {
+		t.Fatalf("Expected the mining address of kaspad2 to have transactions after the reorg")
+	}
+}
+
+func receiveChainChangedNotification(t *testing.T,
+	onChainChangedChan chan *appmessage.VirtualSelectedParentChainChangedNotificationMessage) *appmessage.VirtualSelectedParentChainChangedNotificationMessage {
+
+	select {
+	case notification := <-onChainChangedChan:
+		return notification
+	case <-time.After(defaultTimeout):
+		t.Fatalf("Timed out waiting for a virtual selected parent chain changed notification")
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+package integration
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/util/mstime"
+)
+
+func TestBanListExportAndImport(t *testing.T) {
+	harnesses, teardown := setupHarnesses(t, []*harnessParams{
+		{
+			p2pAddress:              p2pAddress1,
+			rpcAddress:              rpcAddress1,
+			miningAddress:           miningAddress1,
+			miningAddressPrivateKey: miningAddress1PrivateKey,
+		},
+		{
+			p2pAddress:              p2pAddress2,
+			rpcAddress:              rpcAddress2,
+			miningAddress:           miningAddress2,
+			miningAddressPrivateKey: miningAddress2PrivateKey,
+		},
+	})
+	defer teardown()
+	kaspad1, kaspad2 := harnesses[0], harnesses[1]
+
+	_, err := kaspad1.rpcClient.Ban("203.0.113.0/24", "hosting provider", 0, true)
+	if err != nil {
+		t.Fatalf("Error banning a subnet: %s", err)
+	}
+	_, err = kaspad1.rpcClient.Ban("198.51.100.7", "spam", 3600, false)
+	if err != nil {
+		t.Fatalf("Error banning an IP: %s", err)
+	}
+
+	exportedBans, err := kaspad1.rpcClient.ListBanned()
+	if err != nil {
+		t.Fatalf("Error listing bans: %s", err)
+	}
+	if len(exportedBans.Bans) != 2 {
+		t.Fatalf("Unexpected amount of bans. Want: 2, got: %d", len(exportedBans.Bans))
+	}
+	bansBySubnet := make(map[string]*appmessage.RPCBan)
+	for _, ban := range exportedBans.Bans {
+		bansBySubnet[ban.Subnet] = ban
+	}
+	subnetBan, ok := bansBySubnet["203.0.113.0/24"]
+	if !ok || subnetBan.Reason != "hosting provider" || subnetBan.ExpiresAt != 0 {
+		t.Fatalf("Unexpected ban of the subnet: %+v", subnetBan)
+	}
+	ipBan, ok := bansBySubnet["198.51.100.7"]
+	if !ok || ipBan.Reason != "spam" || ipBan.ExpiresAt-ipBan.CreatedAt != time.Hour.Milliseconds() {
+		t.Fatalf("Unexpected ban of the IP: %+v", ipBan)
+	}
+
+	expiredBan := &appmessage.RPCBan{
+		Subnet:    "192.0.2.0/24",
+		Reason:    "expired",
+		CreatedAt: mstime.Now().Add(-2 * time.Hour).UnixMilliseconds(),
+		ExpiresAt: mstime.Now().Add(-time.Hour).UnixMilliseconds(),
+	}
+	importResponse, err := kaspad2.rpcClient.ImportBans(append(exportedBans.Bans, expiredBan))
+	if err != nil {
+		t.Fatalf("Error importing bans: %s", err)
+	}
+	if importResponse.ImportedCount != 2 || len(importResponse.SkippedSubnets) != 0 {
+		t.Fatalf("Unexpected import result. Want 2 imported and none skipped, got: %d imported and %v skipped",
+			importResponse.ImportedCount, importResponse.SkippedSubnets)
+	}
+
+	importedBans, err := kaspad2.rpcClient.ListBanned()
+	if err != nil {
+		t.Fatalf("Error listing bans: %s", err)
+	}
+	if !reflect.DeepEqual(exportedBans.Bans, importedBans.Bans) {
+		t.Fatalf("Imported bans differ from the exported bans. Want: %v, got: %v",
+			exportedBans.Bans, importedBans.Bans)
+	}
+
+	_, err = kaspad2.rpcClient.Unban("203.0.113.0/24")
+	if err != nil {
+		t.Fatalf("Error unbanning a subnet: %s", err)
+	}
+	remainingBans, err := kaspad2.rpcClient.ListBanned()
+	if err != nil {
+		t.Fatalf("Error listing bans: %s", err)
+	}
+	if len(remainingBans.Bans) != 1 || remainingBans.Bans[0].Subnet != "198.51.100.7" {
+		t.Fatalf("Unexpected bans after unbanning the subnet: %v", remainingBans.Bans)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kaspanet/kaspad/domain/dagconfig"
 	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
 )
 
 const (
@@ -22,6 +23,8 @@ const (
 	rpcAddress4 = "127.0.0.1:12348"
 	rpcAddress5 = "127.0.0.1:12349"
 
+	jsonRPCAddress1 = "127.0.0.1:12350"
+
 	miningAddress1           = "kaspasim:qqqqnc0pxg7qw3qkc7l6sge8kfhsvvyt7mkw8uamtndqup27ftnd6c769gn66"
 	miningAddress1PrivateKey = "0d81045b0deb2af36a25403c2154c87aa82d89dd337b575bae27ce7f5de53cee"
 
@@ -40,6 +43,13 @@ func setConfig(t *testing.T, harness *appHarness, protocolVersion uint32) {
 	harness.config.Listeners = []string{harness.p2pAddress}
 	harness.config.RPCListeners = []string{harness.rpcAddress}
 	harness.config.UTXOIndex = harness.utxoIndex
+	harness.config.TxIndex = harness.txIndex
+	harness.config.AddressIndex = harness.addressIndex
+	if harness.jsonRPCAddress != "" {
+		harness.config.JSONRPCListeners = []string{harness.jsonRPCAddress}
+		harness.config.JSONRPCAllowedOrigins = harness.jsonRPCAllowedOrigins
+	}
+	harness.config.RPCAuthorization = harness.rpcAuthorization
 	harness.config.AllowSubmitBlockWhenNotSynced = true
 	if protocolVersion != 0 {
 		harness.config.ProtocolVersion = protocolVersion
@@ -58,6 +68,7 @@ func commonConfig() *config.Config {
 	commonConfig.TargetOutboundPeers = 0
 	commonConfig.DisableDNSSeed = true
 	commonConfig.Simnet = true
+	commonConfig.DbType = dbfactory.TypeMemory
 
 	return commonConfig
 }
@@ -0,0 +1,42 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestGetFeeEstimate(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+	})
+	defer teardown()
+
+	mineNextBlock(t, harness)
+
+	response, err := harness.rpcClient.GetFeeEstimate()
+	if err != nil {
+		t.Fatalf("Error getting fee estimate: %s", err)
+	}
+	estimate := response.Estimate
+
+	// The mempool is empty, so the minimum relay fee rate is enough to get into the next block
+	minimumFeeRate := float64(harness.config.MinRelayTxFee) / 1000
+	if estimate.PriorityBucket.FeeRate != minimumFeeRate {
+		t.Fatalf("Unexpected priority fee rate. Want: %f, got: %f", minimumFeeRate, estimate.PriorityBucket.FeeRate)
+	}
+	expectedSeconds := harness.config.ActiveNetParams.TargetTimePerBlock.Seconds()
+	if estimate.PriorityBucket.EstimatedSeconds != expectedSeconds {
+		t.Fatalf("Unexpected priority estimated seconds. Want: %f, got: %f",
+			expectedSeconds, estimate.PriorityBucket.EstimatedSeconds)
+	}
+	if len(estimate.NormalBuckets) == 0 || len(estimate.LowBuckets) == 0 {
+		t.Fatalf("Expected normal and low buckets, got: %+v", estimate)
+	}
+	for _, bucket := range append(estimate.NormalBuckets, estimate.LowBuckets...) {
+		if bucket.FeeRate != minimumFeeRate {
+			t.Fatalf("Unexpected fee rate in bucket %+v", bucket)
+		}
+	}
+}
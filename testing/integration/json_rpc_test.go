@@ -0,0 +1,205 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server/jsonrpcserver"
+	"golang.org/x/net/websocket"
+)
+
+const jsonRPCAllowedOrigin = "http://127.0.0.1"
+
+type jsonRPCTestResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result"`
+	Error   *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+	ID json.RawMessage `json:"id"`
+}
+
+func TestJSONRPC(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		jsonRPCAddress:          jsonRPCAddress1,
+		jsonRPCAllowedOrigins:   []string{jsonRPCAllowedOrigin},
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+	})
+	defer teardown()
+
+	getInfoResponse, err := harness.rpcClient.GetInfo()
+	if err != nil {
+		t.Fatalf("Error getting info: %s", err)
+	}
+
+	// A JSON-RPC request should be handled the same as its respective gRPC request
+	response := postJSONRPC(t, `{"jsonrpc": "2.0", "method": "getInfo", "id": 1}`)
+	if response.Error != nil {
+		t.Fatalf("Unexpected error from getInfo: %s", response.Error.Message)
+	}
+	if string(response.ID) != "1" {
+		t.Fatalf("Unexpected response ID. Want: 1, got: %s", response.ID)
+	}
+	var getInfoResult struct {
+		P2PID string `json:"p2pId"`
+	}
+	err = json.Unmarshal(response.Result, &getInfoResult)
+	if err != nil {
+		t.Fatalf("Error unmarshalling getInfo result: %s", err)
+	}
+	if getInfoResult.P2PID != getInfoResponse.P2PID {
+		t.Fatalf("Unexpected p2pId. Want: %s, got: %s", getInfoResponse.P2PID, getInfoResult.P2PID)
+	}
+
+	// Unknown methods, RPC errors and subscriptions over HTTP should all result in JSON-RPC errors
+	errorTestCases := []struct {
+		request      string
+		expectedCode int
+	}{
+		{request: `{"jsonrpc": "2.0", "method": "noSuchMethod", "id": 1}`, expectedCode: -32601},
+		{request: `{"jsonrpc": "2.0", "method": "getBlock", "params": {"hash": "invalid"}, "id": 1}`, expectedCode: -32000},
+		{request: `{"jsonrpc": "2.0", "method": "getInfo", "params": [1, 2], "id": 1}`, expectedCode: -32602},
+		{request: `{"jsonrpc": "2.0", "method": "notifyBlockAdded", "id": 1}`, expectedCode: -32600},
+		{request: `{"jsonrpc": "1.0", "method": "getInfo", "id": 1}`, expectedCode: -32600},
+		{request: `{"jsonrpc": "2.0", "method": `, expectedCode: -32700},
+	}
+	for _, testCase := range errorTestCases {
+		response := postJSONRPC(t, testCase.request)
+		if response.Error == nil {
+			t.Fatalf("Expected request %s to fail", testCase.request)
+		}
+		if response.Error.Code != testCase.expectedCode {
+			t.Fatalf("Unexpected error code for request %s. Want: %d, got: %d",
+				testCase.request, testCase.expectedCode, response.Error.Code)
+		}
+	}
+
+	// Batches should be responded to with a response for every request that isn't a notification
+	batchResponse, err := http.Post("http://"+jsonRPCAddress1, "application/json", bytes.NewBufferString(`[
+		{"jsonrpc": "2.0", "method": "getInfo", "id": 1},
+		{"jsonrpc": "2.0", "method": "getBlockCount"},
+		{"jsonrpc": "2.0", "method": "getBlockCount", "id": 2}
+	]`))
+	if err != nil {
+		t.Fatalf("Error posting batch: %s", err)
+	}
+	defer batchResponse.Body.Close()
+	var batchResponses []*jsonRPCTestResponse
+	err = json.NewDecoder(batchResponse.Body).Decode(&batchResponses)
+	if err != nil {
+		t.Fatalf("Error decoding batch response: %s", err)
+	}
+	if len(batchResponses) != 2 {
+		t.Fatalf("Unexpected amount of batch responses. Want: 2, got: %d", len(batchResponses))
+	}
+
+	// Browsers on origins that weren't allowed should be rejected
+	request, err := http.NewRequest(http.MethodPost, "http://"+jsonRPCAddress1,
+		bytes.NewBufferString(`{"jsonrpc": "2.0", "method": "getInfo", "id": 1}`))
+	if err != nil {
+		t.Fatalf("Error creating request: %s", err)
+	}
+	request.Header.Set("Origin", "http://example.com")
+	originResponse, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("Error posting request: %s", err)
+	}
+	originResponse.Body.Close()
+	if originResponse.StatusCode != http.StatusForbidden {
+		t.Fatalf("Unexpected status for a disallowed origin. Want: %d, got: %d",
+			http.StatusForbidden, originResponse.StatusCode)
+	}
+
+	// Requests larger than the max message size should be rejected
+	largeRequest := bytes.Repeat([]byte(" "), jsonrpcserver.MaxMessageSize+1)
+	largeResponse, err := http.Post("http://"+jsonRPCAddress1, "application/json", bytes.NewReader(largeRequest))
+	if err != nil {
+		t.Fatalf("Error posting request: %s", err)
+	}
+	largeResponse.Body.Close()
+	if largeResponse.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("Unexpected status for a request that's too large. Want: %d, got: %d",
+			http.StatusRequestEntityTooLarge, largeResponse.StatusCode)
+	}
+
+	// Notifications should be sent over WebSocket
+	webSocket, err := websocket.Dial("ws://"+jsonRPCAddress1, "", jsonRPCAllowedOrigin)
+	if err != nil {
+		t.Fatalf("Error dialing WebSocket: %s", err)
+	}
+	defer webSocket.Close()
+
+	err = websocket.Message.Send(webSocket, `{"jsonrpc": "2.0", "method": "notifyBlockAdded", "id": "subscribe"}`)
+	if err != nil {
+		t.Fatalf("Error sending notifyBlockAdded: %s", err)
+	}
+	subscribeResponse := &jsonRPCTestResponse{}
+	receiveJSONRPC(t, webSocket, subscribeResponse)
+	if subscribeResponse.Error != nil {
+		t.Fatalf("Unexpected error from notifyBlockAdded: %s", subscribeResponse.Error.Message)
+	}
+	if string(subscribeResponse.ID) != `"subscribe"` {
+		t.Fatalf("Unexpected response ID. Want: \"subscribe\", got: %s", subscribeResponse.ID)
+	}
+
+	mineNextBlock(t, harness)
+
+	var notification struct {
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	receiveJSONRPC(t, webSocket, &notification)
+	if notification.Method != "blockAddedNotification" {
+		t.Fatalf("Unexpected notification method. Want: blockAddedNotification, got: %s", notification.Method)
+	}
+}
+
+func postJSONRPC(t *testing.T, request string) *jsonRPCTestResponse {
+	return postJSONRPCWithAuthorization(t, request, "")
+}
+
+func postJSONRPCWithAuthorization(t *testing.T, request string, authorization string) *jsonRPCTestResponse {
+	httpRequest, err := http.NewRequest(http.MethodPost, "http://"+jsonRPCAddress1, bytes.NewBufferString(request))
+	if err != nil {
+		t.Fatalf("Error creating request %s: %s", request, err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	if authorization != "" {
+		httpRequest.Header.Set("Authorization", authorization)
+	}
+	httpResponse, err := http.DefaultClient.Do(httpRequest)
+	if err != nil {
+		t.Fatalf("Error posting request %s: %s", request, err)
+	}
+	defer httpResponse.Body.Close()
+
+	response := &jsonRPCTestResponse{}
+	err = json.NewDecoder(httpResponse.Body).Decode(response)
+	if err != nil {
+		t.Fatalf("Error decoding response to %s: %s", request, err)
+	}
+	return response
+}
+
+func receiveJSONRPC(t *testing.T, webSocket *websocket.Conn, v interface{}) {
+	err := webSocket.SetReadDeadline(time.Now().Add(defaultTimeout))
+	if err != nil {
+		t.Fatalf("Error setting read deadline: %s", err)
+	}
+	var payload []byte
+	err = websocket.Message.Receive(webSocket, &payload)
+	if err != nil {
+		t.Fatalf("Error receiving from WebSocket: %s", err)
+	}
+	err = json.Unmarshal(payload, v)
+	if err != nil {
+		t.Fatalf("Error unmarshalling %s: %s", payload, err)
+	}
+}
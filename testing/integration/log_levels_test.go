@@ -0,0 +1,55 @@
+package integration
+
+import (
+	"testing"
+)
+
+func TestLogLevels(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		jsonRPCAddress:          jsonRPCAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+	})
+	defer teardown()
+
+	getLogLevel := func(subsystem string) string {
+		response, err := harness.rpcClient.GetLogLevels()
+		if err != nil {
+			t.Fatalf("GetLogLevels: %s", err)
+		}
+		for _, subsystemLogLevel := range response.SubsystemLogLevels {
+			if subsystemLogLevel.Subsystem == subsystem {
+				return subsystemLogLevel.LogLevel
+			}
+		}
+		t.Fatalf("Subsystem %s is missing from the GetLogLevels response", subsystem)
+		return ""
+	}
+
+	originalLogLevel := getLogLevel("BDAG")
+	defer func() {
+		_, err := harness.rpcClient.SetLogLevel("BDAG=" + originalLogLevel)
+		if err != nil {
+			t.Fatalf("Error restoring the log level: %s", err)
+		}
+	}()
+
+	_, err := harness.rpcClient.SetLogLevel("BDAG=trace")
+	if err != nil {
+		t.Fatalf("SetLogLevel: %s", err)
+	}
+	if logLevel := getLogLevel("BDAG"); logLevel != "trace" {
+		t.Fatalf("Unexpected log level. Want: trace, got: %s", logLevel)
+	}
+
+	// An invalid pair must not change any of the levels, including the valid ones
+	_, err = harness.rpcClient.SetLogLevel("BDAG=debug,NOSUCHSUBSYSTEM=debug")
+	if err == nil {
+		t.Fatalf("Expected SetLogLevel with an unknown subsystem to fail")
+	}
+	if logLevel := getLogLevel("BDAG"); logLevel != "trace" {
+		t.Fatalf("A failed SetLogLevel unexpectedly changed the log level to %s", logLevel)
+	}
+}
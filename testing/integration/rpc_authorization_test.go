@@ -0,0 +1,104 @@
+package integration
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/config"
+	"github.com/kaspanet/kaspad/infrastructure/network/netadapter/server"
+	"github.com/kaspanet/kaspad/infrastructure/network/rpcclient"
+)
+
+func TestRPCAuthorization(t *testing.T) {
+	const adminToken = "admin-token"
+	readerCredentials := &server.Credentials{Username: "reader", Password: "reader:password"}
+
+	rpcAuthorization, err := config.ParseRPCAuthorization(
+		[]string{"admin:*", "reader:GetInfo,GetBlockDagInfo", "anonymous:GetInfo"},
+		[]string{"reader:reader:password:reader"},
+		[]string{adminToken + ":admin"},
+		"anonymous")
+	if err != nil {
+		t.Fatalf("ParseRPCAuthorization: %s", err)
+	}
+
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		jsonRPCAddress:          jsonRPCAddress1,
+		rpcAuthorization:        rpcAuthorization,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+	})
+	defer teardown()
+
+	// The harness's client presents no credentials, so it may only call GetInfo
+	_, err = harness.rpcClient.GetBlockDAGInfo()
+	if err == nil || !strings.Contains(err.Error(), "not allowed") {
+		t.Fatalf("Expected anonymous GetBlockDAGInfo to be rejected, got: %v", err)
+	}
+
+	readerClient, err := rpcclient.NewRPCClientWithAuthorization(rpcAddress1, readerCredentials.Authorization())
+	if err != nil {
+		t.Fatalf("Error connecting as reader: %s", err)
+	}
+	defer readerClient.Close()
+	readerClient.SetTimeout(rpcTimeout)
+
+	_, err = readerClient.GetBlockDAGInfo()
+	if err != nil {
+		t.Fatalf("Error getting block DAG info as reader: %s", err)
+	}
+	_, err = readerClient.GetConnectedPeerInfo()
+	if err == nil || !strings.Contains(err.Error(), "not allowed") {
+		t.Fatalf("Expected reader GetConnectedPeerInfo to be rejected, got: %v", err)
+	}
+
+	adminCredentials := &server.Credentials{Token: adminToken}
+	adminClient, err := rpcclient.NewRPCClientWithAuthorization(rpcAddress1, adminCredentials.Authorization())
+	if err != nil {
+		t.Fatalf("Error connecting as admin: %s", err)
+	}
+	defer adminClient.Close()
+	adminClient.SetTimeout(rpcTimeout)
+
+	_, err = adminClient.GetConnectedPeerInfo()
+	if err != nil {
+		t.Fatalf("Error getting connected peer info as admin: %s", err)
+	}
+
+	// Wrong credentials must not fall back to the anonymous role
+	wrongCredentials := &server.Credentials{Username: "reader", Password: "wrong"}
+	_, err = rpcclient.NewRPCClientWithAuthorization(rpcAddress1, wrongCredentials.Authorization())
+	if err == nil || !strings.Contains(err.Error(), "Invalid credentials") {
+		t.Fatalf("Expected connecting with wrong credentials to fail, got: %v", err)
+	}
+
+	// JSON-RPC clients are authorized the same way
+	const getBlockDAGInfoRequest = `{"jsonrpc": "2.0", "method": "getBlockDagInfo", "id": 1}`
+	response := postJSONRPC(t, getBlockDAGInfoRequest)
+	if response.Error == nil || response.Error.Code != -32000 {
+		t.Fatalf("Expected anonymous JSON-RPC getBlockDagInfo to be rejected, got: %+v", response.Error)
+	}
+	response = postJSONRPCWithAuthorization(t, getBlockDAGInfoRequest, readerCredentials.Authorization())
+	if response.Error != nil {
+		t.Fatalf("Unexpected error from JSON-RPC getBlockDagInfo as reader: %s", response.Error.Message)
+	}
+
+	// JSON-RPC requests with wrong credentials are rejected before they're read
+	request, err := http.NewRequest(http.MethodPost, "http://"+jsonRPCAddress1, strings.NewReader(getBlockDAGInfoRequest))
+	if err != nil {
+		t.Fatalf("Error creating request: %s", err)
+	}
+	request.Header.Set("Authorization", wrongCredentials.Authorization())
+	wrongCredentialsResponse, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("Error posting request: %s", err)
+	}
+	wrongCredentialsResponse.Body.Close()
+	if wrongCredentialsResponse.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Unexpected status for wrong JSON-RPC credentials. Want: %d, got: %d",
+			http.StatusUnauthorized, wrongCredentialsResponse.StatusCode)
+	}
+}
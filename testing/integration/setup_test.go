@@ -1,12 +1,13 @@
 package integration
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/kaspanet/kaspad/domain/dagconfig"
 
-	"github.com/kaspanet/kaspad/infrastructure/db/database/ldb"
+	"github.com/kaspanet/kaspad/infrastructure/db/database/dbfactory"
 
 	"github.com/kaspanet/kaspad/infrastructure/db/database"
 
@@ -19,20 +20,30 @@ type appHarness struct {
 	rpcClient               *testRPCClient
 	p2pAddress              string
 	rpcAddress              string
+	jsonRPCAddress          string
+	jsonRPCAllowedOrigins   []string
+	rpcAuthorization        *config.RPCAuthorization
 	miningAddress           string
 	miningAddressPrivateKey string
 	config                  *config.Config
 	database                database.Database
 	utxoIndex               bool
+	txIndex                 bool
+	addressIndex            bool
 	overrideDAGParams       *dagconfig.Params
 }
 
 type harnessParams struct {
 	p2pAddress              string
 	rpcAddress              string
+	jsonRPCAddress          string
+	jsonRPCAllowedOrigins   []string
+	rpcAuthorization        *config.RPCAuthorization
 	miningAddress           string
 	miningAddressPrivateKey string
 	utxoIndex               bool
+	txIndex                 bool
+	addressIndex            bool
 	overrideDAGParams       *dagconfig.Params
 	protocolVersion         uint32
 }
@@ -42,9 +53,14 @@ func setupHarness(t *testing.T, params *harnessParams) (harness *appHarness, tea
 	harness = &appHarness{
 		p2pAddress:              params.p2pAddress,
 		rpcAddress:              params.rpcAddress,
+		jsonRPCAddress:          params.jsonRPCAddress,
+		jsonRPCAllowedOrigins:   params.jsonRPCAllowedOrigins,
+		rpcAuthorization:        params.rpcAuthorization,
 		miningAddress:           params.miningAddress,
 		miningAddressPrivateKey: params.miningAddressPrivateKey,
 		utxoIndex:               params.utxoIndex,
+		txIndex:                 params.txIndex,
+		addressIndex:            params.addressIndex,
 		overrideDAGParams:       params.overrideDAGParams,
 	}
 
@@ -116,6 +132,11 @@ func teardownHarness(t *testing.T, harness *appHarness) {
 	if err != nil {
 		t.Errorf("Error closing database context: %+v", err)
 	}
+
+	err = os.RemoveAll(harness.config.AppDir)
+	if err != nil {
+		t.Errorf("Error removing app directory: %+v", err)
+	}
 }
 
 func setApp(t *testing.T, harness *appHarness) {
@@ -136,5 +157,5 @@ func setDatabaseContext(t *testing.T, harness *appHarness) {
 
 func openDB(cfg *config.Config) (database.Database, error) {
 	dbPath := filepath.Join(cfg.AppDir, "db")
-	return ldb.NewLevelDB(dbPath, 8)
+	return dbfactory.Open(cfg.DbType, dbPath, 8)
 }
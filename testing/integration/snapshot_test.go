@@ -0,0 +1,83 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kaspanet/kaspad/infrastructure/db/dbsnapshot"
+)
+
+func TestCreateAndRestoreSnapshot(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+	})
+	defer teardown()
+
+	const blockAmountToMine = 20
+	for i := 0; i < blockAmountToMine; i++ {
+		mineNextBlock(t, harness)
+	}
+
+	snapshotDirectory := randomDirectory(t)
+	defer os.RemoveAll(snapshotDirectory)
+	snapshotPath := filepath.Join(snapshotDirectory, "kaspad.snapshot")
+	response, err := harness.rpcClient.CreateSnapshot(snapshotPath)
+	if err != nil {
+		t.Fatalf("CreateSnapshot: %s", err)
+	}
+	summary, err := dbsnapshot.Verify(snapshotPath)
+	if err != nil {
+		t.Fatalf("Verify: %+v", err)
+	}
+	if summary.EntryCount != response.EntryCount || summary.Checksum != response.Checksum {
+		t.Fatalf("The snapshot file doesn't match the CreateSnapshot response. "+
+			"Want: %d entries with checksum %s, got: %d entries with checksum %s",
+			response.EntryCount, response.Checksum, summary.EntryCount, summary.Checksum)
+	}
+
+	_, err = harness.rpcClient.CreateSnapshot(snapshotPath)
+	if err == nil {
+		t.Fatalf("CreateSnapshot unexpectedly overwrote an existing snapshot")
+	}
+
+	// Restore the snapshot into a new node, the way --restore-snapshot does
+	restoredHarness := &appHarness{
+		p2pAddress:              p2pAddress2,
+		rpcAddress:              rpcAddress2,
+		miningAddress:           miningAddress2,
+		miningAddressPrivateKey: miningAddress2PrivateKey,
+	}
+	setConfig(t, restoredHarness, 0)
+	restoredHarness.config.RestoreSnapshot = snapshotPath
+	setDatabaseContext(t, restoredHarness)
+	_, err = dbsnapshot.Restore(snapshotPath, restoredHarness.database)
+	if err != nil {
+		t.Fatalf("Restore: %+v", err)
+	}
+	setApp(t, restoredHarness)
+	restoredHarness.app.Start()
+	setRPCClient(t, restoredHarness)
+	defer teardownHarness(t, restoredHarness)
+
+	dagInfo, err := harness.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		t.Fatalf("GetBlockDAGInfo: %s", err)
+	}
+	restoredDAGInfo, err := restoredHarness.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		t.Fatalf("GetBlockDAGInfo: %s", err)
+	}
+	if !reflect.DeepEqual(dagInfo.TipHashes, restoredDAGInfo.TipHashes) {
+		t.Fatalf("The restored node has different tips. Want: %s, got: %s",
+			dagInfo.TipHashes, restoredDAGInfo.TipHashes)
+	}
+	if dagInfo.VirtualDAAScore != response.VirtualDAAScore {
+		t.Fatalf("Unexpected virtual DAA score in the CreateSnapshot response. Want: %d, got: %d",
+			dagInfo.VirtualDAAScore, response.VirtualDAAScore)
+	}
+}
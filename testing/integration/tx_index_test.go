@@ -0,0 +1,81 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/kaspanet/kaspad/domain/consensus/model/externalapi"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+)
+
+func TestTxIndex(t *testing.T) {
+	// Setup a single kaspad instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		txIndex:                 true,
+	}
+	kaspad, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// Mine some blocks. Since the blocks form a chain, the coinbase transaction
+	// of every block except the last one is accepted by the block following it
+	const blockAmountToMine = 10
+	blocks := make([]*externalapi.DomainBlock, blockAmountToMine)
+	for i := range blocks {
+		blocks[i] = mineNextBlock(t, kaspad)
+	}
+
+	for i, block := range blocks[:blockAmountToMine-1] {
+		blockHash := consensushashing.BlockHash(block)
+		coinbaseTransactionID := consensushashing.TransactionID(block.Transactions[0]).String()
+		expectedAcceptingBlockHash := consensushashing.BlockHash(blocks[i+1]).String()
+
+		getTransactionResponse, err := kaspad.rpcClient.GetTransaction(coinbaseTransactionID)
+		if err != nil {
+			t.Fatalf("Error getting transaction %s: %s", coinbaseTransactionID, err)
+		}
+		if getTransactionResponse.Transaction.VerboseData.TransactionID != coinbaseTransactionID {
+			t.Fatalf("Unexpected transaction ID. Want: %s, got: %s",
+				coinbaseTransactionID, getTransactionResponse.Transaction.VerboseData.TransactionID)
+		}
+		if getTransactionResponse.IncludingBlockHash != blockHash.String() {
+			t.Fatalf("Unexpected including block hash for transaction %s. Want: %s, got: %s",
+				coinbaseTransactionID, blockHash, getTransactionResponse.IncludingBlockHash)
+		}
+		if getTransactionResponse.AcceptingBlockHash != expectedAcceptingBlockHash {
+			t.Fatalf("Unexpected accepting block hash for transaction %s. Want: %s, got: %s",
+				coinbaseTransactionID, expectedAcceptingBlockHash, getTransactionResponse.AcceptingBlockHash)
+		}
+		expectedConfirmations := uint64(blockAmountToMine - 1 - i)
+		if getTransactionResponse.Confirmations != expectedConfirmations {
+			t.Fatalf("Unexpected confirmations for transaction %s. Want: %d, got: %d",
+				coinbaseTransactionID, expectedConfirmations, getTransactionResponse.Confirmations)
+		}
+	}
+
+	// The coinbase of the last block is not accepted yet
+	lastBlockCoinbaseTransactionID := consensushashing.TransactionID(blocks[blockAmountToMine-1].Transactions[0]).String()
+	firstBlockCoinbaseTransactionID := consensushashing.TransactionID(blocks[0].Transactions[0]).String()
+	getTransactionAcceptanceResponse, err := kaspad.rpcClient.GetTransactionAcceptance(
+		[]string{firstBlockCoinbaseTransactionID, lastBlockCoinbaseTransactionID})
+	if err != nil {
+		t.Fatalf("Error getting transaction acceptance: %s", err)
+	}
+	if len(getTransactionAcceptanceResponse.TransactionAcceptances) != 2 {
+		t.Fatalf("Unexpected amount of transaction acceptances. Want: %d, got: %d",
+			2, len(getTransactionAcceptanceResponse.TransactionAcceptances))
+	}
+	if !getTransactionAcceptanceResponse.TransactionAcceptances[0].IsAccepted {
+		t.Fatalf("Expected transaction %s to be accepted", firstBlockCoinbaseTransactionID)
+	}
+	if getTransactionAcceptanceResponse.TransactionAcceptances[1].IsAccepted {
+		t.Fatalf("Expected transaction %s not to be accepted", lastBlockCoinbaseTransactionID)
+	}
+
+	_, err = kaspad.rpcClient.GetTransaction(lastBlockCoinbaseTransactionID)
+	if err == nil {
+		t.Fatalf("Expected getting unaccepted transaction %s to fail", lastBlockCoinbaseTransactionID)
+	}
+}
@@ -0,0 +1,105 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kaspanet/kaspad/app/appmessage"
+	"github.com/kaspanet/kaspad/domain/consensus/utils/consensushashing"
+)
+
+func TestVirtualChainChangedV2(t *testing.T) {
+	kaspad1, kaspad2, _, teardown := standardSetup(t)
+	defer teardown()
+
+	// Mine a few blocks before registering, so that the first notification catches up from genesis
+	const blockAmountToMineBeforeRegistering = 5
+	minedBlockHashes := make([]string, 0, blockAmountToMineBeforeRegistering)
+	for i := 0; i < blockAmountToMineBeforeRegistering; i++ {
+		minedBlock := mineNextBlock(t, kaspad1)
+		minedBlockHashes = append(minedBlockHashes, consensushashing.BlockHash(minedBlock).String())
+	}
+
+	onVirtualChainChangedChan := make(chan *appmessage.VirtualChainChangedV2NotificationMessage, 100)
+	genesisHash := consensushashing.BlockHash(kaspad1.config.NetParams().GenesisBlock).String()
+	startHash, err := kaspad1.rpcClient.RegisterForVirtualChainChangedV2Notifications(genesisHash,
+		func(notification *appmessage.VirtualChainChangedV2NotificationMessage) {
+			onVirtualChainChangedChan <- notification
+		})
+	if err != nil {
+		t.Fatalf("Failed to register for virtual chain changed V2 notifications: %s", err)
+	}
+	if startHash != genesisHash {
+		t.Fatalf("Unexpected start hash. Want: %s, got: %s", genesisHash, startHash)
+	}
+
+	catchUpNotification := receiveVirtualChainChangedV2Notification(t, onVirtualChainChangedChan)
+	if len(catchUpNotification.RemovedChainBlocks) != 0 {
+		t.Fatalf("RemovedChainBlocks is unexpectedly not empty")
+	}
+	if len(catchUpNotification.AddedChainBlocks) != blockAmountToMineBeforeRegistering {
+		t.Fatalf("Unexpected length of AddedChainBlocks. Want: %d, got: %d",
+			blockAmountToMineBeforeRegistering, len(catchUpNotification.AddedChainBlocks))
+	}
+	for i, addedChainBlock := range catchUpNotification.AddedChainBlocks {
+		if addedChainBlock.Hash != minedBlockHashes[i] {
+			t.Fatalf("Unexpected added chain block %d. Want: %s, got: %s", i, minedBlockHashes[i], addedChainBlock.Hash)
+		}
+		if len(addedChainBlock.AcceptedTransactions) == 0 {
+			t.Fatalf("Added chain block %s unexpectedly accepts no transactions", addedChainBlock.Hash)
+		}
+	}
+
+	// Mine a chain in kaspad1, and make sure each notification adds exactly the mined block
+	const blockAmountToMine = 10
+	chain1BlockHashes := make(map[string]struct{}, blockAmountToMine)
+	for i := 0; i < blockAmountToMine; i++ {
+		minedBlock := mineNextBlock(t, kaspad1)
+		minedBlockHash := consensushashing.BlockHash(minedBlock).String()
+		chain1BlockHashes[minedBlockHash] = struct{}{}
+
+		notification := receiveVirtualChainChangedV2Notification(t, onVirtualChainChangedChan)
+		if len(notification.RemovedChainBlocks) != 0 {
+			t.Fatalf("RemovedChainBlocks is unexpectedly not empty")
+		}
+		if len(notification.AddedChainBlocks) != 1 || notification.AddedChainBlocks[0].Hash != minedBlockHash {
+			t.Fatalf("Expected a notification that adds only %s", minedBlockHash)
+		}
+	}
+
+	// Mine a longer chain in kaspad2 and connect the two kaspads. kaspad1 should then
+	// reorg to the chain of kaspad2, removing all of its own blocks
+	for i := 0; i < blockAmountToMineBeforeRegistering+blockAmountToMine+1; i++ {
+		mineNextBlock(t, kaspad2)
+	}
+	connect(t, kaspad1, kaspad2)
+
+	reorgNotification := receiveVirtualChainChangedV2Notification(t, onVirtualChainChangedChan)
+	for len(reorgNotification.RemovedChainBlocks) == 0 {
+		reorgNotification = receiveVirtualChainChangedV2Notification(t, onVirtualChainChangedChan)
+	}
+	if len(reorgNotification.RemovedChainBlocks) != blockAmountToMineBeforeRegistering+blockAmountToMine {
+		t.Fatalf("Unexpected length of RemovedChainBlocks. Want: %d, got: %d",
+			blockAmountToMineBeforeRegistering+blockAmountToMine, len(reorgNotification.RemovedChainBlocks))
+	}
+	for _, removedChainBlock := range reorgNotification.RemovedChainBlocks {
+		if _, ok := chain1BlockHashes[removedChainBlock.Hash]; !ok {
+			continue
+		}
+		if len(removedChainBlock.UnacceptedTransactionIDs) == 0 {
+			t.Fatalf("Removed chain block %s unexpectedly unaccepts no transactions", removedChainBlock.Hash)
+		}
+	}
+}
+
+func receiveVirtualChainChangedV2Notification(t *testing.T,
+	onVirtualChainChangedChan chan *appmessage.VirtualChainChangedV2NotificationMessage) *appmessage.VirtualChainChangedV2NotificationMessage {
+
+	select {
+	case notification := <-onVirtualChainChangedChan:
+		return notification
+	case <-time.After(defaultTimeout):
+		t.Fatalf("Timed out waiting for a virtual chain changed V2 notification")
+		return nil
+	}
+}